@@ -0,0 +1,87 @@
+package smartthings
+
+import "context"
+
+// Air Conditioner Helpers
+//
+// These helpers cover the SmartThings air conditioner capabilities
+// (airConditionerMode, thermostatCoolingSetpoint, airConditionerFanMode,
+// fanOscillationMode).
+
+// ExtractAirConditionerStatus extracts air conditioner state from a device status.
+// The current temperature is converted to the setpoint's unit if they differ.
+// Returns nil if the status has no airConditionerMode capability.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if ac := smartthings.ExtractAirConditionerStatus(status); ac != nil && ac.Setpoint != nil {
+//	    fmt.Printf("%s to %.0f°%s\n", ac.Mode, *ac.Setpoint, ac.Unit)
+//	}
+func ExtractAirConditionerStatus(status Status) *AirConditionerStatus {
+	if _, ok := GetMap(status, "airConditionerMode"); !ok {
+		return nil
+	}
+
+	result := &AirConditionerStatus{}
+
+	// Path: airConditionerMode.airConditionerMode.value
+	if mode, ok := GetString(status, "airConditionerMode", "airConditionerMode", "value"); ok {
+		result.Mode = mode
+	}
+
+	// Path: thermostatCoolingSetpoint.coolingSetpoint.{value,unit}
+	setpoint, setpointUnit := temperatureReading(status, "thermostatCoolingSetpoint", "coolingSetpoint")
+	// Path: temperatureMeasurement.temperature.{value,unit}
+	temp, tempUnit := temperatureReading(status, "temperatureMeasurement", "temperature")
+
+	result.Unit = setpointUnit
+	if result.Unit == "" {
+		result.Unit = tempUnit
+	}
+	result.Setpoint = setpoint
+	result.Temperature = convertTemperature(temp, tempUnit, result.Unit)
+
+	// Path: airConditionerFanMode.fanMode.value
+	if fan, ok := GetString(status, "airConditionerFanMode", "fanMode", "value"); ok {
+		result.FanMode = fan
+	}
+
+	// Path: fanOscillationMode.fanOscillationMode.value
+	if swing, ok := GetString(status, "fanOscillationMode", "fanOscillationMode", "value"); ok {
+		result.SwingMode = swing
+	}
+
+	return result
+}
+
+// SetACMode sets the air conditioner mode (e.g. "cool", "dry", "wind", "auto").
+func (c *Client) SetACMode(ctx context.Context, deviceID, mode string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if mode == "" {
+		return ErrEmptyMode
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("airConditionerMode", "setAirConditionerMode", mode))
+}
+
+// SetACSetpoint sets the air conditioner's cooling setpoint.
+// The temperature is in the device's configured unit.
+func (c *Client) SetACSetpoint(ctx context.Context, deviceID string, temp float64) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("thermostatCoolingSetpoint", "setCoolingSetpoint", temp))
+}
+
+// SetFanMode sets the air conditioner fan mode (e.g. "auto", "low", "medium", "high").
+func (c *Client) SetFanMode(ctx context.Context, deviceID, mode string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if mode == "" {
+		return ErrEmptyMode
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("airConditionerFanMode", "setFanMode", mode))
+}
@@ -0,0 +1,118 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractAirConditionerStatus(t *testing.T) {
+	t.Run("full status", func(t *testing.T) {
+		status := Status{
+			"airConditionerMode":        map[string]any{"airConditionerMode": map[string]any{"value": "cool"}},
+			"thermostatCoolingSetpoint": map[string]any{"coolingSetpoint": map[string]any{"value": 24.0, "unit": "C"}},
+			"temperatureMeasurement":    map[string]any{"temperature": map[string]any{"value": 80.6, "unit": "F"}},
+			"airConditionerFanMode":     map[string]any{"fanMode": map[string]any{"value": "high"}},
+			"fanOscillationMode":        map[string]any{"fanOscillationMode": map[string]any{"value": "vertical"}},
+		}
+
+		result := ExtractAirConditionerStatus(status)
+		if result == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if result.Mode != "cool" {
+			t.Errorf("Mode = %q, want %q", result.Mode, "cool")
+		}
+		if result.Setpoint == nil || *result.Setpoint != 24 {
+			t.Errorf("Setpoint = %v, want 24", result.Setpoint)
+		}
+		if result.Unit != "C" {
+			t.Errorf("Unit = %q, want %q", result.Unit, "C")
+		}
+		if result.Temperature == nil || *result.Temperature < 26.99 || *result.Temperature > 27.01 {
+			t.Errorf("Temperature = %v, want 27 (converted from F)", result.Temperature)
+		}
+		if result.FanMode != "high" {
+			t.Errorf("FanMode = %q, want %q", result.FanMode, "high")
+		}
+		if result.SwingMode != "vertical" {
+			t.Errorf("SwingMode = %q, want %q", result.SwingMode, "vertical")
+		}
+	})
+
+	t.Run("mode only", func(t *testing.T) {
+		status := Status{
+			"airConditionerMode": map[string]any{"airConditionerMode": map[string]any{"value": "dry"}},
+		}
+
+		result := ExtractAirConditionerStatus(status)
+		if result.Setpoint != nil || result.FanMode != "" || result.SwingMode != "" {
+			t.Errorf("unexpected fields in %+v", result)
+		}
+	})
+
+	t.Run("not an air conditioner", func(t *testing.T) {
+		status := Status{
+			"thermostatCoolingSetpoint": map[string]any{"coolingSetpoint": map[string]any{"value": 24.0}},
+		}
+		if result := ExtractAirConditionerStatus(status); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
+
+func TestClient_AirConditionerCommands(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(c *Client) error
+		wantCapability string
+		wantCommand    string
+		wantArg        any
+	}{
+		{"mode", func(c *Client) error { return c.SetACMode(context.Background(), "ac-1", "cool") }, "airConditionerMode", "setAirConditionerMode", "cool"},
+		{"setpoint", func(c *Client) error { return c.SetACSetpoint(context.Background(), "ac-1", 23.5) }, "thermostatCoolingSetpoint", "setCoolingSetpoint", 23.5},
+		{"fan mode", func(c *Client) error { return c.SetFanMode(context.Background(), "ac-1", "low") }, "airConditionerFanMode", "setFanMode", "low"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != tt.wantCapability || cmd.Command != tt.wantCommand {
+					t.Errorf("command = %s.%s, want %s.%s", cmd.Capability, cmd.Command, tt.wantCapability, tt.wantCommand)
+				}
+				if len(cmd.Arguments) != 1 || cmd.Arguments[0] != tt.wantArg {
+					t.Errorf("arguments = %v, want [%v]", cmd.Arguments, tt.wantArg)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := tt.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		ctx := context.Background()
+		if err := client.SetACMode(ctx, "", "cool"); err != ErrEmptyDeviceID {
+			t.Errorf("SetACMode: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetACMode(ctx, "ac-1", ""); err != ErrEmptyMode {
+			t.Errorf("SetACMode: expected ErrEmptyMode, got %v", err)
+		}
+		if err := client.SetACSetpoint(ctx, "", 24); err != ErrEmptyDeviceID {
+			t.Errorf("SetACSetpoint: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetFanMode(ctx, "ac-1", ""); err != ErrEmptyMode {
+			t.Errorf("SetFanMode: expected ErrEmptyMode, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package smartthings
+
+// Air Quality Helpers
+//
+// These helpers cover the SmartThings air quality capabilities
+// (airQualitySensor, dustSensor, fineDustSensor, veryFineDustSensor,
+// odorSensor, carbonDioxideMeasurement) reported by air purifiers and air
+// quality monitors.
+
+// airQualityCapabilities lists the capabilities read by ExtractAirQuality.
+var airQualityCapabilities = []string{
+	"airQualitySensor",
+	"dustSensor",
+	"fineDustSensor",
+	"veryFineDustSensor",
+	"odorSensor",
+	"carbonDioxideMeasurement",
+}
+
+// ExtractAirQuality extracts air quality readings from a device status.
+// PM2.5 is read from dustSensor, or from fineDustSensor if dustSensor does
+// not report it. Readings a device does not report are nil.
+// Returns nil if the status has none of the air quality capabilities.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, purifierID)
+//	if aq := smartthings.ExtractAirQuality(status); aq != nil && aq.PM25 != nil {
+//	    fmt.Printf("PM2.5: %d μg/m³\n", *aq.PM25)
+//	}
+func ExtractAirQuality(status Status) *AirQualityStatus {
+	found := false
+	for _, capability := range airQualityCapabilities {
+		if _, ok := GetMap(status, capability); ok {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	result := &AirQualityStatus{}
+
+	// Path: airQualitySensor.airQuality.value
+	result.AirQualityIndex = optionalInt(status, "airQualitySensor", "airQuality", "value")
+
+	// Path: dustSensor.fineDustLevel.value, falling back to
+	// fineDustSensor.fineDustLevel.value
+	result.PM25 = optionalInt(status, "dustSensor", "fineDustLevel", "value")
+	if result.PM25 == nil {
+		result.PM25 = optionalInt(status, "fineDustSensor", "fineDustLevel", "value")
+	}
+
+	// Path: dustSensor.dustLevel.value
+	result.PM10 = optionalInt(status, "dustSensor", "dustLevel", "value")
+
+	// Path: veryFineDustSensor.veryFineDustLevel.value
+	result.PM1 = optionalInt(status, "veryFineDustSensor", "veryFineDustLevel", "value")
+
+	// Path: odorSensor.odorLevel.value
+	result.Odor = optionalInt(status, "odorSensor", "odorLevel", "value")
+
+	// Path: carbonDioxideMeasurement.carbonDioxide.value
+	result.CO2 = optionalInt(status, "carbonDioxideMeasurement", "carbonDioxide", "value")
+
+	return result
+}
+
+// optionalInt returns a pointer to the int at keys, or nil if there is none.
+func optionalInt(status Status, keys ...string) *int {
+	if v, ok := GetInt(status, keys...); ok {
+		return &v
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package smartthings
+
+import "testing"
+
+func TestExtractAirQuality(t *testing.T) {
+	t.Run("air purifier", func(t *testing.T) {
+		status := Status{
+			"airQualitySensor":   map[string]any{"airQuality": map[string]any{"value": float64(2), "unit": "CAQI"}},
+			"dustSensor":         map[string]any{"dustLevel": map[string]any{"value": float64(18), "unit": "μg/m^3"}, "fineDustLevel": map[string]any{"value": float64(9), "unit": "μg/m^3"}},
+			"veryFineDustSensor": map[string]any{"veryFineDustLevel": map[string]any{"value": float64(4), "unit": "μg/m^3"}},
+			"odorSensor":         map[string]any{"odorLevel": map[string]any{"value": float64(1)}},
+		}
+		got := ExtractAirQuality(status)
+		if got == nil {
+			t.Fatal("expected air quality status")
+		}
+		checkIntPtr(t, "AirQualityIndex", got.AirQualityIndex, ptrInt(2))
+		checkIntPtr(t, "PM25", got.PM25, ptrInt(9))
+		checkIntPtr(t, "PM10", got.PM10, ptrInt(18))
+		checkIntPtr(t, "PM1", got.PM1, ptrInt(4))
+		checkIntPtr(t, "Odor", got.Odor, ptrInt(1))
+		checkIntPtr(t, "CO2", got.CO2, nil)
+	})
+
+	t.Run("fine dust and CO2 monitor", func(t *testing.T) {
+		status := Status{
+			"fineDustSensor":           map[string]any{"fineDustLevel": map[string]any{"value": float64(35)}},
+			"carbonDioxideMeasurement": map[string]any{"carbonDioxide": map[string]any{"value": float64(820), "unit": "ppm"}},
+		}
+		got := ExtractAirQuality(status)
+		if got == nil {
+			t.Fatal("expected air quality status")
+		}
+		checkIntPtr(t, "PM25", got.PM25, ptrInt(35))
+		checkIntPtr(t, "CO2", got.CO2, ptrInt(820))
+		checkIntPtr(t, "PM10", got.PM10, nil)
+		checkIntPtr(t, "AirQualityIndex", got.AirQualityIndex, nil)
+	})
+
+	t.Run("capability without readings", func(t *testing.T) {
+		got := ExtractAirQuality(Status{"dustSensor": map[string]any{}})
+		if got == nil || got.PM25 != nil || got.PM10 != nil {
+			t.Errorf("ExtractAirQuality() = %+v, want empty status", got)
+		}
+	})
+
+	t.Run("no air quality capabilities", func(t *testing.T) {
+		status := Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}}
+		if got := ExtractAirQuality(status); got != nil {
+			t.Errorf("ExtractAirQuality() = %+v, want nil", got)
+		}
+		if got := ExtractAirQuality(nil); got != nil {
+			t.Errorf("ExtractAirQuality(nil) = %+v, want nil", got)
+		}
+	})
+}
+
+func checkIntPtr(t *testing.T, name string, got, want *int) {
+	t.Helper()
+	switch {
+	case got == nil && want == nil:
+	case got == nil || want == nil:
+		t.Errorf("%s = %v, want %v", name, got, want)
+	case *got != *want:
+		t.Errorf("%s = %d, want %d", name, *got, *want)
+	}
+}
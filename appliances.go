@@ -2,6 +2,7 @@ package smartthings
 
 import (
 	"math"
+	"slices"
 	"strings"
 	"time"
 )
@@ -108,22 +109,9 @@ func ExtractLaundryStatus(status Status, applianceType string) *ApplianceStatus
 
 // checkMachineRunning checks if the appliance is currently running.
 func checkMachineRunning(opState map[string]any) bool {
-	if opState == nil {
-		return false
-	}
-	// Check machineState first (more reliable)
-	if machineState, ok := GetMap(opState, "machineState"); ok {
-		if value, ok := GetString(machineState, "value"); ok {
-			return value == "running" || value == "run"
-		}
-	}
-	// Fallback to operatingState
-	if operatingState, ok := GetMap(opState, "operatingState"); ok {
-		if value, ok := GetString(operatingState, "value"); ok {
-			return value == "running" || value == "run"
-		}
-	}
-	return false
+	// machineState is checked first (more reliable), then operatingState
+	value, ok := machineStateValue(opState)
+	return ok && ParseMachineState(value) == MachineStateRunning
 }
 
 // extractLaundryTimeFields extracts remaining time, completion time, and cycle progress.
@@ -209,29 +197,27 @@ func ExtractRangeStatus(status Status) *RangeStatus {
 	// Extract cooktop active status
 	// Path: custom.cooktopOperatingState.cooktopOperatingState.value
 	if value, ok := GetString(status, "custom.cooktopOperatingState", "cooktopOperatingState", "value"); ok {
-		result.CooktopActive = value == "run"
+		result.CooktopActive = ParseMachineState(value) == MachineStateRunning
 	}
 
 	// Check if oven is actively running (not just residual heat)
 	// Path: ovenOperatingState.machineState.value
 	if value, ok := GetString(status, "ovenOperatingState", "machineState", "value"); ok {
 		// "ready" means oven is off/idle, anything else means it's running
-		result.OvenActive = value != "ready"
+		result.OvenActive = ParseMachineState(value) != MachineStateReady
 	}
 
 	// Only extract temperatures when oven is actively running
 	if result.OvenActive {
 		// Extract oven target temperature
-		// Path: ovenSetpoint.ovenSetpoint.value
-		if value, ok := GetFloat(status, "ovenSetpoint", "ovenSetpoint", "value"); ok && value > 0 {
-			t := int(value)
+		// Path: ovenSetpoint.ovenSetpoint.{value,unit}
+		if t, ok := fahrenheitReading(status, "F", "ovenSetpoint", "ovenSetpoint"); ok && t > 0 {
 			result.OvenTargetTemp = &t
 		}
 
 		// Extract current oven temperature
-		// Path: temperatureMeasurement.temperature.value
-		if value, ok := GetFloat(status, "temperatureMeasurement", "temperature", "value"); ok && value > 0 {
-			t := int(value)
+		// Path: temperatureMeasurement.temperature.{value,unit}
+		if t, ok := fahrenheitReading(status, "F", "temperatureMeasurement", "temperature"); ok && t > 0 {
 			result.OvenTemp = &t
 		}
 	}
@@ -246,11 +232,9 @@ func ExtractRefrigeratorStatus(allComponents Status) *RefrigeratorStatus {
 	result := &RefrigeratorStatus{}
 
 	// Extract fridge temperature from cooler component
-	// Path: cooler.temperatureMeasurement.temperature.value
+	// Path: cooler.temperatureMeasurement.temperature.{value,unit}
 	if cooler, ok := GetMap(allComponents, "cooler"); ok {
-		if value, vok := GetFloat(cooler, "temperatureMeasurement", "temperature", "value"); vok {
-			// Convert Celsius to Fahrenheit
-			fahrenheit := CelsiusToFahrenheit(value)
+		if fahrenheit, vok := fahrenheitReading(cooler, "C", "temperatureMeasurement", "temperature"); vok {
 			result.FridgeTemp = &fahrenheit
 		}
 
@@ -262,11 +246,9 @@ func ExtractRefrigeratorStatus(allComponents Status) *RefrigeratorStatus {
 	}
 
 	// Extract freezer temperature from freezer component
-	// Path: freezer.temperatureMeasurement.temperature.value
+	// Path: freezer.temperatureMeasurement.temperature.{value,unit}
 	if freezer, ok := GetMap(allComponents, "freezer"); ok {
-		if value, vok := GetFloat(freezer, "temperatureMeasurement", "temperature", "value"); vok {
-			// Convert Celsius to Fahrenheit
-			fahrenheit := CelsiusToFahrenheit(value)
+		if fahrenheit, vok := fahrenheitReading(freezer, "C", "temperatureMeasurement", "temperature"); vok {
 			result.FreezerTemp = &fahrenheit
 		}
 	}
@@ -390,8 +372,7 @@ func convertToMinutes(value float64, unit string) int {
 func extractGenericTemperature(status Status, result *GenericApplianceStatus) {
 	// Try temperatureMeasurement capability
 	if temp, _ := findCapability(status, "temperatureMeasurement"); temp != nil {
-		if value, ok := GetFloat(temp, "temperature", "value"); ok {
-			f := CelsiusToFahrenheit(value)
+		if f, ok := fahrenheitReading(temp, "C", "temperature"); ok {
 			result.Temperature = &f
 		}
 	}
@@ -402,8 +383,7 @@ func extractGenericTemperature(status Status, result *GenericApplianceStatus) {
 		if setpoint, _ := findCapability(status, pattern); setpoint != nil {
 			// Try common paths for setpoint value
 			for _, path := range []string{pattern, "setpoint", "coolingSetpoint", "heatingSetpoint"} {
-				if value, ok := GetFloat(setpoint, path, "value"); ok && value > 0 {
-					t := int(value)
+				if t, ok := fahrenheitReading(setpoint, "F", path); ok && t > 0 {
 					result.TargetTemp = &t
 					break
 				}
@@ -413,6 +393,26 @@ func extractGenericTemperature(status Status, result *GenericApplianceStatus) {
 	}
 }
 
+// fahrenheitReading returns the temperature attribute at keys in whole
+// degrees Fahrenheit (truncated), converting from the unit the status
+// reports, or from defaultUnit if it reports none. Returns false if there is
+// no value or the result does not fit in an int32.
+func fahrenheitReading(data map[string]any, defaultUnit string, keys ...string) (int, bool) {
+	value, ok := GetFloat(data, slices.Concat(keys, []string{"value"})...)
+	if !ok {
+		return 0, false
+	}
+	unit, _ := GetString(data, slices.Concat(keys, []string{"unit"})...)
+	if unit == "" {
+		unit = defaultUnit
+	}
+	f := NormalizeTemperature(value, unit, "F")
+	if math.IsNaN(f) || f > math.MaxInt32 || f < math.MinInt32 {
+		return 0, false
+	}
+	return int(f), true
+}
+
 // extractContactStatus extracts door/lid open status from contact sensors.
 func extractContactStatus(status Status, result *GenericApplianceStatus) {
 	if contact, _ := findCapability(status, "contactSensor"); contact != nil {
@@ -997,13 +997,15 @@ func ExtractRangeDetailedStatus(status Status) *RangeDetailedStatus {
 	}
 
 	// Extract temperature limits if available
+	// in the setpoint's unit, like the setpoint itself
 	if setpoint, ok := GetMap(status, "ovenSetpoint"); ok {
+		unit, _ := GetString(setpoint, "ovenSetpoint", "unit")
 		if minTemp, ok := GetFloat(setpoint, "ovenSetpoint", "range", "minimum"); ok {
-			t := int(minTemp)
+			t := int(NormalizeTemperature(minTemp, unit, "F"))
 			result.OvenTempMin = &t
 		}
 		if maxTemp, ok := GetFloat(setpoint, "ovenSetpoint", "range", "maximum"); ok {
-			t := int(maxTemp)
+			t := int(NormalizeTemperature(maxTemp, unit, "F"))
 			result.OvenTempMax = &t
 		}
 	}
@@ -361,6 +361,28 @@ func TestExtractRangeStatus(t *testing.T) {
 		}
 	})
 
+	t.Run("oven reporting Celsius", func(t *testing.T) {
+		status := Status{
+			"ovenOperatingState": map[string]any{
+				"machineState": map[string]any{"value": "running"},
+			},
+			"ovenSetpoint": map[string]any{
+				"ovenSetpoint": map[string]any{"value": float64(200), "unit": "C"},
+			},
+			"temperatureMeasurement": map[string]any{
+				"temperature": map[string]any{"value": float64(180), "unit": "C"},
+			},
+		}
+
+		result := ExtractRangeStatus(status)
+		if result.OvenTargetTemp == nil || *result.OvenTargetTemp != 392 {
+			t.Errorf("OvenTargetTemp = %v, want 392", result.OvenTargetTemp)
+		}
+		if result.OvenTemp == nil || *result.OvenTemp != 356 {
+			t.Errorf("OvenTemp = %v, want 356", result.OvenTemp)
+		}
+	})
+
 	t.Run("only cooktop active", func(t *testing.T) {
 		status := Status{
 			"custom.cooktopOperatingState": map[string]any{
@@ -456,6 +478,29 @@ func TestExtractRefrigeratorStatus(t *testing.T) {
 		}
 	})
 
+	t.Run("temperatures already in Fahrenheit", func(t *testing.T) {
+		allComponents := Status{
+			"cooler": map[string]any{
+				"temperatureMeasurement": map[string]any{
+					"temperature": map[string]any{"value": float64(37), "unit": "F"},
+				},
+			},
+			"freezer": map[string]any{
+				"temperatureMeasurement": map[string]any{
+					"temperature": map[string]any{"value": float64(-2), "unit": "F"},
+				},
+			},
+		}
+
+		result := ExtractRefrigeratorStatus(allComponents)
+		if result.FridgeTemp == nil || *result.FridgeTemp != 37 {
+			t.Errorf("FridgeTemp = %v, want 37 (not converted again)", result.FridgeTemp)
+		}
+		if result.FreezerTemp == nil || *result.FreezerTemp != -2 {
+			t.Errorf("FreezerTemp = %v, want -2 (not converted again)", result.FreezerTemp)
+		}
+	})
+
 	t.Run("door open", func(t *testing.T) {
 		allComponents := Status{
 			"cooler": map[string]any{
@@ -11,9 +11,11 @@ type BatchCommand struct {
 	Commands []Command // Commands to execute on this device
 }
 
-// BatchResult contains the result of executing commands on a single device.
+// BatchResult contains the result of executing commands on a single device,
+// or of executing a single scene with ExecuteScenesBatch.
 type BatchResult struct {
-	DeviceID string // The device ID
+	DeviceID string // The device ID (empty for scene results)
+	SceneID  string // The scene ID (ExecuteScenesBatch only)
 	Error    error  // Error if execution failed, nil on success
 }
 
@@ -37,7 +39,8 @@ func DefaultBatchConfig() *BatchConfig {
 }
 
 // ExecuteCommandsBatch executes commands on multiple devices concurrently.
-// It uses a worker pool to limit concurrent API calls and respects rate limits.
+// It uses a worker pool to limit concurrent API calls. A device that is rate
+// limited (429) is retried once after waiting for the Retry-After duration.
 //
 // Example:
 //
@@ -56,6 +59,23 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 		return nil
 	}
 
+	errs := c.runBatch(ctx, len(batch), cfg, func(i int) error {
+		return c.ExecuteCommands(ctx, batch[i].DeviceID, batch[i].Commands)
+	})
+	results := make([]BatchResult, len(batch))
+	for i, cmd := range batch {
+		results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: errs[i]}
+	}
+	return results
+}
+
+// runBatch calls fn for each index in [0, n) on a worker pool of at most
+// cfg.MaxConcurrent goroutines (DefaultBatchConfig if cfg is nil) and
+// returns the error of each call. A call that is rate limited (429) is
+// retried once after waiting for the Retry-After duration. Calls not made
+// because ctx is done report ctx.Err(); with cfg.StopOnError, calls not made
+// after a failure report context.Canceled.
+func (c *Client) runBatch(ctx context.Context, n int, cfg *BatchConfig, fn func(i int) error) []error {
 	if cfg == nil {
 		cfg = DefaultBatchConfig()
 	}
@@ -63,7 +83,7 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 		cfg.MaxConcurrent = 10
 	}
 
-	results := make([]BatchResult, len(batch))
+	errs := make([]error, n)
 	var mu sync.Mutex
 	var stopped bool
 
@@ -71,12 +91,12 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 	sem := make(chan struct{}, cfg.MaxConcurrent)
 	var wg sync.WaitGroup
 
-	for i, cmd := range batch {
+	for i := range n {
 		// Check if we should stop
 		mu.Lock()
 		if stopped {
 			mu.Unlock()
-			results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: context.Canceled}
+			errs[i] = context.Canceled
 			continue
 		}
 		mu.Unlock()
@@ -84,7 +104,7 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 		// Check context
 		select {
 		case <-ctx.Done():
-			results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: ctx.Err()}
+			errs[i] = ctx.Err()
 			continue
 		default:
 		}
@@ -94,20 +114,27 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 			case sem <- struct{}{}:
 				defer func() { <-sem }()
 			case <-ctx.Done():
-				results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: ctx.Err()}
+				errs[i] = ctx.Err()
 				return
 			}
 
 			mu.Lock()
 			if stopped {
 				mu.Unlock()
-				results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: context.Canceled}
+				errs[i] = context.Canceled
 				return
 			}
 			mu.Unlock()
 
-			err := c.ExecuteCommands(ctx, cmd.DeviceID, cmd.Commands)
-			results[i] = BatchResult{DeviceID: cmd.DeviceID, Error: err}
+			err := fn(i)
+			if IsRateLimited(err) {
+				if waitErr := c.WaitForRateLimitErr(ctx, err); waitErr != nil {
+					err = waitErr
+				} else {
+					err = fn(i)
+				}
+			}
+			errs[i] = err
 
 			if err != nil && cfg.StopOnError {
 				mu.Lock()
@@ -118,7 +145,7 @@ func (c *Client) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand,
 	}
 
 	wg.Wait()
-	return results
+	return errs
 }
 
 // ExecuteCommandBatch is a convenience wrapper for executing the same command
@@ -139,6 +166,77 @@ func (c *Client) ExecuteCommandBatch(ctx context.Context, deviceIDs []string, cm
 	return c.ExecuteCommandsBatch(ctx, batch, cfg)
 }
 
+// ExecuteScenesBatch executes multiple scenes concurrently, e.g. the same
+// "Goodnight" scene across several locations. It uses the same worker pool,
+// StopOnError, and rate-limit retry as ExecuteCommandsBatch. Results are
+// returned in the order of sceneIDs, with SceneID set.
+//
+// Example:
+//
+//	results := client.ExecuteScenesBatch(ctx, []string{"scene1", "scene2"}, nil)
+//	for _, r := range results {
+//	    if r.Error != nil {
+//	        log.Printf("Scene %s failed: %v", r.SceneID, r.Error)
+//	    }
+//	}
+func (c *Client) ExecuteScenesBatch(ctx context.Context, sceneIDs []string, cfg *BatchConfig) []BatchResult {
+	if len(sceneIDs) == 0 {
+		return nil
+	}
+
+	errs := c.runBatch(ctx, len(sceneIDs), cfg, func(i int) error {
+		return c.ExecuteScene(ctx, sceneIDs[i])
+	})
+	results := make([]BatchResult, len(sceneIDs))
+	for i, sceneID := range sceneIDs {
+		results[i] = BatchResult{SceneID: sceneID, Error: errs[i]}
+	}
+	return results
+}
+
+// AssignDevicesToRoom moves devices into a room of a location, updating each
+// device's RoomID with UpdateDevice concurrently (DefaultBatchConfig limits).
+// The room is fetched first; if it is invalid or cannot be found, every
+// result carries that error and no devices are updated. Use
+// AssignDevicesToRoomWithConfig to set the concurrency or StopOnError.
+//
+// Example:
+//
+//	for _, r := range client.AssignDevicesToRoom(ctx, locationID, roomID, deviceIDs) {
+//	    if r.Error != nil {
+//	        log.Printf("Device %s not moved: %v", r.DeviceID, r.Error)
+//	    }
+//	}
+func (c *Client) AssignDevicesToRoom(ctx context.Context, locationID, roomID string, deviceIDs []string) []BatchResult {
+	return c.AssignDevicesToRoomWithConfig(ctx, locationID, roomID, deviceIDs, nil)
+}
+
+// AssignDevicesToRoomWithConfig is AssignDevicesToRoom with a BatchConfig. It
+// uses the same worker pool, StopOnError, and rate-limit retry as
+// ExecuteCommandsBatch.
+func (c *Client) AssignDevicesToRoomWithConfig(ctx context.Context, locationID, roomID string, deviceIDs []string, cfg *BatchConfig) []BatchResult {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	results := make([]BatchResult, len(deviceIDs))
+	if _, err := c.GetRoom(ctx, locationID, roomID); err != nil {
+		for i, deviceID := range deviceIDs {
+			results[i] = BatchResult{DeviceID: deviceID, Error: err}
+		}
+		return results
+	}
+
+	errs := c.runBatch(ctx, len(deviceIDs), cfg, func(i int) error {
+		_, err := c.UpdateDevice(ctx, deviceIDs[i], &DeviceUpdate{RoomID: roomID})
+		return err
+	})
+	for i, deviceID := range deviceIDs {
+		results[i] = BatchResult{DeviceID: deviceID, Error: errs[i]}
+	}
+	return results
+}
+
 // BatchStatusResult contains device status fetch results.
 type BatchStatusResult struct {
 	DeviceID   string            // The device ID
@@ -204,3 +302,98 @@ func (c *Client) GetDeviceStatusBatch(ctx context.Context, deviceIDs []string, c
 	wg.Wait()
 	return results
 }
+
+// BatchHealthResult contains device health fetch results.
+type BatchHealthResult struct {
+	DeviceID string        // The device ID
+	Health   *DeviceHealth // Health status (nil on error)
+	Error    error         // Error if fetch failed
+}
+
+// GetDeviceHealthBatch fetches health for multiple devices concurrently.
+// It uses the same worker pool, StopOnError, and cancellation as
+// ExecuteCommandsBatch: a device that is rate limited (429) is retried once
+// after waiting for the Retry-After duration, and errors are reported per
+// device.
+//
+// Example:
+//
+//	results := client.GetDeviceHealthBatch(ctx, deviceIDs, nil)
+//	for _, r := range results {
+//	    if r.Error == nil && r.Health.State == "OFFLINE" {
+//	        fmt.Printf("Device %s is offline\n", r.DeviceID)
+//	    }
+//	}
+func (c *Client) GetDeviceHealthBatch(ctx context.Context, deviceIDs []string, cfg *BatchConfig) []BatchHealthResult {
+	if len(deviceIDs) == 0 {
+		return nil
+	}
+
+	health := make([]*DeviceHealth, len(deviceIDs))
+	errs := c.runBatch(ctx, len(deviceIDs), cfg, func(i int) error {
+		var err error
+		health[i], err = c.GetDeviceHealth(ctx, deviceIDs[i])
+		return err
+	})
+	results := make([]BatchHealthResult, len(deviceIDs))
+	for i, deviceID := range deviceIDs {
+		results[i] = BatchHealthResult{
+			DeviceID: deviceID,
+			Health:   health[i],
+			Error:    errs[i],
+		}
+	}
+	return results
+}
+
+// DeviceWithStatus pairs a device with the current status of all its components.
+type DeviceWithStatus struct {
+	Device     Device            // The device
+	Components map[string]Status // Status per component (nil on error)
+	Error      error             // Error if the status fetch failed
+}
+
+// GetLocationInventory returns every device in a location together with its
+// current status. Statuses are fetched concurrently with the default batch
+// worker pool. A device whose status cannot be fetched is still returned,
+// with Error set, so one failure does not hide the rest of the inventory.
+// An error is returned only if the device list itself cannot be fetched.
+//
+// Example:
+//
+//	inventory, err := client.GetLocationInventory(ctx, locationID)
+//	for _, d := range inventory {
+//	    if d.Error != nil {
+//	        log.Printf("%s: %v", d.Device.Label, d.Error)
+//	        continue
+//	    }
+//	    fmt.Printf("%s: %v\n", d.Device.Label, d.Components["main"])
+//	}
+func (c *Client) GetLocationInventory(ctx context.Context, locationID string) ([]DeviceWithStatus, error) {
+	if locationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+
+	var devices []Device
+	for device, err := range c.DevicesWithOptions(ctx, &ListDevicesOptions{LocationID: []string{locationID}}) {
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+	deviceIDs := make([]string, len(devices))
+	for i, device := range devices {
+		deviceIDs[i] = device.DeviceID
+	}
+
+	statuses := c.GetDeviceStatusBatch(ctx, deviceIDs, nil)
+	inventory := make([]DeviceWithStatus, len(devices))
+	for i, device := range devices {
+		inventory[i] = DeviceWithStatus{
+			Device:     device,
+			Components: statuses[i].Components,
+			Error:      statuses[i].Error,
+		}
+	}
+	return inventory, nil
+}
@@ -2,6 +2,7 @@ package smartthings
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"sync/atomic"
@@ -276,6 +277,226 @@ func TestClient_ExecuteCommandBatch(t *testing.T) {
 	}
 }
 
+func TestClient_ExecuteScenesBatch(t *testing.T) {
+	t.Run("executes all scenes", func(t *testing.T) {
+		var callCount atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount.Add(1)
+			if r.Method != http.MethodPost {
+				t.Errorf("method = %q, want POST", r.Method)
+			}
+			if r.URL.Path == "/scenes/scene-bad/execute" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"success"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.ExecuteScenesBatch(context.Background(),
+			[]string{"scene-1", "scene-bad", "scene-2"},
+			&BatchConfig{MaxConcurrent: 2})
+
+		if len(results) != 3 {
+			t.Fatalf("expected 3 results, got %d", len(results))
+		}
+		if callCount.Load() != 3 {
+			t.Errorf("expected 3 API calls, got %d", callCount.Load())
+		}
+		for i, want := range []string{"scene-1", "scene-bad", "scene-2"} {
+			if results[i].SceneID != want {
+				t.Errorf("results[%d].SceneID = %q, want %q", i, results[i].SceneID, want)
+			}
+		}
+		if results[0].Error != nil || results[2].Error != nil {
+			t.Errorf("unexpected errors: %v, %v", results[0].Error, results[2].Error)
+		}
+		if !IsNotFound(results[1].Error) {
+			t.Errorf("expected not found error, got %v", results[1].Error)
+		}
+	})
+
+	t.Run("rate limited scene is retried", func(t *testing.T) {
+		var scene2Calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/scenes/scene-2/execute" && scene2Calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"success"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.ExecuteScenesBatch(context.Background(), []string{"scene-1", "scene-2"}, nil)
+
+		for i, r := range results {
+			if r.Error != nil {
+				t.Errorf("result[%d] unexpected error: %v", i, r.Error)
+			}
+		}
+		if scene2Calls.Load() != 2 {
+			t.Errorf("scene-2 calls = %d, want 2", scene2Calls.Load())
+		}
+	})
+
+	t.Run("empty scene ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		results := client.ExecuteScenesBatch(context.Background(), []string{""}, nil)
+		if len(results) != 1 || results[0].Error != ErrEmptySceneID {
+			t.Errorf("expected ErrEmptySceneID, got %+v", results)
+		}
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if results := client.ExecuteScenesBatch(context.Background(), nil, nil); results != nil {
+			t.Errorf("expected nil results, got %v", results)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		client, _ := NewClient("token")
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results := client.ExecuteScenesBatch(ctx, []string{"scene-1", "scene-2"}, nil)
+		for _, r := range results {
+			if r.Error != context.Canceled {
+				t.Errorf("scene %s: expected context.Canceled, got %v", r.SceneID, r.Error)
+			}
+		}
+	})
+}
+
+func TestClient_AssignDevicesToRoom(t *testing.T) {
+	t.Run("updates each device", func(t *testing.T) {
+		var updates atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/locations/loc-1/rooms/room-1":
+				w.Write([]byte(`{"roomId":"room-1","locationId":"loc-1","name":"Kitchen"}`))
+			case r.Method == http.MethodPut:
+				updates.Add(1)
+				var body map[string]any
+				json.NewDecoder(r.Body).Decode(&body)
+				if body["roomId"] != "room-1" || len(body) != 1 {
+					t.Errorf("body = %v, want only roomId", body)
+				}
+				if r.URL.Path == "/devices/dev-bad" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(`{"deviceId":"dev","roomId":"room-1"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		ids := []string{"dev-1", "dev-bad", "dev-2"}
+		results := client.AssignDevicesToRoom(context.Background(), "loc-1", "room-1", ids)
+
+		if len(results) != 3 {
+			t.Fatalf("expected 3 results, got %d", len(results))
+		}
+		if updates.Load() != 3 {
+			t.Errorf("expected 3 updates, got %d", updates.Load())
+		}
+		for i, want := range ids {
+			if results[i].DeviceID != want {
+				t.Errorf("results[%d].DeviceID = %q, want %q", i, results[i].DeviceID, want)
+			}
+		}
+		if results[0].Error != nil || results[2].Error != nil {
+			t.Errorf("unexpected errors: %v, %v", results[0].Error, results[2].Error)
+		}
+		if !IsNotFound(results[1].Error) {
+			t.Errorf("expected not found error, got %v", results[1].Error)
+		}
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		var updates atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`{"roomId":"room-1","locationId":"loc-1","name":"Kitchen"}`))
+				return
+			}
+			updates.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.AssignDevicesToRoomWithConfig(context.Background(), "loc-1", "room-1",
+			[]string{"dev-1", "dev-2", "dev-3"}, &BatchConfig{MaxConcurrent: 1, StopOnError: true})
+
+		if updates.Load() != 1 {
+			t.Errorf("expected 1 update before stopping, got %d", updates.Load())
+		}
+		var notFound, canceled int
+		for _, r := range results {
+			switch {
+			case IsNotFound(r.Error):
+				notFound++
+			case r.Error == context.Canceled:
+				canceled++
+			}
+		}
+		if notFound != 1 || canceled != 2 {
+			t.Errorf("got %d not found and %d canceled results, want 1 and 2", notFound, canceled)
+		}
+	})
+
+	t.Run("room not found", func(t *testing.T) {
+		var updates atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPut {
+				updates.Add(1)
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.AssignDevicesToRoom(context.Background(), "loc-1", "room-x", []string{"dev-1", "dev-2"})
+		if len(results) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(results))
+		}
+		for _, r := range results {
+			if !IsNotFound(r.Error) {
+				t.Errorf("device %s: expected not found error, got %v", r.DeviceID, r.Error)
+			}
+		}
+		if updates.Load() != 0 {
+			t.Errorf("expected no updates, got %d", updates.Load())
+		}
+	})
+
+	t.Run("empty IDs", func(t *testing.T) {
+		client, _ := NewClient("token")
+		results := client.AssignDevicesToRoom(context.Background(), "", "room-1", []string{"dev-1"})
+		if len(results) != 1 || results[0].Error != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %+v", results)
+		}
+		results = client.AssignDevicesToRoom(context.Background(), "loc-1", "", []string{"dev-1"})
+		if len(results) != 1 || results[0].Error != ErrEmptyRoomID {
+			t.Errorf("expected ErrEmptyRoomID, got %+v", results)
+		}
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if results := client.AssignDevicesToRoom(context.Background(), "loc-1", "room-1", nil); results != nil {
+			t.Errorf("expected nil results, got %v", results)
+		}
+	})
+}
+
 func TestClient_GetDeviceStatusBatch(t *testing.T) {
 	t.Run("empty list returns nil", func(t *testing.T) {
 		client, _ := NewClient("token")
@@ -395,3 +616,170 @@ func TestClient_GetDeviceStatusBatch(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_GetDeviceHealthBatch(t *testing.T) {
+	t.Run("empty list returns nil", func(t *testing.T) {
+		client, _ := NewClient("token")
+		results := client.GetDeviceHealthBatch(context.Background(), nil, nil)
+		if results != nil {
+			t.Error("expected nil for empty list")
+		}
+	})
+
+	t.Run("successful batch fetch", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"deviceId":"device","state":"ONLINE"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.GetDeviceHealthBatch(context.Background(),
+			[]string{"device1", "device2"},
+			nil)
+
+		if len(results) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(results))
+		}
+		for i, r := range results {
+			if r.Error != nil {
+				t.Errorf("result[%d] unexpected error: %v", i, r.Error)
+			}
+			if r.Health == nil || r.Health.State != "ONLINE" {
+				t.Errorf("result[%d] health = %+v, want ONLINE", i, r.Health)
+			}
+		}
+	})
+
+	t.Run("rate limited device is retried", func(t *testing.T) {
+		var device2Calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/devices/device2/health" && device2Calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"state":"ONLINE"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.GetDeviceHealthBatch(context.Background(),
+			[]string{"device1", "device2", "device3"},
+			nil)
+
+		for i, r := range results {
+			if r.Error != nil {
+				t.Errorf("result[%d] unexpected error: %v", i, r.Error)
+			}
+		}
+		if device2Calls.Load() != 2 {
+			t.Errorf("device2 calls = %d, want 2", device2Calls.Load())
+		}
+	})
+
+	t.Run("handles mixed success and failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/devices/device2/health" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"state":"OFFLINE"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		results := client.GetDeviceHealthBatch(context.Background(),
+			[]string{"device1", "device2", "device3"},
+			nil)
+
+		if results[0].Error != nil || results[2].Error != nil {
+			t.Error("device1 and device3 should succeed")
+		}
+		if !IsNotFound(results[1].Error) {
+			t.Errorf("device2 error = %v, want not found", results[1].Error)
+		}
+		if results[1].Health != nil {
+			t.Error("device2 health should be nil on error")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+			w.Write([]byte(`{"state":"ONLINE"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		results := client.GetDeviceHealthBatch(ctx,
+			[]string{"device1", "device2", "device3"},
+			&BatchConfig{MaxConcurrent: 1})
+
+		for i, r := range results {
+			if r.Error == nil {
+				t.Errorf("result[%d] expected error after cancellation", i)
+			}
+		}
+	})
+}
+
+func TestClient_GetLocationInventory(t *testing.T) {
+	t.Run("empty location ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, err := client.GetLocationInventory(context.Background(), "")
+		if err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+
+	t.Run("devices with statuses and partial failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/devices":
+				if got := r.URL.Query().Get("locationId"); got != "loc-1" {
+					t.Errorf("expected locationId loc-1, got %q", got)
+				}
+				w.Write([]byte(`{"items":[{"deviceId":"device1","label":"Lamp"},{"deviceId":"device2","label":"Sensor"}],"_links":{}}`))
+			case "/devices/device1/status":
+				w.Write([]byte(`{"components":{"main":{"switch":{"switch":{"value":"on"}}}}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"message":"not found"}}`))
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		inventory, err := client.GetLocationInventory(context.Background(), "loc-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(inventory) != 2 {
+			t.Fatalf("expected 2 devices, got %d", len(inventory))
+		}
+		if inventory[0].Device.Label != "Lamp" || inventory[0].Error != nil || inventory[0].Components["main"] == nil {
+			t.Errorf("unexpected first entry: %+v", inventory[0])
+		}
+		if inventory[1].Device.DeviceID != "device2" || inventory[1].Error == nil {
+			t.Errorf("expected second entry to carry an error, got %+v", inventory[1])
+		}
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.GetLocationInventory(context.Background(), "loc-1"); err != ErrUnauthorized {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
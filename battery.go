@@ -0,0 +1,43 @@
+package smartthings
+
+// Battery and Power Helpers
+//
+// These helpers cover the SmartThings battery and powerSource capabilities
+// reported by most sensors and other battery-powered devices.
+
+// LowBatteryThreshold is the battery percentage below which
+// ExtractBatteryStatus reports a battery as low.
+var LowBatteryThreshold = 20
+
+// ExtractBatteryStatus extracts the battery level from a device status.
+// Returns nil if the status has no battery level, so a missing battery can be
+// told apart from an empty one.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if battery := smartthings.ExtractBatteryStatus(status); battery != nil && battery.Low {
+//	    fmt.Printf("Battery low: %d%%\n", battery.Percent)
+//	}
+func ExtractBatteryStatus(status Status) *BatteryStatus {
+	// Path: battery.battery.value
+	percent, ok := GetInt(status, "battery", "battery", "value")
+	if !ok {
+		return nil
+	}
+
+	percent = max(0, min(percent, 100))
+	return &BatteryStatus{
+		Percent: percent,
+		Low:     percent < LowBatteryThreshold,
+	}
+}
+
+// ExtractPowerSource extracts the power source of a device, such as "battery",
+// "mains", "dc", or "unknown". Returns an empty string if the status has no
+// powerSource capability.
+func ExtractPowerSource(status Status) string {
+	// Path: powerSource.powerSource.value
+	source, _ := GetString(status, "powerSource", "powerSource", "value")
+	return source
+}
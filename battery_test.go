@@ -0,0 +1,63 @@
+package smartthings
+
+import "testing"
+
+func TestExtractBatteryStatus(t *testing.T) {
+	battery := func(v any) Status {
+		return Status{"battery": map[string]any{"battery": map[string]any{"value": v}}}
+	}
+
+	tests := []struct {
+		name        string
+		status      Status
+		wantPercent int
+		wantLow     bool
+	}{
+		{"healthy", battery(85.0), 85, false},
+		{"at threshold", battery(20.0), 20, false},
+		{"low", battery(19.0), 19, true},
+		{"empty", battery(0.0), 0, true},
+		{"clamped", battery(150.0), 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractBatteryStatus(tt.status)
+			if result == nil {
+				t.Fatal("expected non-nil result")
+			}
+			if result.Percent != tt.wantPercent {
+				t.Errorf("Percent = %d, want %d", result.Percent, tt.wantPercent)
+			}
+			if result.Low != tt.wantLow {
+				t.Errorf("Low = %v, want %v", result.Low, tt.wantLow)
+			}
+		})
+	}
+
+	t.Run("missing capability", func(t *testing.T) {
+		if result := ExtractBatteryStatus(Status{"switch": map[string]any{}}); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+
+	t.Run("custom threshold", func(t *testing.T) {
+		orig := LowBatteryThreshold
+		defer func() { LowBatteryThreshold = orig }()
+		LowBatteryThreshold = 50
+
+		if result := ExtractBatteryStatus(battery(40.0)); result == nil || !result.Low {
+			t.Errorf("expected low battery with threshold 50, got %+v", result)
+		}
+	})
+}
+
+func TestExtractPowerSource(t *testing.T) {
+	status := Status{"powerSource": map[string]any{"powerSource": map[string]any{"value": "mains"}}}
+	if got := ExtractPowerSource(status); got != "mains" {
+		t.Errorf("ExtractPowerSource() = %q, want %q", got, "mains")
+	}
+	if got := ExtractPowerSource(Status{}); got != "" {
+		t.Errorf("ExtractPowerSource() = %q, want empty", got)
+	}
+}
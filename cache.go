@@ -132,6 +132,15 @@ type CacheConfig struct {
 	// DeviceProfileTTL is how long to cache device profiles.
 	// Defaults to 1 hour if zero.
 	DeviceProfileTTL time.Duration
+
+	// DeviceTTL is how long to cache devices and their ETags for conditional requests.
+	// Defaults to 5 minutes if zero.
+	DeviceTTL time.Duration
+
+	// DeviceStatusTTL is how long to cache GetDeviceStatusAllComponents results.
+	// Keep it short, since device status changes frequently.
+	// Defaults to 10 seconds if zero.
+	DeviceStatusTTL time.Duration
 }
 
 // DefaultCacheConfig returns a CacheConfig with sensible defaults.
@@ -140,6 +149,8 @@ func DefaultCacheConfig() *CacheConfig {
 		Cache:            NewMemoryCache(),
 		CapabilityTTL:    1 * time.Hour,
 		DeviceProfileTTL: 1 * time.Hour,
+		DeviceTTL:        5 * time.Minute,
+		DeviceStatusTTL:  10 * time.Second,
 	}
 }
 
@@ -153,7 +164,9 @@ func cacheKey(resourceType string, ids ...string) string {
 }
 
 // WithCache enables response caching for the client.
-// Cached resources include capability definitions and device profiles.
+// Cached resources include capability definitions, device profiles, devices,
+// and GetDeviceStatusAllComponents results. Cached devices are revalidated with
+// ETags on each GetDevice call.
 //
 // Example:
 //
@@ -174,6 +187,12 @@ func WithCache(config *CacheConfig) Option {
 		if config.DeviceProfileTTL == 0 {
 			config.DeviceProfileTTL = 1 * time.Hour
 		}
+		if config.DeviceTTL == 0 {
+			config.DeviceTTL = 5 * time.Minute
+		}
+		if config.DeviceStatusTTL == 0 {
+			config.DeviceStatusTTL = 10 * time.Second
+		}
 		c.cacheConfig = config
 	}
 }
@@ -201,6 +220,7 @@ func (c *Client) getCached(key string, ttl time.Duration, fetch func() (any, err
 
 // InvalidateCapabilityCache removes all cached capability entries.
 func (c *Client) InvalidateCapabilityCache() {
+	c.commandCaps.Clear()
 	if c.cacheConfig != nil && c.cacheConfig.Cache != nil {
 		// Clear all entries (we don't have a prefix-based delete)
 		c.cacheConfig.Cache.Clear()
@@ -208,8 +228,19 @@ func (c *Client) InvalidateCapabilityCache() {
 }
 
 // InvalidateCache removes a specific entry from the cache.
+// The resource types and their identifiers are:
+//   - "capability": capability ID and version (e.g. "switch", "1")
+//   - "deviceprofile": profile ID
+//   - "device": device ID
+//   - "deviceStatus": device ID (see InvalidateDeviceStatus)
 func (c *Client) InvalidateCache(resourceType string, ids ...string) {
 	if c.cacheConfig != nil && c.cacheConfig.Cache != nil {
 		c.cacheConfig.Cache.Delete(cacheKey(resourceType, ids...))
 	}
 }
+
+// InvalidateDeviceStatus removes the cached GetDeviceStatusAllComponents result
+// for a device, e.g. to see the effect of a command immediately.
+func (c *Client) InvalidateDeviceStatus(deviceID string) {
+	c.InvalidateCache("deviceStatus", deviceID)
+}
@@ -202,6 +202,9 @@ func TestDefaultCacheConfig(t *testing.T) {
 	if config.DeviceProfileTTL != time.Hour {
 		t.Errorf("expected 1 hour device profile TTL, got %v", config.DeviceProfileTTL)
 	}
+	if config.DeviceStatusTTL != 10*time.Second {
+		t.Errorf("expected 10 second device status TTL, got %v", config.DeviceStatusTTL)
+	}
 }
 
 func TestCacheKey(t *testing.T) {
@@ -280,7 +283,7 @@ func TestGetCapabilityWithCache(t *testing.T) {
 	defer server.Close()
 
 	client, _ := NewClient("test-token",
-		WithBaseURL(server.URL),
+		WithBaseURL(server.URL), WithForceRemoteCapabilities(),
 		WithCache(DefaultCacheConfig()),
 	)
 
@@ -383,7 +386,7 @@ func TestGetCached_FetchError(t *testing.T) {
 	defer server.Close()
 
 	client, _ := NewClient("test-token",
-		WithBaseURL(server.URL),
+		WithBaseURL(server.URL), WithForceRemoteCapabilities(),
 		WithCache(DefaultCacheConfig()),
 	)
 
@@ -406,7 +409,7 @@ func TestGetCapabilityWithoutCache(t *testing.T) {
 	defer server.Close()
 
 	// Client without cache
-	client, _ := NewClient("test-token", WithBaseURL(server.URL))
+	client, _ := NewClient("test-token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
 
 	ctx := context.Background()
 
@@ -430,7 +433,7 @@ func TestClient_InvalidateCapabilityCache(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, _ := NewClient("test-token", WithBaseURL(server.URL), WithCache(config))
+	client, _ := NewClient("test-token", WithBaseURL(server.URL), WithForceRemoteCapabilities(), WithCache(config))
 
 	ctx := context.Background()
 
@@ -455,3 +458,58 @@ func TestClient_InvalidateCapabilityCache(t *testing.T) {
 		t.Errorf("expected 2 server calls after invalidation, got %d", callCount)
 	}
 }
+
+func TestGetDeviceStatusAllComponentsWithCache(t *testing.T) {
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if r.URL.Path != "/devices/fridge-1/status" {
+			t.Errorf("path = %q, want %q", r.URL.Path, "/devices/fridge-1/status")
+		}
+		w.Write([]byte(`{"components":{"main":{"switch":{"switch":{"value":"on"}}},"freezer":{"temperatureMeasurement":{"temperature":{"value":-18}}}}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", WithBaseURL(server.URL), WithCache(DefaultCacheConfig()))
+	ctx := context.Background()
+
+	status, err := client.GetDeviceStatusAllComponents(ctx, "fridge-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(status) != 2 {
+		t.Errorf("got %d components, want 2", len(status))
+	}
+
+	// Mutating the result must not affect the cached copy.
+	delete(status, "main")
+
+	status, _ = client.GetDeviceStatusAllComponents(ctx, "fridge-1")
+	if callCount != 1 {
+		t.Errorf("expected 1 server call (cached), got %d", callCount)
+	}
+	if _, ok := status["main"]; !ok {
+		t.Error("cached status was modified by caller")
+	}
+
+	// Nor may mutating a nested attribute map.
+	status["main"].(Status)["switch"].(map[string]any)["switch"] = map[string]any{"value": "off"}
+
+	status, _ = client.GetDeviceStatusAllComponents(ctx, "fridge-1")
+	if v, _ := GetString(status["main"].(Status), "switch", "switch", "value"); v != "on" {
+		t.Errorf("cached switch value = %q after caller mutation, want %q", v, "on")
+	}
+
+	client.InvalidateDeviceStatus("fridge-1")
+	client.GetDeviceStatusAllComponents(ctx, "fridge-1")
+	if callCount != 2 {
+		t.Errorf("expected 2 server calls after InvalidateDeviceStatus, got %d", callCount)
+	}
+
+	// The generic resource type is equivalent.
+	client.InvalidateCache("deviceStatus", "fridge-1")
+	client.GetDeviceStatusAllComponents(ctx, "fridge-1")
+	if callCount != 3 {
+		t.Errorf("expected 3 server calls after InvalidateCache, got %d", callCount)
+	}
+}
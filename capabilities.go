@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 )
@@ -18,6 +19,17 @@ type Capability struct {
 	Commands   map[string]CapabilityCommand   `json:"commands,omitempty"`
 }
 
+// IsWritable reports whether the capability defines any commands, i.e.
+// whether its state can be changed rather than only read.
+func (c *Capability) IsWritable() bool {
+	return len(c.Commands) > 0
+}
+
+// IsDeprecated reports whether this capability version is deprecated.
+func (c *Capability) IsDeprecated() bool {
+	return c.Status == "deprecated"
+}
+
 // CapabilityReference is a lightweight reference to a capability.
 type CapabilityReference struct {
 	ID      string `json:"id"`
@@ -58,30 +70,36 @@ type CapabilityCommandArgument struct {
 // capabilityListResponse is the API response for listing capabilities.
 type capabilityListResponse struct {
 	Items []CapabilityReference `json:"items"`
+	Links Links                 `json:"_links,omitempty"`
 }
 
-// ListCapabilities returns all available capabilities.
+// ListCapabilities returns all available capabilities, fetching every page.
 func (c *Client) ListCapabilities(ctx context.Context) ([]CapabilityReference, error) {
-	data, err := c.get(ctx, "/capabilities")
-	if err != nil {
-		return nil, err
-	}
-
-	var resp capabilityListResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("failed to parse capability list: %w (body: %s)", err, truncatePreview(data))
+	var caps []CapabilityReference
+	for cap, err := range c.Capabilities(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		caps = append(caps, cap)
 	}
-
-	return resp.Items, nil
+	return caps, nil
 }
 
 // GetCapability returns a specific capability definition.
 // If version is 0, returns the latest version.
+// Well-known standard capabilities are answered from the embedded registry
+// (see LookupLocalCapability) without an API call, unless the client was
+// created with WithForceRemoteCapabilities.
 // Results are cached if caching is enabled.
 func (c *Client) GetCapability(ctx context.Context, capabilityID string, version int) (*Capability, error) {
 	if capabilityID == "" {
 		return nil, ErrEmptyCapabilityID
 	}
+	if !c.forceRemoteCaps {
+		if capability, ok := LookupLocalCapability(capabilityID, version); ok {
+			return capability, nil
+		}
+	}
 
 	path := "/capabilities/" + capabilityID
 	if version > 0 {
@@ -140,13 +158,32 @@ const (
 type ListCapabilitiesOptions struct {
 	// Namespace filters capabilities by namespace ("st" for standard, "custom" for custom).
 	Namespace CapabilityNamespace
+	Max       int // Max results per page (server default if zero)
+	Page      int // Page number (0-based)
 }
 
-// ListCapabilitiesWithOptions returns capabilities with filtering options.
+// ListCapabilitiesWithOptions returns a single page of capabilities with
+// filtering options. Use CapabilitiesWithOptions to iterate over every page.
 func (c *Client) ListCapabilitiesWithOptions(ctx context.Context, opts *ListCapabilitiesOptions) ([]CapabilityReference, error) {
+	resp, err := c.listCapabilitiesPage(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
+
+// listCapabilitiesPage fetches one page of capabilities, including its links.
+func (c *Client) listCapabilitiesPage(ctx context.Context, opts *ListCapabilitiesOptions) (*capabilityListResponse, error) {
 	path := "/capabilities"
-	if opts != nil && opts.Namespace != "" {
-		path += "?namespace=" + string(opts.Namespace)
+	if opts != nil {
+		params := url.Values{}
+		if opts.Namespace != "" {
+			params.Set("namespace", string(opts.Namespace))
+		}
+		listOpts := ListOptions{Max: opts.Max, Page: opts.Page}
+		if encoded := listOpts.encode(params); encoded != "" {
+			path += "?" + encoded
+		}
 	}
 
 	data, err := c.get(ctx, path)
@@ -159,6 +196,84 @@ func (c *Client) ListCapabilitiesWithOptions(ctx context.Context, opts *ListCapa
 		return nil, fmt.Errorf("failed to parse capability list: %w (body: %s)", err, truncatePreview(data))
 	}
 
-	return resp.Items, nil
+	return &resp, nil
+}
+
+// CapabilityLocalization contains the translated strings for a capability
+// in a single locale.
+type CapabilityLocalization struct {
+	Tag         string                                     `json:"tag"` // Locale tag, e.g., "en", "es"
+	Label       string                                     `json:"label,omitempty"`
+	Description string                                     `json:"description,omitempty"`
+	Attributes  map[string]CapabilityAttributeLocalization `json:"attributes,omitempty"`
+	Commands    map[string]CapabilityCommandLocalization   `json:"commands,omitempty"`
+}
+
+// CapabilityAttributeLocalization contains the translated strings for a capability attribute.
+type CapabilityAttributeLocalization struct {
+	Label           string `json:"label,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DisplayTemplate string `json:"displayTemplate,omitempty"`
+	// I18n maps attribute properties (e.g., "value") to translations of their enum values.
+	I18n map[string]map[string]LocalizedValue `json:"i18n,omitempty"`
 }
 
+// CapabilityCommandLocalization contains the translated strings for a capability command.
+type CapabilityCommandLocalization struct {
+	Label       string                    `json:"label,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Arguments   map[string]LocalizedValue `json:"arguments,omitempty"`
+}
+
+// LocalizedValue is a translated label and description for a single value or argument.
+type LocalizedValue struct {
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetCapabilityLocalization returns the translations of a capability for a locale.
+func (c *Client) GetCapabilityLocalization(ctx context.Context, capabilityID string, version int, locale string) (*CapabilityLocalization, error) {
+	if capabilityID == "" {
+		return nil, ErrEmptyCapabilityID
+	}
+	if version <= 0 {
+		return nil, ErrInvalidCapabilityVersion
+	}
+	if locale == "" {
+		return nil, ErrEmptyLocaleTag
+	}
+
+	data, err := c.get(ctx, "/capabilities/"+capabilityID+"/"+strconv.Itoa(version)+"/i18n/"+locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var localization CapabilityLocalization
+	if err := json.Unmarshal(data, &localization); err != nil {
+		return nil, fmt.Errorf("failed to parse capability localization: %w (body: %s)", err, truncatePreview(data))
+	}
+
+	return &localization, nil
+}
+
+// ListCapabilityLocalizations returns the locales a capability has been translated into.
+func (c *Client) ListCapabilityLocalizations(ctx context.Context, capabilityID string, version int) ([]LocaleReference, error) {
+	if capabilityID == "" {
+		return nil, ErrEmptyCapabilityID
+	}
+	if version <= 0 {
+		return nil, ErrInvalidCapabilityVersion
+	}
+
+	data, err := c.get(ctx, "/capabilities/"+capabilityID+"/"+strconv.Itoa(version)+"/i18n")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp localeReferenceListResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse capability localization list: %w (body: %s)", err, truncatePreview(data))
+	}
+
+	return resp.Items, nil
+}
@@ -57,6 +57,26 @@ func TestClient_ListCapabilities(t *testing.T) {
 		}
 	})
 
+	t.Run("multiple pages", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			resp := capabilityListResponse{Items: []CapabilityReference{{ID: "switch", Version: 1}}, Links: Links{Next: "/capabilities?page=1"}}
+			if r.URL.Query().Get("page") == "1" {
+				resp = capabilityListResponse{Items: []CapabilityReference{{ID: "switchLevel", Version: 1}}}
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		caps, err := client.ListCapabilities(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(caps) != 2 || caps[1].ID != "switchLevel" {
+			t.Errorf("got %+v, want both pages", caps)
+		}
+	})
+
 	t.Run("invalid JSON", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte("not json"))
@@ -99,7 +119,7 @@ func TestClient_GetCapability(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client, _ := NewClient("token", WithBaseURL(server.URL))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
 		cap, err := client.GetCapability(context.Background(), "switch", 1)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -140,7 +160,7 @@ func TestClient_GetCapability(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client, _ := NewClient("token", WithBaseURL(server.URL))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
 		cap, err := client.GetCapability(context.Background(), "temperatureMeasurement", 0)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -164,7 +184,7 @@ func TestClient_GetCapability(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client, _ := NewClient("token", WithBaseURL(server.URL))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
 		_, err := client.GetCapability(context.Background(), "nonexistent", 1)
 		if !IsNotFound(err) {
 			t.Errorf("expected not found error, got %v", err)
@@ -210,7 +230,7 @@ func TestClient_GetCapability(t *testing.T) {
 		}))
 		defer server.Close()
 
-		client, _ := NewClient("token", WithBaseURL(server.URL))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
 		cap, err := client.GetCapability(context.Background(), "audioVolume", 1)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -233,6 +253,43 @@ func ptrFloat64(f float64) *float64 {
 	return &f
 }
 
+func TestCapability_IsWritable(t *testing.T) {
+	tests := []struct {
+		name           string
+		capability     Capability
+		wantWritable   bool
+		wantDeprecated bool
+	}{
+		{
+			name: "actuator",
+			capability: Capability{ID: "switch", Status: "live", Commands: map[string]CapabilityCommand{
+				"on": {Name: "on"}, "off": {Name: "off"},
+			}},
+			wantWritable: true,
+		},
+		{
+			name:       "sensor",
+			capability: Capability{ID: "temperatureMeasurement", Status: "live"},
+		},
+		{
+			name:           "deprecated",
+			capability:     Capability{ID: "oldSensor", Status: "deprecated"},
+			wantDeprecated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.capability.IsWritable(); got != tt.wantWritable {
+				t.Errorf("IsWritable() = %v, want %v", got, tt.wantWritable)
+			}
+			if got := tt.capability.IsDeprecated(); got != tt.wantDeprecated {
+				t.Errorf("IsDeprecated() = %v, want %v", got, tt.wantDeprecated)
+			}
+		})
+	}
+}
+
 func TestClient_ListCapabilitiesWithOptions(t *testing.T) {
 	t.Run("with namespace filter", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -290,6 +347,34 @@ func TestClient_ListCapabilitiesWithOptions(t *testing.T) {
 		}
 	})
 
+	t.Run("with max and page", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("namespace") != "custom" || q.Get("max") != "50" || q.Get("page") != "2" {
+				t.Errorf("query = %q, want namespace=custom, max=50, page=2", r.URL.RawQuery)
+			}
+			resp := capabilityListResponse{
+				Items: []CapabilityReference{{ID: "custom.one", Version: 1}},
+				Links: Links{Next: "/capabilities?page=3"},
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		caps, err := client.ListCapabilitiesWithOptions(context.Background(), &ListCapabilitiesOptions{
+			Namespace: CapabilityNamespaceCustom,
+			Max:       50,
+			Page:      2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(caps) != 1 {
+			t.Errorf("got %d capabilities, want 1 (a single page)", len(caps))
+		}
+	})
+
 	t.Run("without options", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if ns := r.URL.Query().Get("namespace"); ns != "" {
@@ -314,3 +399,120 @@ func TestClient_ListCapabilitiesWithOptions(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_GetCapabilityLocalization(t *testing.T) {
+	t.Run("successful response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/capabilities/switch/1/i18n/es" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/capabilities/switch/1/i18n/es")
+			}
+			w.Write([]byte(`{
+				"tag": "es",
+				"label": "Interruptor",
+				"attributes": {
+					"switch": {
+						"label": "Interruptor",
+						"description": "Estado del interruptor",
+						"i18n": {"value": {"on": {"label": "Encendido"}, "off": {"label": "Apagado"}}}
+					}
+				},
+				"commands": {
+					"on": {"label": "Encender", "description": "Enciende el dispositivo"}
+				}
+			}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		loc, err := client.GetCapabilityLocalization(context.Background(), "switch", 1, "es")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if loc.Tag != "es" {
+			t.Errorf("Tag = %q, want %q", loc.Tag, "es")
+		}
+		if got := loc.Attributes["switch"].Label; got != "Interruptor" {
+			t.Errorf("attribute label = %q, want %q", got, "Interruptor")
+		}
+		if got := loc.Attributes["switch"].I18n["value"]["on"].Label; got != "Encendido" {
+			t.Errorf("value label = %q, want %q", got, "Encendido")
+		}
+		if got := loc.Commands["on"].Description; got != "Enciende el dispositivo" {
+			t.Errorf("command description = %q, want %q", got, "Enciende el dispositivo")
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		tests := []struct {
+			name    string
+			id      string
+			version int
+			locale  string
+			want    error
+		}{
+			{"empty capability ID", "", 1, "es", ErrEmptyCapabilityID},
+			{"zero version", "switch", 0, "es", ErrInvalidCapabilityVersion},
+			{"empty locale", "switch", 1, "", ErrEmptyLocaleTag},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := client.GetCapabilityLocalization(context.Background(), tt.id, tt.version, tt.locale)
+				if err != tt.want {
+					t.Errorf("expected %v, got %v", tt.want, err)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.GetCapabilityLocalization(context.Background(), "switch", 1, "es"); err == nil {
+			t.Error("expected error for invalid JSON")
+		}
+	})
+}
+
+func TestClient_ListCapabilityLocalizations(t *testing.T) {
+	t.Run("successful response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/capabilities/switch/1/i18n" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/capabilities/switch/1/i18n")
+			}
+			json.NewEncoder(w).Encode(localeReferenceListResponse{
+				Items: []LocaleReference{{Tag: "en"}, {Tag: "es"}},
+			})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		locales, err := client.ListCapabilityLocalizations(context.Background(), "switch", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(locales) != 2 || locales[1].Tag != "es" {
+			t.Errorf("locales = %v, want [en es]", locales)
+		}
+	})
+
+	t.Run("empty capability ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, err := client.ListCapabilityLocalizations(context.Background(), "", 1)
+		if err != ErrEmptyCapabilityID {
+			t.Errorf("expected ErrEmptyCapabilityID, got %v", err)
+		}
+	})
+
+	t.Run("zero version", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, err := client.ListCapabilityLocalizations(context.Background(), "switch", 0)
+		if err != ErrInvalidCapabilityVersion {
+			t.Errorf("expected ErrInvalidCapabilityVersion, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package smartthings
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+)
+
+// capabilityRegistryJSON holds version 1 of common standard capabilities,
+// in the same format the capabilities API returns.
+//
+//go:embed capabilityregistry.json
+var capabilityRegistryJSON []byte
+
+// localCapabilities indexes the embedded registry by capability ID.
+var localCapabilities = sync.OnceValue(func() map[string]json.RawMessage {
+	var defs []json.RawMessage
+	if err := json.Unmarshal(capabilityRegistryJSON, &defs); err != nil {
+		panic("smartthings: invalid embedded capability registry: " + err.Error())
+	}
+	registry := make(map[string]json.RawMessage, len(defs))
+	for _, def := range defs {
+		var ref CapabilityReference
+		if err := json.Unmarshal(def, &ref); err != nil {
+			panic("smartthings: invalid embedded capability registry: " + err.Error())
+		}
+		registry[ref.ID] = def
+	}
+	return registry
+})
+
+// WithForceRemoteCapabilities makes GetCapability always fetch definitions
+// from the API instead of answering well-known capabilities from the
+// embedded registry.
+func WithForceRemoteCapabilities() Option {
+	return func(c *Client) {
+		c.forceRemoteCaps = true
+	}
+}
+
+// LookupLocalCapability returns a capability definition from the embedded
+// registry of common standard capabilities (switch, switchLevel,
+// colorControl, and others), without making an API call. A version of 0
+// matches the registry's latest version. Returns false for capabilities or
+// versions the registry does not contain.
+//
+// Each call returns a new copy that the caller may modify.
+func LookupLocalCapability(id string, version int) (*Capability, bool) {
+	def, ok := localCapabilities()[id]
+	if !ok {
+		return nil, false
+	}
+	var capability Capability
+	if err := json.Unmarshal(def, &capability); err != nil {
+		return nil, false
+	}
+	if version != 0 && version != capability.Version {
+		return nil, false
+	}
+	return &capability, true
+}
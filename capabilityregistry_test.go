@@ -0,0 +1,105 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLookupLocalCapability(t *testing.T) {
+	t.Run("well-known capability", func(t *testing.T) {
+		capability, ok := LookupLocalCapability("switchLevel", 0)
+		if !ok {
+			t.Fatal("expected switchLevel in registry")
+		}
+		if capability.ID != "switchLevel" || capability.Version != 1 || capability.Status != "live" {
+			t.Errorf("capability = %+v", capability)
+		}
+		level, ok := capability.Attributes["level"]
+		if !ok || level.Setter != "setLevel" || level.Schema.Maximum == nil || *level.Schema.Maximum != 100 {
+			t.Errorf("level attribute = %+v", level)
+		}
+		if !capability.IsWritable() {
+			t.Error("switchLevel should be writable")
+		}
+	})
+
+	t.Run("version must match", func(t *testing.T) {
+		if _, ok := LookupLocalCapability("switch", 1); !ok {
+			t.Error("expected switch version 1")
+		}
+		if _, ok := LookupLocalCapability("switch", 2); ok {
+			t.Error("expected no switch version 2")
+		}
+	})
+
+	t.Run("unknown capability", func(t *testing.T) {
+		if _, ok := LookupLocalCapability("custom.picturemode", 0); ok {
+			t.Error("expected custom capability not to be in registry")
+		}
+	})
+
+	t.Run("returns independent copies", func(t *testing.T) {
+		first, _ := LookupLocalCapability("switch", 1)
+		delete(first.Commands, "on")
+		second, _ := LookupLocalCapability("switch", 1)
+		if _, ok := second.Commands["on"]; !ok {
+			t.Error("modifying a returned capability changed the registry")
+		}
+	})
+
+	t.Run("all entries are valid", func(t *testing.T) {
+		for id := range localCapabilities() {
+			capability, ok := LookupLocalCapability(id, 0)
+			if !ok || capability.ID != id || capability.Version == 0 || capability.Name == "" {
+				t.Errorf("invalid registry entry %q: %+v", id, capability)
+			}
+		}
+	})
+}
+
+func TestClient_GetCapability_LocalRegistry(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		json.NewEncoder(w).Encode(Capability{ID: "switch", Version: 1, Name: "Remote Switch"})
+	}))
+	defer server.Close()
+
+	t.Run("answers well-known capabilities locally", func(t *testing.T) {
+		requests = nil
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		capability, err := client.GetCapability(context.Background(), "switch", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if capability.Name != "Switch" || len(requests) != 0 {
+			t.Errorf("Name = %q, requests = %v, want local definition", capability.Name, requests)
+		}
+	})
+
+	t.Run("fetches other capabilities remotely", func(t *testing.T) {
+		requests = nil
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.GetCapability(context.Background(), "switch", 2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(requests) != 1 || requests[0] != "/capabilities/switch/2" {
+			t.Errorf("requests = %v", requests)
+		}
+	})
+
+	t.Run("WithForceRemoteCapabilities", func(t *testing.T) {
+		requests = nil
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithForceRemoteCapabilities())
+		capability, err := client.GetCapability(context.Background(), "switch", 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if capability.Name != "Remote Switch" || len(requests) != 1 {
+			t.Errorf("Name = %q, requests = %v, want remote definition", capability.Name, requests)
+		}
+	})
+}
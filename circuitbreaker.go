@@ -0,0 +1,192 @@
+package smartthings
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a client's circuit breaker.
+type CircuitState string
+
+const (
+	// CircuitClosed lets requests through. It is also the state of clients
+	// without a circuit breaker.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen fails requests with ErrCircuitOpen without sending them.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single trial request through after the
+	// cooldown; its outcome closes or reopens the circuit.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// CircuitBreakerConfig configures WithCircuitBreaker.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests that
+	// opens the circuit (default: 5).
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before a trial request is
+	// let through (default: 30s).
+	Cooldown time.Duration
+	// OnStateChange, if set, is called after each state change. It must not
+	// block or make requests with the same client.
+	OnStateChange func(from, to CircuitState)
+}
+
+// WithCircuitBreaker stops sending requests during a sustained outage.
+// After FailureThreshold consecutive failures the circuit opens and every
+// request fails immediately with an error wrapping ErrCircuitOpen. Once
+// Cooldown has passed, the next request is sent as a trial: if it succeeds
+// the circuit closes, otherwise it opens for another cooldown.
+//
+// Failures are network errors, timeouts, 429 responses, and 5xx responses
+// other than 503, which reports an offline device rather than an outage.
+// Each HTTP attempt counts, including retries made by WithRetry; retries
+// stop as soon as the circuit opens. Requests canceled by their context do
+// not count.
+//
+// Example:
+//
+//	client, _ := st.NewClient(token,
+//	    st.WithRetry(st.DefaultRetryConfig()),
+//	    st.WithCircuitBreaker(st.CircuitBreakerConfig{FailureThreshold: 10, Cooldown: time.Minute}),
+//	)
+func WithCircuitBreaker(cfg CircuitBreakerConfig) Option {
+	return func(c *Client) {
+		if cfg.FailureThreshold <= 0 {
+			cfg.FailureThreshold = 5
+		}
+		if cfg.Cooldown <= 0 {
+			cfg.Cooldown = 30 * time.Second
+		}
+		c.breaker = &circuitBreaker{cfg: cfg, state: CircuitClosed}
+	}
+}
+
+// CircuitState returns the state of the client's circuit breaker, or
+// CircuitClosed if it has none. An open circuit whose cooldown has passed
+// reports CircuitHalfOpen.
+func (c *Client) CircuitState() CircuitState {
+	if c.breaker == nil {
+		return CircuitClosed
+	}
+	b := c.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && !time.Now().Before(b.openUntil) {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// circuitDone records the outcome of a request with the circuit breaker, if any.
+func (c *Client) circuitDone(trial bool, outcome circuitOutcome) {
+	if c.breaker != nil {
+		c.breaker.done(trial, outcome)
+	}
+}
+
+// circuitBreaker tracks consecutive failures for WithCircuitBreaker.
+type circuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int       // Consecutive failures while closed
+	openUntil time.Time // End of the cooldown while open
+	trial     bool      // A half-open trial request is in flight
+}
+
+// circuitOutcome is the result of a request as seen by the circuit breaker.
+type circuitOutcome int
+
+const (
+	circuitSuccess circuitOutcome = iota
+	circuitFailure
+	circuitIgnored // Canceled by the caller; says nothing about the API
+)
+
+// allow reports whether a request may be sent, returning an error wrapping
+// ErrCircuitOpen if not, and whether it is the half-open trial request.
+// Every allowed request must be followed by done.
+func (b *circuitBreaker) allow() (trial bool, err error) {
+	b.mu.Lock()
+	var from CircuitState
+	switch b.state {
+	case CircuitOpen:
+		if wait := time.Until(b.openUntil); wait > 0 {
+			b.mu.Unlock()
+			return false, fmt.Errorf("%w: retry in %s", ErrCircuitOpen, wait.Round(time.Millisecond))
+		}
+		from = b.transition(CircuitHalfOpen)
+		b.trial = true
+	case CircuitHalfOpen:
+		if b.trial {
+			b.mu.Unlock()
+			return false, fmt.Errorf("%w: trial request in progress", ErrCircuitOpen)
+		}
+		b.trial = true
+	}
+	trial = b.trial
+	b.mu.Unlock()
+	b.notify(from, CircuitHalfOpen)
+	return trial, nil
+}
+
+// done records the outcome of a request allowed by allow. Requests that
+// were allowed before the circuit opened only count while it is closed.
+func (b *circuitBreaker) done(trial bool, outcome circuitOutcome) {
+	b.mu.Lock()
+	var from, to CircuitState
+	switch {
+	case trial:
+		b.trial = false
+		switch outcome {
+		case circuitSuccess:
+			b.failures = 0
+			from, to = b.transition(CircuitClosed), CircuitClosed
+		case circuitFailure:
+			b.openUntil = time.Now().Add(b.cfg.Cooldown)
+			from, to = b.transition(CircuitOpen), CircuitOpen
+		}
+	case b.state != CircuitClosed:
+		// Sent before the circuit opened; the outcome is stale.
+	case outcome == circuitSuccess:
+		b.failures = 0
+	case outcome == circuitFailure:
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.failures = 0
+			b.openUntil = time.Now().Add(b.cfg.Cooldown)
+			from, to = b.transition(CircuitOpen), CircuitOpen
+		}
+	}
+	b.mu.Unlock()
+	b.notify(from, to)
+}
+
+// transition sets the state and returns the previous one. b.mu must be held.
+func (b *circuitBreaker) transition(to CircuitState) CircuitState {
+	from := b.state
+	b.state = to
+	return from
+}
+
+// notify calls OnStateChange if from is a real change. b.mu must not be held.
+func (b *circuitBreaker) notify(from, to CircuitState) {
+	if from != "" && from != to && b.cfg.OnStateChange != nil {
+		b.cfg.OnStateChange(from, to)
+	}
+}
+
+// circuitOutcomeFor classifies a response by its status code.
+func circuitOutcomeFor(code int) circuitOutcome {
+	switch {
+	case code == http.StatusTooManyRequests:
+		return circuitFailure
+	case code >= 500 && code != http.StatusServiceUnavailable:
+		return circuitFailure
+	}
+	return circuitSuccess
+}
@@ -0,0 +1,231 @@
+package smartthings
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// circuitServer responds with the status code in *code, counting requests.
+func circuitServer(t *testing.T, code *atomic.Int32, hits *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(int(code.Load()))
+		w.Write([]byte(`{"items": []}`))
+	}))
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	var code, hits atomic.Int32
+	code.Store(http.StatusInternalServerError)
+	server := circuitServer(t, &code, &hits)
+	defer server.Close()
+
+	var mu sync.Mutex
+	var changes []string
+	client, _ := NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 3,
+		Cooldown:         50 * time.Millisecond,
+		OnStateChange: func(from, to CircuitState) {
+			mu.Lock()
+			changes = append(changes, string(from)+"->"+string(to))
+			mu.Unlock()
+		},
+	}))
+	ctx := context.Background()
+
+	for i := range 3 {
+		if _, err := client.ListDevices(ctx); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d: expected API error, got %v", i, err)
+		}
+	}
+	if state := client.CircuitState(); state != CircuitOpen {
+		t.Fatalf("CircuitState() = %q after 3 failures, want open", state)
+	}
+
+	if _, err := client.ListDevices(ctx); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen while open, got %v", err)
+	}
+	if hits.Load() != 3 {
+		t.Errorf("server hits = %d, want 3 (open circuit must not send)", hits.Load())
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if state := client.CircuitState(); state != CircuitHalfOpen {
+		t.Fatalf("CircuitState() = %q after cooldown, want half-open", state)
+	}
+
+	// Failed trial reopens the circuit
+	if _, err := client.ListDevices(ctx); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected trial request to be sent and fail, got %v", err)
+	}
+	if state := client.CircuitState(); state != CircuitOpen {
+		t.Fatalf("CircuitState() = %q after failed trial, want open", state)
+	}
+
+	// Successful trial closes it
+	time.Sleep(60 * time.Millisecond)
+	code.Store(http.StatusOK)
+	if _, err := client.ListDevices(ctx); err != nil {
+		t.Fatalf("unexpected error from trial: %v", err)
+	}
+	if state := client.CircuitState(); state != CircuitClosed {
+		t.Errorf("CircuitState() = %q after successful trial, want closed", state)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if !slices.Equal(changes, want) {
+		t.Errorf("state changes = %v, want %v", changes, want)
+	}
+}
+
+func TestWithCircuitBreaker_Failures(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  int
+		opens bool
+	}{
+		{"server error", http.StatusBadGateway, true},
+		{"rate limited", http.StatusTooManyRequests, true},
+		{"device offline", http.StatusServiceUnavailable, false},
+		{"not found", http.StatusNotFound, false},
+		{"bad request", http.StatusBadRequest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var code, hits atomic.Int32
+			code.Store(int32(tt.code))
+			server := circuitServer(t, &code, &hits)
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2}))
+			for range 2 {
+				client.ListDevices(context.Background())
+			}
+			if got := client.CircuitState() == CircuitOpen; got != tt.opens {
+				t.Errorf("circuit open = %v, want %v", got, tt.opens)
+			}
+		})
+	}
+
+	t.Run("success resets the count", func(t *testing.T) {
+		var code, hits atomic.Int32
+		server := circuitServer(t, &code, &hits)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2}))
+		for _, c := range []int{http.StatusBadGateway, http.StatusOK, http.StatusBadGateway, http.StatusOK} {
+			code.Store(int32(c))
+			client.ListDevices(context.Background())
+		}
+		if state := client.CircuitState(); state != CircuitClosed {
+			t.Errorf("CircuitState() = %q, want closed", state)
+		}
+	})
+
+	t.Run("network errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		url := server.URL
+		server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(url), WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2}))
+		for range 2 {
+			client.ListDevices(context.Background())
+		}
+		if state := client.CircuitState(); state != CircuitOpen {
+			t.Errorf("CircuitState() = %q, want open", state)
+		}
+	})
+
+	t.Run("canceled requests do not count", func(t *testing.T) {
+		var code, hits atomic.Int32
+		code.Store(http.StatusOK)
+		server := circuitServer(t, &code, &hits)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1}))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		client.ListDevices(ctx)
+		if state := client.CircuitState(); state != CircuitClosed {
+			t.Errorf("CircuitState() = %q, want closed", state)
+		}
+	})
+}
+
+func TestWithCircuitBreaker_StopsRetries(t *testing.T) {
+	var code, hits atomic.Int32
+	code.Store(http.StatusBadGateway)
+	server := circuitServer(t, &code, &hits)
+	defer server.Close()
+
+	retry := DefaultRetryConfig()
+	retry.MaxRetries = 5
+	retry.InitialBackoff = time.Millisecond
+	client, _ := NewClient("token", WithBaseURL(server.URL), WithRetry(retry),
+		WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2}))
+
+	if _, err := client.ListDevices(context.Background()); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen, got %v", err)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("server hits = %d, want 2", hits.Load())
+	}
+}
+
+func TestWithCircuitBreaker_SingleTrial(t *testing.T) {
+	release := make(chan struct{})
+	arrived := make(chan struct{}, 10)
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		arrived <- struct{}{}
+		<-release
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL),
+		WithCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1, Cooldown: 10 * time.Millisecond}))
+	client.ListDevices(context.Background())
+	failing.Store(false)
+	time.Sleep(20 * time.Millisecond)
+
+	trialDone := make(chan error, 1)
+	go func() {
+		_, err := client.ListDevices(context.Background())
+		trialDone <- err
+	}()
+	<-arrived
+
+	if _, err := client.ListDevices(context.Background()); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen during trial, got %v", err)
+	}
+	close(release)
+	if err := <-trialDone; err != nil {
+		t.Errorf("trial request failed: %v", err)
+	}
+	if state := client.CircuitState(); state != CircuitClosed {
+		t.Errorf("CircuitState() = %q, want closed", state)
+	}
+}
+
+func TestClient_CircuitState_NoBreaker(t *testing.T) {
+	client, _ := NewClient("token")
+	if state := client.CircuitState(); state != CircuitClosed {
+		t.Errorf("CircuitState() = %q, want closed", state)
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"math/rand/v2"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -18,8 +21,15 @@ const (
 	// DefaultBaseURL is the SmartThings API base URL.
 	DefaultBaseURL = "https://api.smartthings.com/v1"
 
-	// DefaultTimeout is the default HTTP request timeout.
+	// DefaultTimeout is the default per-request timeout, applied when the
+	// caller's context has no deadline.
 	DefaultTimeout = 30 * time.Second
+
+	// Version is the library version reported in the default User-Agent.
+	Version = "1.0.0"
+
+	// DefaultUserAgent is the User-Agent header sent when none is configured.
+	DefaultUserAgent = "smartthings-go/" + Version
 )
 
 // RetryConfig configures automatic retry behavior for transient failures.
@@ -32,6 +42,16 @@ type RetryConfig struct {
 	MaxBackoff time.Duration
 	// Multiplier is the backoff multiplier (default: 2.0).
 	Multiplier float64
+	// Jitter randomizes each backoff to between zero and its computed value
+	// ("full jitter"), spreading out retries from concurrent callers.
+	Jitter bool
+	// RetryableStatus, if set, decides which HTTP status codes are retried,
+	// replacing the default of 429 and 5xx. Timeouts are always retried.
+	RetryableStatus func(code int) bool
+	// BackoffFor, if set, overrides the computed backoff before retry attempt
+	// (starting at 1) of a request that failed with the given status code
+	// (0 for timeouts). Jitter and MaxBackoff still apply.
+	BackoffFor func(attempt int, code int) time.Duration
 }
 
 // DefaultRetryConfig returns sensible retry defaults.
@@ -57,47 +77,87 @@ type RateLimitCallback func(RateLimitInfo)
 
 // Client is a SmartThings API client.
 type Client struct {
-	baseURL           string
-	token             string
-	httpClient        *http.Client
-	retryConfig       *RetryConfig
-	rateLimitCallback RateLimitCallback
-	lastRateLimit     *RateLimitInfo
-	rateLimitMu       sync.RWMutex
-	cacheConfig       *CacheConfig
-	logger            *slog.Logger
+	baseURL            string
+	token              string
+	httpClient         *http.Client
+	timeout            time.Duration
+	retryConfig        *RetryConfig
+	rateLimitCallback  RateLimitCallback
+	lastRateLimit      *RateLimitInfo
+	rateLimitMu        sync.RWMutex
+	cacheConfig        *CacheConfig
+	logger             *slog.Logger
+	streamPollInterval time.Duration
+	userAgent          string
+	recorder           *recorder
+	validateRules      bool
+	refreshDelay       time.Duration
+	offlinePrecheck    bool
+	paginationWorkers  int
+	metrics            MetricsRecorder
+	logHooks           bool
+	forceRemoteCaps    bool
+	dryRun             bool
+	validateCommands   bool
+	commandCaps        sync.Map // capability ID -> *Capability, for ValidateCommand
+	breaker            *circuitBreaker
+	requestIDKey       any // Context key of the request ID, for WithRequestIDFromContext
+
+	// tokenRefreshCallback is only used by OAuthClient.
+	tokenRefreshCallback func(*TokenResponse)
 }
 
 // Option configures a Client.
 type Option func(*Client)
 
-// WithBaseURL sets a custom base URL for the API.
+// WithBaseURL sets a custom base URL for the API. It must be an absolute
+// http or https URL without a query or fragment, or NewClient returns an
+// error wrapping ErrInvalidBaseURL. Trailing slashes are removed.
 func WithBaseURL(url string) Option {
 	return func(c *Client) {
 		c.baseURL = url
 	}
 }
 
-// WithHTTPClient sets a custom HTTP client.
+// WithUserAgent sets the User-Agent header sent with every request,
+// including OAuth token requests made by OAuthClient.
+// Defaults to DefaultUserAgent.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client, e.g. to route requests through a
+// proxy or to trust custom TLS roots via its Transport. A nil client is ignored.
+//
+// The client is used as-is for every API request. Retries (WithRetry),
+// rate-limit tracking, logging, and caching are applied by Client around each
+// call to the injected client's Do, so they compose with its transport rather
+// than replacing it. The client's own Timeout, if any, still applies in
+// addition to the per-request timeout set with WithTimeout.
 func WithHTTPClient(client *http.Client) Option {
 	return func(c *Client) {
-		c.httpClient = client
+		if client != nil {
+			c.httpClient = client
+		}
 	}
 }
 
-// WithTimeout sets the HTTP request timeout.
-// This option can be applied in any order relative to other options.
+// WithTimeout sets the default per-request timeout (default: DefaultTimeout).
+// It is applied to each request, including each retry attempt, only when the
+// caller's context has no deadline; caller deadlines are left untouched.
+// A zero or negative timeout disables the default.
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Client) {
-		if c.httpClient == nil {
-			c.httpClient = &http.Client{}
-		}
-		c.httpClient.Timeout = timeout
+		c.timeout = timeout
 	}
 }
 
 // WithRetry enables automatic retry with the given configuration.
 // Retries are attempted on rate limits (429), server errors (5xx), and timeouts.
+// Each attempt is sent through the configured HTTP client, including one
+// supplied with WithHTTPClient.
 func WithRetry(config *RetryConfig) Option {
 	return func(c *Client) {
 		c.retryConfig = config
@@ -120,10 +180,11 @@ func NewClient(token string, opts ...Option) (*Client, error) {
 	}
 
 	c := &Client{
-		baseURL: DefaultBaseURL,
-		token:   token,
+		baseURL:      DefaultBaseURL,
+		token:        token,
+		timeout:      DefaultTimeout,
+		refreshDelay: DefaultRefreshDelay,
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
 			Transport: &http.Transport{
 				MaxIdleConns:        100,
 				MaxIdleConnsPerHost: 10,
@@ -138,20 +199,65 @@ func NewClient(token string, opts ...Option) (*Client, error) {
 		opt(c)
 	}
 
+	baseURL, err := normalizeBaseURL(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	c.baseURL = baseURL
+
 	return c, nil
 }
 
+// normalizeBaseURL checks that raw is an absolute http(s) URL that paths can
+// be appended to, and removes trailing slashes.
+func normalizeBaseURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidBaseURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidBaseURL, raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("%w: %q has a query or fragment", ErrInvalidBaseURL, raw)
+	}
+	return strings.TrimRight(raw, "/"), nil
+}
+
+// response holds the parts of an HTTP response needed by API methods.
+type response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
 // do performs an HTTP request and returns the response body.
 func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte, error) {
+	resp, err := c.doRequest(ctx, method, path, body, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// doRequest performs an HTTP request with optional extra headers and returns
+// the response status, headers, and body.
+func (c *Client) doRequest(ctx context.Context, method, path string, body any, header http.Header) (*response, error) {
+	callerCtx := ctx
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
 	url := c.baseURL + path
 
 	var reqBody io.Reader
+	var reqData []byte
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewReader(data)
+		reqData = data
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
@@ -161,20 +267,63 @@ func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte,
 
 	req.Header.Set("Authorization", "Bearer "+c.token)
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.UserAgent())
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, values := range header {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if key := idempotencyKey(ctx); key != "" && method == http.MethodPost {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	if id := c.requestID(ctx); id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
 
+	if c.skipDryRun(ctx, method, path) {
+		return &response{StatusCode: http.StatusOK, Header: http.Header{}, Body: []byte("{}")}, nil
+	}
+
+	var trial bool
+	if c.breaker != nil {
+		if trial, err = c.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.logHooks {
+		c.LogRequest(ctx, method, path)
+	}
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		if callerCtx.Err() != nil {
+			c.circuitDone(trial, circuitIgnored)
+		} else {
+			c.circuitDone(trial, circuitFailure)
+		}
+		c.record(req, reqData, nil, nil, start, err)
+		c.observeRequest(method, path, 0, start)
+		c.logResponse(ctx, method, path, 0, start, err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Parse and store rate limit headers
-	c.parseRateLimitHeaders(resp.Header)
+	c.parseRateLimitHeaders(ctx, resp.Header)
 
 	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.circuitDone(trial, circuitFailure)
+	} else {
+		c.circuitDone(trial, circuitOutcomeFor(resp.StatusCode))
+	}
+	c.record(req, reqData, resp, respBody, start, err)
+	c.observeRequest(method, path, resp.StatusCode, start)
+	c.logResponse(ctx, method, path, resp.StatusCode, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
@@ -183,11 +332,26 @@ func (c *Client) do(ctx context.Context, method, path string, body any) ([]byte,
 		return nil, c.handleError(resp.StatusCode, respBody, resp.Header)
 	}
 
-	return respBody, nil
+	return &response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, nil
+}
+
+// requestContext applies the client's default timeout to ctx if it has no deadline.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
 }
 
 // parseRateLimitHeaders extracts rate limit information from response headers.
-func (c *Client) parseRateLimitHeaders(header http.Header) {
+func (c *Client) parseRateLimitHeaders(ctx context.Context, header http.Header) {
 	limit := header.Get("X-RateLimit-Limit")
 	remaining := header.Get("X-RateLimit-Remaining")
 	reset := header.Get("X-RateLimit-Reset")
@@ -226,6 +390,12 @@ func (c *Client) parseRateLimitHeaders(header http.Header) {
 	if c.rateLimitCallback != nil {
 		c.rateLimitCallback(info)
 	}
+	if c.metrics != nil && remaining != "" {
+		c.metrics.SetRateLimitRemaining(info.Remaining)
+	}
+	if c.logHooks {
+		c.LogRateLimit(ctx, info)
+	}
 }
 
 // handleError converts HTTP error responses to appropriate errors.
@@ -237,14 +407,10 @@ func (c *Client) handleError(statusCode int, body []byte, headers http.Header) e
 		return ErrNotFound
 	case http.StatusTooManyRequests:
 		// Return detailed rate limit error with Retry-After info
-		retryAfter := parseRetryAfter(headers.Get("Retry-After"))
 		c.rateLimitMu.RLock()
 		info := c.lastRateLimit
 		c.rateLimitMu.RUnlock()
-		return &RateLimitError{
-			RetryAfter: retryAfter,
-			Info:       info,
-		}
+		return newRateLimitError(headers, info)
 	case http.StatusServiceUnavailable:
 		return ErrDeviceOffline
 	default:
@@ -254,14 +420,30 @@ func (c *Client) handleError(statusCode int, body []byte, headers http.Header) e
 			Error     struct {
 				Code    string `json:"code"`
 				Message string `json:"message"`
+				Details []struct {
+					Code    string `json:"code"`
+					Message string `json:"message"`
+				} `json:"details"`
 			} `json:"error"`
 		}
 		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Message != "" {
-			return &APIError{
+			apiErr := &APIError{
 				StatusCode: statusCode,
 				Message:    errResp.Error.Message,
 				RequestID:  errResp.RequestID,
 			}
+			// Commands sent to an unreachable device are rejected with a 409 or
+			// 422 whose message or details mention that the device is offline.
+			if statusCode == http.StatusConflict || statusCode == http.StatusUnprocessableEntity {
+				offline := mentionsOffline(errResp.Error.Code, errResp.Error.Message)
+				for _, d := range errResp.Error.Details {
+					offline = offline || mentionsOffline(d.Code, d.Message)
+				}
+				if offline {
+					return fmt.Errorf("%w: %w", ErrDeviceOffline, apiErr)
+				}
+			}
+			return apiErr
 		}
 		return &APIError{
 			StatusCode: statusCode,
@@ -270,12 +452,27 @@ func (c *Client) handleError(statusCode int, body []byte, headers http.Header) e
 	}
 }
 
+// mentionsOffline reports whether an API error code or message indicates that
+// the target device is offline.
+func mentionsOffline(code, message string) bool {
+	return strings.Contains(strings.ToLower(code), "offline") ||
+		strings.Contains(strings.ToLower(message), "offline")
+}
+
 // SetToken updates the client's bearer token.
 // This is useful for OAuth clients that need to refresh tokens.
 func (c *Client) SetToken(token string) {
 	c.token = token
 }
 
+// UserAgent returns the User-Agent header sent with requests.
+func (c *Client) UserAgent() string {
+	if c.userAgent == "" {
+		return DefaultUserAgent
+	}
+	return c.userAgent
+}
+
 // Token returns the current bearer token.
 func (c *Client) Token() string {
 	return c.token
@@ -321,17 +518,27 @@ func (c *Client) delete(ctx context.Context, path string) ([]byte, error) {
 
 // doWithRetry performs a request with automatic retry on transient failures.
 func (c *Client) doWithRetry(ctx context.Context, method, path string, body any) ([]byte, error) {
+	resp, err := c.doRequestWithRetry(ctx, method, path, body, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// doRequestWithRetry performs a request with extra headers and automatic retry
+// on transient failures, returning the full response.
+func (c *Client) doRequestWithRetry(ctx context.Context, method, path string, body any, header http.Header) (*response, error) {
 	if c.retryConfig == nil {
-		return c.do(ctx, method, path, body)
+		return c.doRequest(ctx, method, path, body, header)
 	}
 
 	var lastErr error
 	backoff := c.retryConfig.InitialBackoff
 
 	for attempt := 0; attempt <= c.retryConfig.MaxRetries; attempt++ {
-		data, err := c.do(ctx, method, path, body)
+		resp, err := c.doRequest(ctx, method, path, body, header)
 		if err == nil {
-			return data, nil
+			return resp, nil
 		}
 
 		// Only retry on transient errors
@@ -345,24 +552,48 @@ func (c *Client) doWithRetry(ctx context.Context, method, path string, body any)
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
-			case <-time.After(backoff):
+			case <-time.After(c.retryDelay(attempt+1, backoff, err)):
 				backoff = time.Duration(float64(backoff) * c.retryConfig.Multiplier)
 				if backoff > c.retryConfig.MaxBackoff {
 					backoff = c.retryConfig.MaxBackoff
 				}
 			}
+			if guard := retryGuard(ctx); guard != nil && guard() {
+				return nil, errAlreadyCreated
+			}
 		}
 	}
 
 	return nil, lastErr
 }
 
+// retryDelay returns how long to wait before the given retry attempt.
+// A Retry-After header on a 429 takes precedence over the computed backoff.
+func (c *Client) retryDelay(attempt int, backoff time.Duration, err error) time.Duration {
+	var rle *RateLimitError
+	if errors.As(err, &rle) && rle.RetryAfter > 0 {
+		return rle.RetryAfter
+	}
+
+	if c.retryConfig.BackoffFor != nil {
+		backoff = min(c.retryConfig.BackoffFor(attempt, errorStatusCode(err)), c.retryConfig.MaxBackoff)
+	}
+	if c.retryConfig.Jitter && backoff > 0 {
+		backoff = rand.N(backoff + 1)
+	}
+	return backoff
+}
+
 // isRetryable returns true if the error is a transient failure worth retrying.
 func (c *Client) isRetryable(err error) bool {
-	if IsRateLimited(err) {
+	if IsTimeout(err) {
 		return true
 	}
-	if IsTimeout(err) {
+	if c.retryConfig != nil && c.retryConfig.RetryableStatus != nil {
+		code := errorStatusCode(err)
+		return code != 0 && c.retryConfig.RetryableStatus(code)
+	}
+	if IsRateLimited(err) {
 		return true
 	}
 	var apiErr *APIError
@@ -372,3 +603,22 @@ func (c *Client) isRetryable(err error) bool {
 	}
 	return false
 }
+
+// errorStatusCode returns the HTTP status code behind an API error, or 0 if
+// err did not come from an HTTP response.
+func errorStatusCode(err error) int {
+	var apiErr *APIError
+	switch {
+	case IsRateLimited(err):
+		return http.StatusTooManyRequests
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrDeviceOffline):
+		return http.StatusServiceUnavailable
+	}
+	return 0
+}
@@ -3,8 +3,10 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -46,8 +48,8 @@ func TestNewClient(t *testing.T) {
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
-		if client.httpClient.Timeout != customTimeout {
-			t.Errorf("timeout = %v, want %v", client.httpClient.Timeout, customTimeout)
+		if client.timeout != customTimeout {
+			t.Errorf("timeout = %v, want %v", client.timeout, customTimeout)
 		}
 	})
 
@@ -74,6 +76,36 @@ func TestNewClient(t *testing.T) {
 			t.Error("client should be nil on error")
 		}
 	})
+
+	t.Run("base URL trailing slash removed", func(t *testing.T) {
+		client, err := NewClient("token", WithBaseURL("https://custom.api.com/v1/"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if client.baseURL != "https://custom.api.com/v1" {
+			t.Errorf("baseURL = %q, want %q", client.baseURL, "https://custom.api.com/v1")
+		}
+	})
+
+	t.Run("invalid base URL returns error", func(t *testing.T) {
+		for _, baseURL := range []string{
+			"",
+			"api.smartthings.com/v1",
+			"/v1",
+			"ftp://api.smartthings.com",
+			"https://",
+			"https://api.smartthings.com/v1?x=1",
+			"http://[::1",
+		} {
+			client, err := NewClient("token", WithBaseURL(baseURL))
+			if !errors.Is(err, ErrInvalidBaseURL) {
+				t.Errorf("WithBaseURL(%q): error = %v, want ErrInvalidBaseURL", baseURL, err)
+			}
+			if client != nil {
+				t.Errorf("WithBaseURL(%q): client should be nil on error", baseURL)
+			}
+		}
+	})
 }
 
 func TestClient_do(t *testing.T) {
@@ -273,25 +305,165 @@ func TestClient_handleError(t *testing.T) {
 	})
 }
 
-func TestWithTimeout_initializesClient(t *testing.T) {
-	// Test that WithTimeout initializes httpClient if nil
-	c := &Client{
-		baseURL:    DefaultBaseURL,
-		token:      "token",
-		httpClient: nil, // Explicitly nil
-	}
+// headerTransport is a RoundTripper that adds a header and counts requests.
+type headerTransport struct {
+	calls atomic.Int32
+}
 
-	opt := WithTimeout(5 * time.Second)
-	// This should not panic
-	opt(c)
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Proxy-Auth", "secret")
+	return http.DefaultTransport.RoundTrip(req)
+}
 
-	// httpClient should now be initialized with the timeout
-	if c.httpClient == nil {
-		t.Error("expected httpClient to be initialized")
-	}
-	if c.httpClient.Timeout != 5*time.Second {
-		t.Errorf("expected timeout to be 5s, got %v", c.httpClient.Timeout)
+func TestWithHTTPClient(t *testing.T) {
+	t.Run("nil client ignored", func(t *testing.T) {
+		client, _ := NewClient("token", WithHTTPClient(nil))
+		if client.httpClient == nil {
+			t.Fatal("expected default httpClient to be kept")
+		}
+	})
+
+	t.Run("retry and rate limits compose with injected transport", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Proxy-Auth") != "secret" {
+				t.Error("request did not go through injected transport")
+			}
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			if attempts.Add(1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		transport := &headerTransport{}
+		client, _ := NewClient("token",
+			WithBaseURL(server.URL),
+			WithHTTPClient(&http.Client{Transport: transport}),
+			WithRetry(&RetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 2}),
+		)
+
+		if _, err := client.ListLocations(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if transport.calls.Load() != 2 {
+			t.Errorf("transport calls = %d, want 2", transport.calls.Load())
+		}
+		info := client.RateLimitInfo()
+		if info == nil || info.Remaining != 42 {
+			t.Errorf("RateLimitInfo = %+v, want Remaining 42", info)
+		}
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	// slowServer responds after the given delay, or when the request is canceled.
+	slowServer := func(delay time.Duration) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(delay):
+				w.Write([]byte(`{"items":[]}`))
+			case <-r.Context().Done():
+			}
+		}))
 	}
+
+	t.Run("applies default when context has no deadline", func(t *testing.T) {
+		server := slowServer(time.Second)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithTimeout(20*time.Millisecond))
+		_, err := client.ListLocations(context.Background())
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("leaves caller deadline untouched", func(t *testing.T) {
+		server := slowServer(50 * time.Millisecond)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithTimeout(10*time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := client.ListLocations(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("zero disables default", func(t *testing.T) {
+		server := slowServer(20 * time.Millisecond)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithTimeout(0))
+		if _, err := client.ListLocations(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does not modify injected client", func(t *testing.T) {
+		httpClient := &http.Client{Timeout: 10 * time.Second}
+		client, _ := NewClient("token", WithHTTPClient(httpClient), WithTimeout(5*time.Second))
+		if httpClient.Timeout != 10*time.Second {
+			t.Errorf("injected client Timeout = %v, want 10s", httpClient.Timeout)
+		}
+		if client.timeout != 5*time.Second {
+			t.Errorf("timeout = %v, want 5s", client.timeout)
+		}
+	})
+}
+
+func TestWithUserAgent(t *testing.T) {
+	t.Run("default user agent", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ua := r.Header.Get("User-Agent"); ua != DefaultUserAgent {
+				t.Errorf("User-Agent = %q, want %q", ua, DefaultUserAgent)
+			}
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.get(context.Background(), "/test"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("custom user agent with retry", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if ua := r.Header.Get("User-Agent"); ua != "dashboard/2.1" {
+				t.Errorf("User-Agent = %q, want %q", ua, "dashboard/2.1")
+			}
+			if calls == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token",
+			WithUserAgent("dashboard/2.1"),
+			WithBaseURL(server.URL),
+			WithRetry(&RetryConfig{MaxRetries: 1, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1}),
+		)
+		if _, err := client.get(context.Background(), "/test"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("got %d calls, want 2", calls)
+		}
+		if client.UserAgent() != "dashboard/2.1" {
+			t.Errorf("UserAgent() = %q, want %q", client.UserAgent(), "dashboard/2.1")
+		}
+	})
 }
 
 func TestClient_RateLimitHeaders(t *testing.T) {
@@ -526,3 +698,32 @@ func TestIsDeviceOffline(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_handleError_DeviceOffline(t *testing.T) {
+	client, _ := NewClient("token")
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantOffline bool
+	}{
+		{"409 message", 409, `{"requestId":"r1","error":{"code":"ConflictError","message":"The device is offline"}}`, true},
+		{"422 details", 422, `{"error":{"code":"ConstraintViolationError","message":"The request is malformed.","details":[{"code":"DeviceOfflineError","message":"Device unreachable"}]}}`, true},
+		{"422 unrelated", 422, `{"error":{"code":"ConstraintViolationError","message":"Invalid argument"}}`, false},
+		{"400 mentioning offline", 400, `{"error":{"code":"BadRequest","message":"offline mode not supported"}}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.handleError(tt.status, []byte(tt.body), http.Header{})
+			if got := IsDeviceOffline(err); got != tt.wantOffline {
+				t.Errorf("IsDeviceOffline() = %v, want %v (err: %v)", got, tt.wantOffline, err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Errorf("expected *APIError with status %d, got %v", tt.status, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package smartthings
+
+import (
+	"context"
+	"sync"
+)
+
+// CommandQueue collects device commands and executes them in rate-limit-aware
+// bursts. It is safe for concurrent use.
+type CommandQueue struct {
+	client SmartThingsClient
+	cfg    BatchConfig
+
+	mu      sync.Mutex
+	pending []BatchCommand
+}
+
+// NewCommandQueue creates a queue that executes commands through client.
+// cfg.MaxConcurrent sets the largest burst size; StopOnError stops draining
+// after the first burst containing a failure. A nil cfg uses DefaultBatchConfig.
+//
+// Example:
+//
+//	queue := smartthings.NewCommandQueue(client, nil)
+//	for _, id := range deviceIDs {
+//	    queue.Enqueue(id, smartthings.NewCommand("switch", "off"))
+//	}
+//	for _, r := range queue.Drain(ctx) {
+//	    if r.Error != nil {
+//	        log.Printf("Device %s failed: %v", r.DeviceID, r.Error)
+//	    }
+//	}
+func NewCommandQueue(client SmartThingsClient, cfg *BatchConfig) *CommandQueue {
+	if cfg == nil {
+		cfg = DefaultBatchConfig()
+	}
+	q := &CommandQueue{client: client, cfg: *cfg}
+	if q.cfg.MaxConcurrent <= 0 {
+		q.cfg.MaxConcurrent = 10
+	}
+	return q
+}
+
+// Enqueue adds a command for a device. Each call produces one BatchResult when drained.
+func (q *CommandQueue) Enqueue(deviceID string, cmd Command) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pending = append(q.pending, BatchCommand{DeviceID: deviceID, Commands: []Command{cmd}})
+}
+
+// Len returns the number of commands waiting to be drained.
+func (q *CommandQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// Drain executes all queued commands and empties the queue, returning one
+// result per command in enqueue order.
+//
+// Commands are sent in bursts of at most MaxConcurrent, shrunk to the
+// client's RemainingRequests when that is lower. When the rate limit is
+// exhausted, Drain calls WaitForRateLimit before the next burst. If the wait
+// is canceled, the remaining commands fail with the context's error.
+func (q *CommandQueue) Drain(ctx context.Context) []BatchResult {
+	q.mu.Lock()
+	pending := q.pending
+	q.pending = nil
+	q.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	results := make([]BatchResult, 0, len(pending))
+	fail := func(err error) []BatchResult {
+		for _, cmd := range pending[len(results):] {
+			results = append(results, BatchResult{DeviceID: cmd.DeviceID, Error: err})
+		}
+		return results
+	}
+
+	for len(results) < len(pending) {
+		if err := ctx.Err(); err != nil {
+			return fail(err)
+		}
+
+		size := q.cfg.MaxConcurrent
+		if q.client.ShouldThrottle(1) {
+			if err := q.client.WaitForRateLimit(ctx); err != nil {
+				return fail(err)
+			}
+		} else if remaining := q.client.RemainingRequests(); remaining > 0 {
+			size = min(size, remaining)
+		}
+
+		burst := pending[len(results):min(len(results)+size, len(pending))]
+		burstResults := q.client.ExecuteCommandsBatch(ctx, burst, &q.cfg)
+		results = append(results, burstResults...)
+
+		if q.cfg.StopOnError {
+			for _, r := range burstResults {
+				if r.Error != nil {
+					return fail(context.Canceled)
+				}
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,165 @@
+package smartthings
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// queueTestClient fakes the rate limit state seen by a CommandQueue.
+type queueTestClient struct {
+	SmartThingsClient
+	remaining int // -1 when unknown
+	waits     int
+	waitErr   error
+	bursts    [][]string
+	failOn    string
+}
+
+func (f *queueTestClient) ShouldThrottle(threshold int) bool {
+	return f.remaining >= 0 && f.remaining < threshold
+}
+
+func (f *queueTestClient) RemainingRequests() int { return f.remaining }
+
+func (f *queueTestClient) WaitForRateLimit(ctx context.Context) error {
+	f.waits++
+	if f.waitErr != nil {
+		return f.waitErr
+	}
+	f.remaining = 3
+	return nil
+}
+
+func (f *queueTestClient) ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand, cfg *BatchConfig) []BatchResult {
+	ids := make([]string, len(batch))
+	results := make([]BatchResult, len(batch))
+	for i, cmd := range batch {
+		ids[i] = cmd.DeviceID
+		results[i] = BatchResult{DeviceID: cmd.DeviceID}
+		if cmd.DeviceID == f.failOn {
+			results[i].Error = ErrNotFound
+		}
+	}
+	f.bursts = append(f.bursts, ids)
+	if f.remaining >= 0 {
+		f.remaining = max(0, f.remaining-len(batch))
+	}
+	return results
+}
+
+func TestCommandQueue(t *testing.T) {
+	enqueue := func(q *CommandQueue, ids ...string) {
+		for _, id := range ids {
+			q.Enqueue(id, NewCommand("switch", "on"))
+		}
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		q := NewCommandQueue(&queueTestClient{remaining: -1}, nil)
+		if results := q.Drain(context.Background()); results != nil {
+			t.Errorf("expected nil results, got %v", results)
+		}
+	})
+
+	t.Run("bursts of MaxConcurrent without rate limit info", func(t *testing.T) {
+		client := &queueTestClient{remaining: -1}
+		q := NewCommandQueue(client, &BatchConfig{MaxConcurrent: 2})
+		enqueue(q, "d1", "d2", "d3", "d4", "d5")
+		if q.Len() != 5 {
+			t.Errorf("Len() = %d, want 5", q.Len())
+		}
+
+		results := q.Drain(context.Background())
+		if len(results) != 5 {
+			t.Fatalf("expected 5 results, got %d", len(results))
+		}
+		for i, want := range []string{"d1", "d2", "d3", "d4", "d5"} {
+			if results[i].DeviceID != want || results[i].Error != nil {
+				t.Errorf("results[%d] = %+v, want %s without error", i, results[i], want)
+			}
+		}
+		if len(client.bursts) != 3 {
+			t.Errorf("expected 3 bursts, got %v", client.bursts)
+		}
+		if client.waits != 0 {
+			t.Errorf("expected no waits, got %d", client.waits)
+		}
+		if q.Len() != 0 {
+			t.Errorf("queue not emptied, Len() = %d", q.Len())
+		}
+	})
+
+	t.Run("shrinks bursts and waits when limit is exhausted", func(t *testing.T) {
+		client := &queueTestClient{remaining: 2}
+		q := NewCommandQueue(client, &BatchConfig{MaxConcurrent: 10})
+		enqueue(q, "d1", "d2", "d3", "d4", "d5")
+
+		results := q.Drain(context.Background())
+		if len(results) != 5 {
+			t.Fatalf("expected 5 results, got %d", len(results))
+		}
+		// 2 remaining, then wait and 3 remaining after reset
+		if len(client.bursts) != 2 || len(client.bursts[0]) != 2 || len(client.bursts[1]) != 3 {
+			t.Errorf("unexpected bursts: %v", client.bursts)
+		}
+		if client.waits != 1 {
+			t.Errorf("expected 1 wait, got %d", client.waits)
+		}
+	})
+
+	t.Run("wait error fails remaining commands", func(t *testing.T) {
+		client := &queueTestClient{remaining: 1, waitErr: context.DeadlineExceeded}
+		q := NewCommandQueue(client, nil)
+		enqueue(q, "d1", "d2", "d3")
+
+		results := q.Drain(context.Background())
+		if len(results) != 3 {
+			t.Fatalf("expected 3 results, got %d", len(results))
+		}
+		if results[0].Error != nil {
+			t.Errorf("results[0] unexpected error: %v", results[0].Error)
+		}
+		for _, r := range results[1:] {
+			if !errors.Is(r.Error, context.DeadlineExceeded) {
+				t.Errorf("%s: expected DeadlineExceeded, got %v", r.DeviceID, r.Error)
+			}
+		}
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		client := &queueTestClient{remaining: -1, failOn: "d2"}
+		q := NewCommandQueue(client, &BatchConfig{MaxConcurrent: 2, StopOnError: true})
+		enqueue(q, "d1", "d2", "d3", "d4")
+
+		results := q.Drain(context.Background())
+		if len(client.bursts) != 1 {
+			t.Errorf("expected 1 burst, got %v", client.bursts)
+		}
+		if !errors.Is(results[1].Error, ErrNotFound) {
+			t.Errorf("results[1] = %v, want ErrNotFound", results[1].Error)
+		}
+		for _, r := range results[2:] {
+			if r.Error != context.Canceled {
+				t.Errorf("%s: expected context.Canceled, got %v", r.DeviceID, r.Error)
+			}
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		client := &queueTestClient{remaining: -1}
+		q := NewCommandQueue(client, nil)
+		enqueue(q, "d1", "d2")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		for _, r := range q.Drain(ctx) {
+			if r.Error != context.Canceled {
+				t.Errorf("%s: expected context.Canceled, got %v", r.DeviceID, r.Error)
+			}
+		}
+		if len(client.bursts) != 0 {
+			t.Errorf("expected no bursts, got %v", client.bursts)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package smartthings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+)
+
+// WithCommandValidation makes ExecuteCommand and ExecuteCommands run
+// ValidateCommand on each command before sending, returning the local
+// validation error instead of the API's 422 response.
+func WithCommandValidation() Option {
+	return func(c *Client) {
+		c.validateCommands = true
+	}
+}
+
+// ValidateCommand checks cmd against the latest definition of its
+// capability: the command must exist, required arguments must be present,
+// there must be no extra arguments, and each argument must match its schema
+// type, range, and allowed values. Arguments whose schema has no type are
+// not type-checked.
+//
+// Capability definitions are kept for the lifetime of the client (or until
+// InvalidateCapabilityCache), so only the first command for each capability
+// costs a lookup; common capabilities need no request at all.
+//
+// All problems are reported at once. The returned error is an errors.Join of
+// the individual issues, each matching ErrInvalidCommand. Errors fetching the
+// capability definition are returned as-is.
+func (c *Client) ValidateCommand(ctx context.Context, cmd Command) error {
+	if cmd.Capability == "" {
+		return ErrEmptyCapabilityID
+	}
+
+	capability, err := c.commandCapability(ctx, cmd.Capability)
+	if err != nil {
+		return err
+	}
+
+	def, ok := capability.Commands[cmd.Command]
+	if !ok {
+		return fmt.Errorf("%w: %s has no command %q", ErrInvalidCommand, cmd.Capability, cmd.Command)
+	}
+
+	var errs []error
+	name := cmd.Capability + "." + cmd.Command
+	if len(cmd.Arguments) > len(def.Arguments) {
+		errs = append(errs, fmt.Errorf("%w: %s takes at most %d arguments, got %d", ErrInvalidCommand, name, len(def.Arguments), len(cmd.Arguments)))
+	}
+	for i, arg := range def.Arguments {
+		if i >= len(cmd.Arguments) {
+			if !arg.Optional {
+				errs = append(errs, fmt.Errorf("%w: %s is missing required argument %q", ErrInvalidCommand, name, arg.Name))
+			}
+			continue
+		}
+		if err := checkArgument(arg.Schema, cmd.Arguments[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s argument %q: %v", ErrInvalidCommand, name, arg.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// commandCapability returns the latest definition of a capability, fetching
+// it at most once per client.
+func (c *Client) commandCapability(ctx context.Context, capabilityID string) (*Capability, error) {
+	if cached, ok := c.commandCaps.Load(capabilityID); ok {
+		return cached.(*Capability), nil
+	}
+	capability, err := c.GetCapability(ctx, capabilityID, 0)
+	if err != nil {
+		return nil, err
+	}
+	c.commandCaps.Store(capabilityID, capability)
+	return capability, nil
+}
+
+// checkArgument reports why value does not satisfy schema, or nil.
+func checkArgument(schema AttributeSchema, value any) error {
+	switch schema.Type {
+	case "integer", "number":
+		n, ok := toFloat(value)
+		if !ok {
+			return fmt.Errorf("want %s, got %T", schema.Type, value)
+		}
+		if schema.Type == "integer" && n != math.Trunc(n) {
+			return fmt.Errorf("want integer, got %v", value)
+		}
+		if schema.Minimum != nil && n < *schema.Minimum {
+			return fmt.Errorf("%v is below minimum %v", value, *schema.Minimum)
+		}
+		if schema.Maximum != nil && n > *schema.Maximum {
+			return fmt.Errorf("%v is above maximum %v", value, *schema.Maximum)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("want string, got %T", value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("want boolean, got %T", value)
+		}
+	case "object":
+		if k := reflect.Indirect(reflect.ValueOf(value)).Kind(); k != reflect.Map && k != reflect.Struct {
+			return fmt.Errorf("want object, got %T", value)
+		}
+	case "array":
+		if k := reflect.ValueOf(value).Kind(); k != reflect.Slice && k != reflect.Array {
+			return fmt.Errorf("want array, got %T", value)
+		}
+	}
+
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(allowed any) bool { return sameValue(allowed, value) }) {
+		return fmt.Errorf("%v is not one of %v", value, schema.Enum)
+	}
+	return nil
+}
+
+// sameValue compares an enum entry decoded from JSON with an argument,
+// treating numbers of any type as equal when their values are.
+func sameValue(allowed, value any) bool {
+	if a, ok := toFloat(allowed); ok {
+		v, ok := toFloat(value)
+		return ok && a == v
+	}
+	if value == nil || !reflect.TypeOf(value).Comparable() {
+		return false
+	}
+	return allowed == value
+}
@@ -0,0 +1,142 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_ValidateCommand(t *testing.T) {
+	client, _ := NewClient("token", WithBaseURL("http://127.0.0.1:0"))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		cmd     Command
+		wantErr string
+	}{
+		{"no arguments", NewCommand("switch", "on"), ""},
+		{"integer in range", NewCommand("switchLevel", "setLevel", 50), ""},
+		{"optional argument", NewCommand("switchLevel", "setLevel", 50, 2), ""},
+		{"whole float as integer", NewCommand("switchLevel", "setLevel", 50.0), ""},
+		{"number argument", NewCommand("colorControl", "setHue", 33.5), ""},
+		{"object argument", NewCommand("colorControl", "setColor", map[string]any{"hue": 10}), ""},
+		{"enum value", NewCommand("audioMute", "setMute", "muted"), ""},
+		{"unknown command", NewCommand("switch", "toggle"), `no command "toggle"`},
+		{"missing argument", NewCommand("switchLevel", "setLevel"), `missing required argument "level"`},
+		{"extra arguments", NewCommand("switch", "on", true), "at most 0 arguments"},
+		{"wrong type", NewCommand("switchLevel", "setLevel", "50"), "want integer, got string"},
+		{"fractional integer", NewCommand("switchLevel", "setLevel", 50.5), "want integer"},
+		{"above maximum", NewCommand("switchLevel", "setLevel", 150), "above maximum 100"},
+		{"below minimum", NewCommand("colorTemperature", "setColorTemperature", 0), "below minimum 1"},
+		{"not an object", NewCommand("colorControl", "setColor", "red"), "want object"},
+		{"not in enum", NewCommand("audioMute", "setMute", "loud"), "not one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.ValidateCommand(ctx, tt.cmd)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidCommand) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want ErrInvalidCommand containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("reports all problems", func(t *testing.T) {
+		err := client.ValidateCommand(ctx, NewCommand("switchLevel", "setLevel", "high", "fast", 3))
+		for _, want := range []string{"at most 2 arguments", `argument "level"`, `argument "rate"`} {
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("error = %v, want it to contain %q", err, want)
+			}
+		}
+	})
+
+	t.Run("empty capability", func(t *testing.T) {
+		if err := client.ValidateCommand(ctx, Command{Command: "on"}); err != ErrEmptyCapabilityID {
+			t.Errorf("expected ErrEmptyCapabilityID, got %v", err)
+		}
+	})
+}
+
+func TestClient_ValidateCommand_RemoteDefinition(t *testing.T) {
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if r.URL.Path != "/capabilities/custom.picturemode" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Capability{
+			ID:      "custom.picturemode",
+			Version: 1,
+			Commands: map[string]CapabilityCommand{
+				"setPictureMode": {Arguments: []CapabilityCommandArgument{{Name: "mode", Schema: AttributeSchema{Type: "string"}}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	for range 3 {
+		if err := client.ValidateCommand(ctx, NewCommand("custom.picturemode", "setPictureMode", "Movie")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected definition fetched once, got %d", fetches.Load())
+	}
+
+	client.InvalidateCapabilityCache()
+	if err := client.ValidateCommand(ctx, NewCommand("custom.picturemode", "setPictureMode", 1)); !errors.Is(err, ErrInvalidCommand) {
+		t.Errorf("expected ErrInvalidCommand, got %v", err)
+	}
+	if fetches.Load() != 2 {
+		t.Errorf("expected refetch after InvalidateCapabilityCache, got %d fetches", fetches.Load())
+	}
+}
+
+func TestWithCommandValidation(t *testing.T) {
+	var posts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cmd := NewCommand("switchLevel", "setLevel", 150)
+
+	client, _ := NewClient("token", WithBaseURL(server.URL), WithCommandValidation())
+	if err := client.ExecuteCommand(ctx, "device-123", cmd); !errors.Is(err, ErrInvalidCommand) {
+		t.Errorf("expected ErrInvalidCommand, got %v", err)
+	}
+	if err := client.ExecuteCommands(ctx, "device-123", []Command{NewCommand("switch", "on"), cmd}); !errors.Is(err, ErrInvalidCommand) {
+		t.Errorf("expected ErrInvalidCommand, got %v", err)
+	}
+	if posts.Load() != 0 {
+		t.Errorf("expected no commands sent, got %d", posts.Load())
+	}
+	if err := client.ExecuteCommand(ctx, "device-123", NewCommand("switchLevel", "setLevel", 50)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	unchecked, _ := NewClient("token", WithBaseURL(server.URL))
+	if err := unchecked.ExecuteCommand(ctx, "device-123", cmd); err != nil {
+		t.Errorf("without validation: unexpected error: %v", err)
+	}
+	if posts.Load() != 2 {
+		t.Errorf("expected 2 commands sent, got %d", posts.Load())
+	}
+}
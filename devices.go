@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"sync"
+	"time"
 )
 
 // ListDevices returns all devices associated with the account.
@@ -68,7 +72,19 @@ func (c *Client) ListDevicesWithOptions(ctx context.Context, opts *ListDevicesOp
 	return &resp, nil
 }
 
+// WithParallelPagination makes ListAllDevices fetch the remaining pages with up
+// to workers concurrent requests once the first page reports the total page
+// count. Pages are reassembled in order. If the API returns only Links (no
+// total page count), pages are fetched sequentially. Values below 2 disable
+// parallel fetching, which is the default.
+func WithParallelPagination(workers int) Option {
+	return func(c *Client) {
+		c.paginationWorkers = workers
+	}
+}
+
 // ListAllDevices retrieves all devices by automatically handling pagination.
+// See WithParallelPagination to fetch pages concurrently.
 func (c *Client) ListAllDevices(ctx context.Context) ([]Device, error) {
 	var allDevices []Device
 	page := 0
@@ -84,6 +100,14 @@ func (c *Client) ListAllDevices(ctx context.Context) ([]Device, error) {
 
 		allDevices = append(allDevices, resp.Items...)
 
+		if page == 0 && c.paginationWorkers > 1 && resp.PageInfo.TotalPages > 1 && len(resp.Items) > 0 {
+			rest, err := c.listDevicePages(ctx, 1, resp.PageInfo.TotalPages)
+			if err != nil {
+				return nil, err
+			}
+			return append(allDevices, rest...), nil
+		}
+
 		if resp.Links.Next == "" || len(resp.Items) == 0 {
 			break
 		}
@@ -93,22 +117,160 @@ func (c *Client) ListAllDevices(ctx context.Context) ([]Device, error) {
 	return allDevices, nil
 }
 
+// ListDevicesByLocation lists the devices in the given locations, grouped by
+// their LocationID. Every requested location has an entry, even if it has no
+// devices; devices without a LocationID are grouped under the empty string.
+//
+// Example:
+//
+//	byLocation, err := client.ListDevicesByLocation(ctx, []string{homeID, cabinID})
+//	for locationID, devices := range byLocation {
+//	    fmt.Printf("%s: %d devices\n", locationID, len(devices))
+//	}
+func (c *Client) ListDevicesByLocation(ctx context.Context, locationIDs []string) (map[string][]Device, error) {
+	if len(locationIDs) == 0 {
+		return nil, ErrEmptyLocationID
+	}
+
+	byLocation := make(map[string][]Device, len(locationIDs))
+	for _, id := range locationIDs {
+		byLocation[id] = []Device{}
+	}
+
+	for device, err := range c.DevicesWithOptions(ctx, &ListDevicesOptions{LocationID: locationIDs}) {
+		if err != nil {
+			return nil, err
+		}
+		byLocation[device.LocationID] = append(byLocation[device.LocationID], device)
+	}
+	return byLocation, nil
+}
+
+// listDevicePages fetches device pages [from, to) concurrently using up to
+// c.paginationWorkers requests at a time and returns their items in page order.
+// The first failing page cancels the remaining requests and its error is returned.
+func (c *Client) listDevicePages(ctx context.Context, from, to int) ([]Device, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]Device, to-from)
+	sem := make(chan struct{}, c.paginationWorkers)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for i := range pages {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			resp, err := c.ListDevicesWithOptions(ctx, &ListDevicesOptions{
+				Max:  200,
+				Page: from + i,
+			})
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[i] = resp.Items
+		})
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return slices.Concat(pages...), nil
+}
+
 // GetDevice returns a single device by ID.
+// If caching is enabled, the cached device is revalidated with its ETag.
 func (c *Client) GetDevice(ctx context.Context, deviceID string) (*Device, error) {
+	device, _, err := c.GetDeviceCached(ctx, deviceID)
+	return device, err
+}
+
+// deviceCacheEntry holds the response body of a cached device along with its
+// ETag. The body is decoded on each cache hit so callers never share slices
+// with the cache.
+type deviceCacheEntry struct {
+	body []byte
+	etag string
+}
+
+// GetDeviceCached returns a single device by ID using a conditional request.
+// When caching is enabled and a device with an ETag is cached, If-None-Match is sent
+// and the cached device is returned on 304 Not Modified. The returned bool reports
+// whether the result came from the cache. Without caching this behaves like GetDevice.
+func (c *Client) GetDeviceCached(ctx context.Context, deviceID string) (*Device, bool, error) {
 	if deviceID == "" {
-		return nil, ErrEmptyDeviceID
+		return nil, false, ErrEmptyDeviceID
 	}
-	data, err := c.get(ctx, "/devices/"+deviceID)
+
+	path := "/devices/" + deviceID
+	ttl := c.getDeviceTTL()
+	key := cacheKey("device", deviceID)
+
+	var cached *deviceCacheEntry
+	var header http.Header
+	if ttl > 0 {
+		if v, ok := c.cacheConfig.Cache.Get(key); ok {
+			if entry, ok := v.(*deviceCacheEntry); ok && entry.etag != "" {
+				cached = entry
+				header = http.Header{"If-None-Match": []string{entry.etag}}
+			}
+		}
+	}
+
+	resp, err := c.doRequestWithRetry(ctx, http.MethodGet, path, nil, header)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		// Re-store the entry to refresh its TTL
+		c.cacheConfig.Cache.Set(key, cached, ttl)
+		var device Device
+		if err := json.Unmarshal(cached.body, &device); err != nil {
+			return nil, false, fmt.Errorf("failed to parse device: %w (body: %s)", err, truncatePreview(cached.body))
+		}
+		return &device, true, nil
 	}
 
 	var device Device
-	if err := json.Unmarshal(data, &device); err != nil {
-		return nil, fmt.Errorf("failed to parse device: %w (body: %s)", err, truncatePreview(data))
+	if err := json.Unmarshal(resp.Body, &device); err != nil {
+		return nil, false, fmt.Errorf("failed to parse device: %w (body: %s)", err, truncatePreview(resp.Body))
 	}
 
-	return &device, nil
+	if ttl > 0 {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			c.cacheConfig.Cache.Set(key, &deviceCacheEntry{body: resp.Body, etag: etag}, ttl)
+		} else {
+			c.cacheConfig.Cache.Delete(key)
+		}
+	}
+
+	return &device, false, nil
+}
+
+// getDeviceTTL returns the TTL for device caching, or 0 if caching is disabled.
+func (c *Client) getDeviceTTL() time.Duration {
+	if c.cacheConfig == nil || c.cacheConfig.Cache == nil {
+		return 0
+	}
+	return c.cacheConfig.DeviceTTL
 }
 
 // GetDeviceStatus returns the status of the main component of a device.
@@ -152,7 +314,25 @@ func (c *Client) GetDeviceFullStatus(ctx context.Context, deviceID string) (map[
 
 // GetDeviceStatusAllComponents returns a merged status from all components.
 // This is useful for devices like refrigerators where data is split across components.
+// If caching is enabled, results are cached for CacheConfig.DeviceStatusTTL;
+// use InvalidateDeviceStatus to force a fresh fetch after a command.
 func (c *Client) GetDeviceStatusAllComponents(ctx context.Context, deviceID string) (Status, error) {
+	if ttl := c.getDeviceStatusTTL(); ttl > 0 && deviceID != "" {
+		result, err := c.getCached(cacheKey("deviceStatus", deviceID), ttl, func() (any, error) {
+			return c.fetchDeviceStatusAllComponents(ctx, deviceID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Deep copy so callers can't modify the cached maps
+		return copyValue(result.(Status)).(Status), nil
+	}
+
+	return c.fetchDeviceStatusAllComponents(ctx, deviceID)
+}
+
+// fetchDeviceStatusAllComponents performs the actual API call for GetDeviceStatusAllComponents.
+func (c *Client) fetchDeviceStatusAllComponents(ctx context.Context, deviceID string) (Status, error) {
 	components, err := c.GetDeviceFullStatus(ctx, deviceID)
 	if err != nil {
 		return nil, err
@@ -167,6 +347,14 @@ func (c *Client) GetDeviceStatusAllComponents(ctx context.Context, deviceID stri
 	return merged, nil
 }
 
+// getDeviceStatusTTL returns the TTL for device status caching, or 0 if caching is disabled.
+func (c *Client) getDeviceStatusTTL() time.Duration {
+	if c.cacheConfig == nil {
+		return 0
+	}
+	return c.cacheConfig.DeviceStatusTTL
+}
+
 // GetComponentStatus returns the status of a specific component.
 func (c *Client) GetComponentStatus(ctx context.Context, deviceID, componentID string) (Status, error) {
 	if deviceID == "" {
@@ -188,16 +376,121 @@ func (c *Client) GetComponentStatus(ctx context.Context, deviceID, componentID s
 	return status, nil
 }
 
+// GetCapabilityStatus returns the status of a single capability of a device
+// component, transferring only that capability's attributes. The result has
+// the same shape as GetComponentStatus with only that capability in it, so
+// paths such as GetString(status, "switch", "switch", "value") and the
+// Extract helpers work unchanged.
+//
+// Example:
+//
+//	status, err := client.GetCapabilityStatus(ctx, deviceID, "main", "switch")
+//	if err != nil {
+//	    return err
+//	}
+//	power, _ := smartthings.GetString(status, "switch", "switch", "value")
+func (c *Client) GetCapabilityStatus(ctx context.Context, deviceID, componentID, capabilityID string) (Status, error) {
+	if deviceID == "" {
+		return nil, ErrEmptyDeviceID
+	}
+	if componentID == "" {
+		return nil, ErrEmptyComponentID
+	}
+	if capabilityID == "" {
+		return nil, ErrEmptyCapabilityID
+	}
+	data, err := c.get(ctx, "/devices/"+deviceID+"/components/"+componentID+"/capabilities/"+capabilityID+"/status")
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes map[string]any
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, fmt.Errorf("failed to parse capability status: %w (body: %s)", err, truncatePreview(data))
+	}
+
+	return Status{capabilityID: attributes}, nil
+}
+
 // ExecuteCommand sends a single command to a device.
 func (c *Client) ExecuteCommand(ctx context.Context, deviceID string, cmd Command) error {
 	return c.ExecuteCommands(ctx, deviceID, []Command{cmd})
 }
 
+// DefaultRefreshDelay is the default settle delay used by ExecuteCommandAndRefresh.
+const DefaultRefreshDelay = 500 * time.Millisecond
+
+// WithRefreshDelay sets how long ExecuteCommandAndRefresh waits after a
+// command before fetching the device status, giving the device time to report
+// its new state. Defaults to DefaultRefreshDelay. Zero or negative fetches
+// immediately.
+func WithRefreshDelay(d time.Duration) Option {
+	return func(c *Client) {
+		c.refreshDelay = d
+	}
+}
+
+// ExecuteCommandAndRefresh sends a command to a device, waits for the settle
+// delay configured with WithRefreshDelay, and returns the device's fresh main
+// component status. If the command fails, its error is returned with a nil
+// status and no status is fetched.
+//
+// Example:
+//
+//	status, err := client.ExecuteCommandAndRefresh(ctx, deviceID, NewCommand("switch", "on"))
+//	if err == nil {
+//	    sw, _ := GetString(status, "switch", "switch", "value")
+//	    fmt.Println("switch is", sw)
+//	}
+func (c *Client) ExecuteCommandAndRefresh(ctx context.Context, deviceID string, cmd Command) (Status, error) {
+	if err := c.ExecuteCommand(ctx, deviceID, cmd); err != nil {
+		return nil, err
+	}
+
+	if c.refreshDelay > 0 {
+		timer := time.NewTimer(c.refreshDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return c.GetDeviceStatus(ctx, deviceID)
+}
+
+// WithOfflinePrecheck makes ExecuteCommand and ExecuteCommands check the
+// device's health first and return an error wrapping ErrDeviceOffline without
+// sending the command if the device reports OFFLINE. This costs one extra
+// request per command. If the health check itself fails, the command is sent
+// anyway and its own result is returned.
+func WithOfflinePrecheck() Option {
+	return func(c *Client) {
+		c.offlinePrecheck = true
+	}
+}
+
 // ExecuteCommands sends multiple commands to a device.
+// If the device is unreachable, the returned error satisfies IsDeviceOffline.
+// With WithCommandValidation, nothing is sent unless every command passes
+// ValidateCommand.
 func (c *Client) ExecuteCommands(ctx context.Context, deviceID string, cmds []Command) error {
 	if deviceID == "" {
 		return ErrEmptyDeviceID
 	}
+	if c.validateCommands {
+		for _, cmd := range cmds {
+			if err := c.ValidateCommand(ctx, cmd); err != nil {
+				return err
+			}
+		}
+	}
+	if c.offlinePrecheck {
+		if health, err := c.GetDeviceHealth(ctx, deviceID); err == nil && health.State == "OFFLINE" {
+			return fmt.Errorf("%w: %s", ErrDeviceOffline, deviceID)
+		}
+	}
 	// Ensure each command has a component (default to "main")
 	for i := range cmds {
 		if cmds[i].Component == "" {
@@ -207,19 +500,66 @@ func (c *Client) ExecuteCommands(ctx context.Context, deviceID string, cmds []Co
 
 	req := CommandRequest{Commands: cmds}
 	_, err := c.post(ctx, "/devices/"+deviceID+"/commands", req)
+	if c.logHooks {
+		for _, cmd := range cmds {
+			c.LogDeviceCommand(ctx, deviceID, cmd.Capability, cmd.Command, err)
+		}
+	}
 	return err
 }
 
+// CommandStep is one step of ExecuteCommandSequence: a command and how long
+// to wait after it before sending the next one.
+type CommandStep struct {
+	Command Command
+	Delay   time.Duration
+}
+
+// ExecuteCommandSequence sends each step's command to a device as its own
+// request, in order, waiting the step's delay before the next. Use it for
+// devices that drop commands sent together, e.g. switching on before setting
+// a level. The last step's delay is not waited. It stops at the first
+// failure and reports which step failed; earlier steps are not undone.
+//
+// Example:
+//
+//	err := client.ExecuteCommandSequence(ctx, deviceID, []smartthings.CommandStep{
+//	    {Command: smartthings.NewCommand("switch", "on"), Delay: 500 * time.Millisecond},
+//	    {Command: smartthings.NewCommand("switchLevel", "setLevel", 40)},
+//	})
+func (c *Client) ExecuteCommandSequence(ctx context.Context, deviceID string, steps []CommandStep) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	for i, step := range steps {
+		cmd := step.Command
+		if err := c.ExecuteCommand(ctx, deviceID, cmd); err != nil {
+			return fmt.Errorf("step %d (%s.%s): %w", i, cmd.Capability, cmd.Command, err)
+		}
+		if i < len(steps)-1 && step.Delay > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("step %d (%s.%s): %w", i+1, steps[i+1].Command.Capability, steps[i+1].Command.Command, ctx.Err())
+			case <-time.After(step.Delay):
+			}
+		}
+	}
+	return nil
+}
+
 // DeleteDevice deletes a device.
 func (c *Client) DeleteDevice(ctx context.Context, deviceID string) error {
 	if deviceID == "" {
 		return ErrEmptyDeviceID
 	}
 	_, err := c.delete(ctx, "/devices/"+deviceID)
+	if err == nil {
+		c.InvalidateCache("device", deviceID)
+	}
 	return err
 }
 
-// UpdateDevice updates a device (currently only the label can be updated).
+// UpdateDevice updates a device's label or room.
 func (c *Client) UpdateDevice(ctx context.Context, deviceID string, update *DeviceUpdate) (*Device, error) {
 	if deviceID == "" {
 		return nil, ErrEmptyDeviceID
@@ -234,10 +574,46 @@ func (c *Client) UpdateDevice(ctx context.Context, deviceID string, update *Devi
 	if err := json.Unmarshal(data, &device); err != nil {
 		return nil, fmt.Errorf("failed to parse updated device: %w (body: %s)", err, truncatePreview(data))
 	}
+	c.InvalidateCache("device", deviceID)
 
 	return &device, nil
 }
 
+// MoveDevice moves a device into a room of the target location and returns
+// the updated device. The room is checked to exist in the location first.
+//
+// The SmartThings API can change a device's room but not its location, so
+// if the device belongs to another location MoveDevice returns an error
+// wrapping ErrLocationChange without changing anything; move it with the
+// SmartThings app instead.
+func (c *Client) MoveDevice(ctx context.Context, deviceID, targetLocationID, targetRoomID string) (*Device, error) {
+	if deviceID == "" {
+		return nil, ErrEmptyDeviceID
+	}
+	if targetLocationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+	if targetRoomID == "" {
+		return nil, ErrEmptyRoomID
+	}
+
+	if _, err := c.GetRoom(ctx, targetLocationID, targetRoomID); err != nil {
+		return nil, err
+	}
+	device, err := c.GetDevice(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if device.LocationID != targetLocationID {
+		return nil, fmt.Errorf("%w: device %s is in location %s, not %s", ErrLocationChange, deviceID, device.LocationID, targetLocationID)
+	}
+	if device.RoomID == targetRoomID {
+		return device, nil
+	}
+
+	return c.UpdateDevice(ctx, deviceID, &DeviceUpdate{RoomID: targetRoomID})
+}
+
 // GetDeviceHealth returns the health status of a device.
 func (c *Client) GetDeviceHealth(ctx context.Context, deviceID string) (*DeviceHealth, error) {
 	if deviceID == "" {
@@ -292,6 +668,39 @@ func (c *Client) ExecuteComponentCommand(ctx context.Context, deviceID, componen
 	return c.ExecuteCommand(ctx, deviceID, cmd)
 }
 
+// ExecuteCommandOnComponent sends cmd to the named component of a device,
+// overriding cmd.Component. Unlike ExecuteComponentCommand, it first checks
+// the component against the device's component list (fetched with GetDevice,
+// which is cached when caching is enabled) and returns an error wrapping
+// ErrComponentNotFound if the device has no such component.
+//
+// Example:
+//
+//	cmd := NewCommand("switch", "on")
+//	err := client.ExecuteCommandOnComponent(ctx, deviceID, "icemaker", cmd)
+//	if errors.Is(err, smartthings.ErrComponentNotFound) {
+//	    log.Printf("no icemaker on %s", deviceID)
+//	}
+func (c *Client) ExecuteCommandOnComponent(ctx context.Context, deviceID, componentID string, cmd Command) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if componentID == "" {
+		return ErrEmptyComponentID
+	}
+
+	device, err := c.GetDevice(ctx, deviceID)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(device.Components, func(comp Component) bool { return comp.ID == componentID }) {
+		return fmt.Errorf("%w: %q on device %s", ErrComponentNotFound, componentID, deviceID)
+	}
+
+	cmd.Component = componentID
+	return c.ExecuteCommand(ctx, deviceID, cmd)
+}
+
 // FilterDevices returns devices matching the given filter function.
 func FilterDevices(devices []Device, filter func(Device) bool) []Device {
 	result := make([]Device, 0, len(devices))
@@ -331,3 +740,135 @@ func FindDeviceByID(devices []Device, deviceID string) *Device {
 	}
 	return nil
 }
+
+// DeviceCategoryOther is the category of devices that report none.
+const DeviceCategoryOther = "other"
+
+// DeviceCategoryName returns a device's category as SmartThings names it
+// (e.g. "Light", "Television", "MotionSensor", "Washer"). Categories of the
+// main component are preferred over those of other components, and a
+// category the user assigned over the manufacturer's. Hubs without a
+// category are "Hub"; any other device without one is DeviceCategoryOther.
+func DeviceCategoryName(device *Device) string {
+	if device == nil {
+		return DeviceCategoryOther
+	}
+
+	components := make([]*Component, 0, len(device.Components))
+	if main := device.MainComponent(); main != nil {
+		components = append(components, main)
+	}
+	for i := range device.Components {
+		if device.Components[i].ID != "main" {
+			components = append(components, &device.Components[i])
+		}
+	}
+
+	for _, comp := range components {
+		var name string
+		for _, cat := range comp.Categories {
+			if cat.Name == "" {
+				continue
+			}
+			if cat.CategoryType == "user" {
+				return cat.Name
+			}
+			if name == "" {
+				name = cat.Name
+			}
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	if device.Type == DeviceTypeHUB {
+		return "Hub"
+	}
+	return DeviceCategoryOther
+}
+
+// GroupDevicesByCategory groups devices by DeviceCategoryName, preserving
+// their order within each group.
+func GroupDevicesByCategory(devices []Device) map[string][]Device {
+	groups := make(map[string][]Device)
+	for i := range devices {
+		category := DeviceCategoryName(&devices[i])
+		groups[category] = append(groups[category], devices[i])
+	}
+	return groups
+}
+
+// ComponentCapabilities returns the capability IDs of each component of an
+// already-fetched device, keyed by component ID.
+func ComponentCapabilities(device *Device) map[string][]string {
+	if device == nil {
+		return nil
+	}
+	result := make(map[string][]string, len(device.Components))
+	for _, comp := range device.Components {
+		caps := make([]string, 0, len(comp.Capabilities))
+		for _, ref := range comp.Capabilities {
+			caps = append(caps, ref.ID)
+		}
+		result[comp.ID] = caps
+	}
+	return result
+}
+
+// ComponentByID returns the device component with the given ID.
+func (d *Device) ComponentByID(id string) (*Component, bool) {
+	for i := range d.Components {
+		if d.Components[i].ID == id {
+			return &d.Components[i], true
+		}
+	}
+	return nil, false
+}
+
+// MainComponent returns the device's "main" component, or nil if the device
+// has none (e.g. when it was listed without component details).
+func (d *Device) MainComponent() *Component {
+	comp, _ := d.ComponentByID("main")
+	return comp
+}
+
+// HasCapability reports whether the component supports the given capability.
+func (c *Component) HasCapability(capability string) bool {
+	_, ok := c.CapabilityVersion(capability)
+	return ok
+}
+
+// CapabilityVersion returns the version of a capability supported by the
+// component. The version is 0 if the API did not report one.
+func (c *Component) CapabilityVersion(capability string) (int, bool) {
+	for _, ref := range c.Capabilities {
+		if ref.ID == capability {
+			return ref.Version, true
+		}
+	}
+	return 0, false
+}
+
+// DeviceHasCapability reports whether any component of a device supports the
+// given capability. The device is fetched with GetDevice, which is cached when
+// caching is enabled. Use ComponentCapabilities when the Device is already at hand.
+func (c *Client) DeviceHasCapability(ctx context.Context, deviceID, capability string) (bool, error) {
+	if deviceID == "" {
+		return false, ErrEmptyDeviceID
+	}
+	if capability == "" {
+		return false, ErrEmptyCapabilityID
+	}
+
+	device, err := c.GetDevice(ctx, deviceID)
+	if err != nil {
+		return false, err
+	}
+	for _, caps := range ComponentCapabilities(device) {
+		if slices.Contains(caps, capability) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
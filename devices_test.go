@@ -3,9 +3,13 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_ListDevices(t *testing.T) {
@@ -270,6 +274,59 @@ func TestClient_GetComponentStatus(t *testing.T) {
 	})
 }
 
+func TestClient_GetCapabilityStatus(t *testing.T) {
+	t.Run("successful response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if want := "/devices/device-123/components/main/capabilities/switch/status"; r.URL.Path != want {
+				t.Errorf("path = %q, want %q", r.URL.Path, want)
+			}
+			w.Write([]byte(`{"switch": {"value": "on", "timestamp": "2025-01-01T00:00:00Z"}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		status, err := client.GetCapabilityStatus(context.Background(), "device-123", "main", "switch")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if power, _ := GetString(status, "switch", "switch", "value"); power != "on" {
+			t.Errorf("switch = %q, want %q", power, "on")
+		}
+		if len(status) != 1 {
+			t.Errorf("status = %v, want only the switch capability", status)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.GetCapabilityStatus(context.Background(), "device-123", "main", "switch"); err == nil {
+			t.Fatal("expected error for invalid JSON")
+		}
+	})
+
+	t.Run("empty IDs", func(t *testing.T) {
+		client, _ := NewClient("token")
+		tests := []struct {
+			deviceID, componentID, capabilityID string
+			want                                error
+		}{
+			{"", "main", "switch", ErrEmptyDeviceID},
+			{"device-123", "", "switch", ErrEmptyComponentID},
+			{"device-123", "main", "", ErrEmptyCapabilityID},
+		}
+		for _, tt := range tests {
+			if _, err := client.GetCapabilityStatus(context.Background(), tt.deviceID, tt.componentID, tt.capabilityID); err != tt.want {
+				t.Errorf("GetCapabilityStatus(%q, %q, %q) error = %v, want %v", tt.deviceID, tt.componentID, tt.capabilityID, err, tt.want)
+			}
+		}
+	})
+}
+
 func TestClient_ExecuteCommand(t *testing.T) {
 	t.Run("successful command", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -363,6 +420,96 @@ func TestClient_ExecuteCommands(t *testing.T) {
 	})
 }
 
+func TestClient_ExecuteCommandSequence(t *testing.T) {
+	t.Run("sends each step as its own request with delays", func(t *testing.T) {
+		var sent []string
+		var times []time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req CommandRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req.Commands) != 1 {
+				t.Errorf("got %d commands in one request, want 1", len(req.Commands))
+			}
+			for _, cmd := range req.Commands {
+				sent = append(sent, cmd.Component+"/"+cmd.Capability+"."+cmd.Command)
+			}
+			times = append(times, time.Now())
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.ExecuteCommandSequence(context.Background(), "dev-1", []CommandStep{
+			{Command: NewCommand("switch", "on"), Delay: 50 * time.Millisecond},
+			{Command: NewCommand("switchLevel", "setLevel", 40), Delay: time.Hour},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"main/switch.on", "main/switchLevel.setLevel"}; fmt.Sprint(sent) != fmt.Sprint(want) {
+			t.Errorf("sent %v, want %v", sent, want)
+		}
+		if gap := times[1].Sub(times[0]); gap < 50*time.Millisecond {
+			t.Errorf("second command sent %v after the first, want at least 50ms", gap)
+		}
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests == 2 {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.ExecuteCommandSequence(context.Background(), "dev-1", []CommandStep{
+			{Command: NewCommand("switch", "on")},
+			{Command: NewCommand("switchLevel", "setLevel", 40)},
+			{Command: NewCommand("switch", "off")},
+		})
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+			t.Fatalf("expected 422 APIError, got %v", err)
+		}
+		if want := "step 1 (switchLevel.setLevel)"; !strings.HasPrefix(err.Error(), want) {
+			t.Errorf("error = %q, want prefix %q", err, want)
+		}
+		if requests != 2 {
+			t.Errorf("sent %d requests, want 2", requests)
+		}
+	})
+
+	t.Run("context canceled during delay", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.ExecuteCommandSequence(ctx, "dev-1", []CommandStep{
+			{Command: NewCommand("switch", "on"), Delay: time.Hour},
+			{Command: NewCommand("switch", "off")},
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.ExecuteCommandSequence(context.Background(), "", nil); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
 func TestNewCommand(t *testing.T) {
 	t.Run("without arguments", func(t *testing.T) {
 		cmd := NewCommand("switch", "on")
@@ -568,3 +715,688 @@ func TestFindDeviceByID(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_GetDeviceCached(t *testing.T) {
+	t.Run("returns cached device on 304", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				if r.Header.Get("If-None-Match") != "" {
+					t.Errorf("unexpected If-None-Match on first request: %q", r.Header.Get("If-None-Match"))
+				}
+				w.Header().Set("ETag", `"v1"`)
+				json.NewEncoder(w).Encode(Device{DeviceID: "device-123", Label: "My Device"})
+				return
+			}
+			if r.Header.Get("If-None-Match") != `"v1"` {
+				t.Errorf("If-None-Match = %q, want %q", r.Header.Get("If-None-Match"), `"v1"`)
+			}
+			w.WriteHeader(http.StatusNotModified)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCache(nil))
+
+		device, hit, err := client.GetDeviceCached(context.Background(), "device-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if hit {
+			t.Error("expected cache miss on first request")
+		}
+		if device.Label != "My Device" {
+			t.Errorf("Label = %q, want %q", device.Label, "My Device")
+		}
+
+		device, hit, err = client.GetDeviceCached(context.Background(), "device-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !hit {
+			t.Error("expected cache hit on 304")
+		}
+		if device.Label != "My Device" {
+			t.Errorf("Label = %q, want %q", device.Label, "My Device")
+		}
+		if calls != 2 {
+			t.Errorf("got %d requests, want 2", calls)
+		}
+	})
+
+	t.Run("caller mutations do not leak into the cache", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			json.NewEncoder(w).Encode(Device{
+				DeviceID: "device-123",
+				Components: []Component{{
+					ID:           "main",
+					Capabilities: []CapabilityRef{{ID: "switch"}},
+				}},
+			})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCache(nil))
+		ctx := context.Background()
+
+		for i := range 3 {
+			device, _, err := client.GetDeviceCached(ctx, "device-123")
+			if err != nil {
+				t.Fatalf("request %d: unexpected error: %v", i+1, err)
+			}
+			if len(device.Components) != 1 || device.Components[0].ID != "main" || device.Components[0].Capabilities[0].ID != "switch" {
+				t.Fatalf("request %d: components = %+v, want the original", i+1, device.Components)
+			}
+			device.Components[0].ID = "tampered"
+			device.Components[0].Capabilities[0].ID = "tampered"
+		}
+	})
+
+	t.Run("304 refreshes cache TTL", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			json.NewEncoder(w).Encode(Device{DeviceID: "device-123"})
+		}))
+		defer server.Close()
+
+		ttl := 50 * time.Millisecond
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCache(&CacheConfig{DeviceTTL: ttl}))
+		ctx := context.Background()
+
+		client.GetDeviceCached(ctx, "device-123")
+		for i := 0; i < 3; i++ {
+			time.Sleep(ttl / 2)
+			if _, hit, _ := client.GetDeviceCached(ctx, "device-123"); !hit {
+				t.Fatalf("request %d: expected cache hit after TTL refresh", i+2)
+			}
+		}
+	})
+
+	t.Run("new ETag replaces cached device", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, calls))
+			json.NewEncoder(w).Encode(Device{DeviceID: "device-123", Label: fmt.Sprintf("Label %d", calls)})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithCache(nil))
+		client.GetDevice(context.Background(), "device-123")
+		device, hit, err := client.GetDeviceCached(context.Background(), "device-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if hit {
+			t.Error("expected cache miss on 200")
+		}
+		if device.Label != "Label 2" {
+			t.Errorf("Label = %q, want %q", device.Label, "Label 2")
+		}
+	})
+
+	t.Run("without cache", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") != "" {
+				t.Error("unexpected If-None-Match without cache")
+			}
+			w.Header().Set("ETag", `"v1"`)
+			json.NewEncoder(w).Encode(Device{DeviceID: "device-123"})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		for i := 0; i < 2; i++ {
+			_, hit, err := client.GetDeviceCached(context.Background(), "device-123")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hit {
+				t.Error("expected no cache hit without cache")
+			}
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, _, err := client.GetDeviceCached(context.Background(), "")
+		if err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
+func TestClient_ExecuteCommandOnComponent(t *testing.T) {
+	device := Device{
+		DeviceID:   "fridge-1",
+		Components: []Component{{ID: "main"}, {ID: "icemaker"}},
+	}
+
+	t.Run("sends to existing component", func(t *testing.T) {
+		var commandPosted bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/devices/fridge-1":
+				json.NewEncoder(w).Encode(device)
+			case "/devices/fridge-1/commands":
+				commandPosted = true
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if req.Commands[0].Component != "icemaker" {
+					t.Errorf("component = %q, want %q", req.Commands[0].Component, "icemaker")
+				}
+				w.WriteHeader(http.StatusOK)
+			default:
+				t.Errorf("unexpected path %q", r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		cmd := NewComponentCommand("main", "switch", "on")
+		if err := client.ExecuteCommandOnComponent(context.Background(), "fridge-1", "icemaker", cmd); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !commandPosted {
+			t.Error("command was not sent")
+		}
+	})
+
+	t.Run("unknown component", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/devices/fridge-1" {
+				t.Errorf("unexpected request to %q", r.URL.Path)
+			}
+			json.NewEncoder(w).Encode(device)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.ExecuteCommandOnComponent(context.Background(), "fridge-1", "icemakr", NewCommand("switch", "on"))
+		if !errors.Is(err, ErrComponentNotFound) {
+			t.Errorf("expected ErrComponentNotFound, got %v", err)
+		}
+	})
+
+	t.Run("device lookup error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.ExecuteCommandOnComponent(context.Background(), "fridge-1", "main", NewCommand("switch", "on"))
+		if !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.ExecuteCommandOnComponent(context.Background(), "", "main", Command{}); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.ExecuteCommandOnComponent(context.Background(), "fridge-1", "", Command{}); err != ErrEmptyComponentID {
+			t.Errorf("expected ErrEmptyComponentID, got %v", err)
+		}
+	})
+}
+
+func TestClient_ExecuteCommandAndRefresh(t *testing.T) {
+	t.Run("executes, waits, and refreshes", func(t *testing.T) {
+		var commandAt time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/devices/device-123/commands":
+				commandAt = time.Now()
+				w.WriteHeader(http.StatusOK)
+			case r.Method == http.MethodGet && r.URL.Path == "/devices/device-123/components/main/status":
+				if commandAt.IsZero() {
+					t.Error("status fetched before command")
+				} else if elapsed := time.Since(commandAt); elapsed < 20*time.Millisecond {
+					t.Errorf("status fetched %v after command, want >= 20ms", elapsed)
+				}
+				w.Write([]byte(`{"switch":{"switch":{"value":"on"}}}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRefreshDelay(20*time.Millisecond))
+		status, err := client.ExecuteCommandAndRefresh(context.Background(), "device-123", NewCommand("switch", "on"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, _ := GetString(status, "switch", "switch", "value"); v != "on" {
+			t.Errorf("switch = %q, want %q", v, "on")
+		}
+	})
+
+	t.Run("command error skips refresh", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				t.Error("status should not be fetched")
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRefreshDelay(0))
+		status, err := client.ExecuteCommandAndRefresh(context.Background(), "device-123", NewCommand("switch", "on"))
+		if !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		if status != nil {
+			t.Errorf("status = %v, want nil", status)
+		}
+	})
+
+	t.Run("context canceled during settle delay", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				t.Error("status should not be fetched")
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRefreshDelay(time.Minute))
+		_, err := client.ExecuteCommandAndRefresh(ctx, "device-123", NewCommand("switch", "on"))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("default delay", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if client.refreshDelay != DefaultRefreshDelay {
+			t.Errorf("refreshDelay = %v, want %v", client.refreshDelay, DefaultRefreshDelay)
+		}
+	})
+}
+
+func TestWithOfflinePrecheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		healthStatus int
+		healthState  string
+		wantOffline  bool
+		wantCommand  bool
+	}{
+		{"offline device", http.StatusOK, "OFFLINE", true, false},
+		{"online device", http.StatusOK, "ONLINE", false, true},
+		{"health check fails", http.StatusInternalServerError, "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var commandSent bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/devices/device-123/health":
+					w.WriteHeader(tt.healthStatus)
+					if tt.healthState != "" {
+						fmt.Fprintf(w, `{"deviceId":"device-123","state":%q}`, tt.healthState)
+					}
+				case "/devices/device-123/commands":
+					commandSent = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL), WithOfflinePrecheck())
+			err := client.ExecuteCommand(context.Background(), "device-123", NewCommand("switch", "on"))
+			if got := IsDeviceOffline(err); got != tt.wantOffline {
+				t.Errorf("IsDeviceOffline() = %v, want %v (err: %v)", got, tt.wantOffline, err)
+			}
+			if !tt.wantOffline && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if commandSent != tt.wantCommand {
+				t.Errorf("command sent = %v, want %v", commandSent, tt.wantCommand)
+			}
+		})
+	}
+}
+
+func TestComponentCapabilities(t *testing.T) {
+	device := &Device{
+		Components: []Component{
+			{ID: "main", Capabilities: []CapabilityRef{{ID: "switch"}, {ID: "switchLevel"}}},
+			{ID: "icemaker", Capabilities: []CapabilityRef{{ID: "switch"}}},
+			{ID: "empty"},
+		},
+	}
+
+	got := ComponentCapabilities(device)
+	if len(got) != 3 {
+		t.Fatalf("got %d components, want 3", len(got))
+	}
+	if caps := got["main"]; len(caps) != 2 || caps[0] != "switch" || caps[1] != "switchLevel" {
+		t.Errorf("main = %v, want [switch switchLevel]", caps)
+	}
+	if caps := got["icemaker"]; len(caps) != 1 || caps[0] != "switch" {
+		t.Errorf("icemaker = %v, want [switch]", caps)
+	}
+	if caps, ok := got["empty"]; !ok || len(caps) != 0 {
+		t.Errorf("empty = %v (present %v), want empty slice", caps, ok)
+	}
+
+	if ComponentCapabilities(nil) != nil {
+		t.Error("expected nil for nil device")
+	}
+}
+
+func TestDevice_ComponentByID(t *testing.T) {
+	device := &Device{
+		Components: []Component{
+			{ID: "main", Capabilities: []CapabilityRef{{ID: "switch", Version: 1}, {ID: "switchLevel"}}},
+			{ID: "icemaker", Capabilities: []CapabilityRef{{ID: "switch"}}},
+		},
+	}
+
+	comp, ok := device.ComponentByID("icemaker")
+	if !ok || comp.ID != "icemaker" {
+		t.Fatalf("ComponentByID(icemaker) = %v, %v", comp, ok)
+	}
+	if comp != &device.Components[1] {
+		t.Error("expected pointer into device.Components")
+	}
+	if _, ok := device.ComponentByID("missing"); ok {
+		t.Error("expected missing component not to be found")
+	}
+
+	main := device.MainComponent()
+	if main == nil || main.ID != "main" {
+		t.Fatalf("MainComponent() = %v", main)
+	}
+	if !main.HasCapability("switchLevel") || main.HasCapability("colorControl") {
+		t.Error("HasCapability returned wrong result")
+	}
+	if v, ok := main.CapabilityVersion("switch"); !ok || v != 1 {
+		t.Errorf("CapabilityVersion(switch) = %d, %v, want 1, true", v, ok)
+	}
+	if _, ok := main.CapabilityVersion("colorControl"); ok {
+		t.Error("expected unsupported capability to have no version")
+	}
+
+	if (&Device{}).MainComponent() != nil {
+		t.Error("expected nil main component for device without components")
+	}
+}
+
+func TestDeviceCategoryName(t *testing.T) {
+	tests := []struct {
+		name   string
+		device *Device
+		want   string
+	}{
+		{"nil device", nil, DeviceCategoryOther},
+		{"no categories", &Device{Type: DeviceTypeLAN}, DeviceCategoryOther},
+		{"hub without category", &Device{Type: DeviceTypeHUB}, "Hub"},
+		{"manufacturer category", &Device{Components: []Component{
+			{ID: "main", Categories: []DeviceCategory{{Name: "Light", CategoryType: "manufacturer"}}},
+		}}, "Light"},
+		{"user category wins", &Device{Components: []Component{
+			{ID: "main", Categories: []DeviceCategory{{Name: "Switch", CategoryType: "manufacturer"}, {Name: "Light", CategoryType: "user"}}},
+		}}, "Light"},
+		{"main component first", &Device{Components: []Component{
+			{ID: "freezer", Categories: []DeviceCategory{{Name: "Freezer"}}},
+			{ID: "main", Categories: []DeviceCategory{{Name: "Refrigerator"}}},
+		}}, "Refrigerator"},
+		{"falls back to other components", &Device{Components: []Component{
+			{ID: "main"},
+			{ID: "sub", Categories: []DeviceCategory{{Name: "MotionSensor"}}},
+		}}, "MotionSensor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeviceCategoryName(tt.device); got != tt.want {
+				t.Errorf("DeviceCategoryName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupDevicesByCategory(t *testing.T) {
+	light := func(id string) Device {
+		return Device{DeviceID: id, Components: []Component{{ID: "main", Categories: []DeviceCategory{{Name: "Light"}}}}}
+	}
+	devices := []Device{
+		light("light-1"),
+		{DeviceID: "tv-1", Components: []Component{{ID: "main", Categories: []DeviceCategory{{Name: "Television"}}}}},
+		light("light-2"),
+		{DeviceID: "unknown-1"},
+	}
+
+	groups := GroupDevicesByCategory(devices)
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %v", groups)
+	}
+	if lights := groups["Light"]; len(lights) != 2 || lights[0].DeviceID != "light-1" || lights[1].DeviceID != "light-2" {
+		t.Errorf("Light group = %+v", lights)
+	}
+	if len(groups["Television"]) != 1 || len(groups[DeviceCategoryOther]) != 1 {
+		t.Errorf("unexpected groups: %v", groups)
+	}
+	if got := GroupDevicesByCategory(nil); len(got) != 0 {
+		t.Errorf("expected empty map, got %v", got)
+	}
+}
+
+func TestClient_DeviceHasCapability(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devices/device-123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(Device{
+			DeviceID: "device-123",
+			Components: []Component{
+				{ID: "main", Capabilities: []CapabilityRef{{ID: "switch"}}},
+				{ID: "sub", Capabilities: []CapabilityRef{{ID: "temperatureMeasurement"}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	tests := []struct {
+		capability string
+		want       bool
+	}{
+		{"switch", true},
+		{"temperatureMeasurement", true},
+		{"lock", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.capability, func(t *testing.T) {
+			got, err := client.DeviceHasCapability(ctx, "device-123", tt.capability)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DeviceHasCapability(%q) = %v, want %v", tt.capability, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown device", func(t *testing.T) {
+		if _, err := client.DeviceHasCapability(ctx, "missing", "switch"); !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := client.DeviceHasCapability(ctx, "", "switch"); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+		if _, err := client.DeviceHasCapability(ctx, "device-123", ""); err != ErrEmptyCapabilityID {
+			t.Errorf("expected ErrEmptyCapabilityID, got %v", err)
+		}
+	})
+}
+
+func TestClient_ListDevicesByLocation(t *testing.T) {
+	t.Run("groups devices by location", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query()["locationId"]; len(got) != 3 {
+				t.Errorf("expected 3 locationId filters, got %v", got)
+			}
+			w.Write([]byte(`{"items":[
+				{"deviceId":"d1","locationId":"home"},
+				{"deviceId":"d2","locationId":"cabin"},
+				{"deviceId":"d3","locationId":"home"},
+				{"deviceId":"d4"}
+			],"_links":{}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		byLocation, err := client.ListDevicesByLocation(context.Background(), []string{"home", "cabin", "office"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(byLocation["home"]) != 2 || byLocation["home"][0].DeviceID != "d1" || byLocation["home"][1].DeviceID != "d3" {
+			t.Errorf("home = %+v, want d1 and d3", byLocation["home"])
+		}
+		if len(byLocation["cabin"]) != 1 {
+			t.Errorf("cabin = %+v, want d2", byLocation["cabin"])
+		}
+		if devices, ok := byLocation["office"]; !ok || len(devices) != 0 {
+			t.Errorf("office = %+v (present: %v), want empty entry", devices, ok)
+		}
+		if len(byLocation[""]) != 1 || byLocation[""][0].DeviceID != "d4" {
+			t.Errorf("unlocated = %+v, want d4", byLocation[""])
+		}
+	})
+
+	t.Run("empty location IDs", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if _, err := client.ListDevicesByLocation(context.Background(), nil); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.ListDevicesByLocation(context.Background(), []string{"home"}); err != ErrUnauthorized {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
+
+func TestClient_MoveDevice(t *testing.T) {
+	newServer := func(t *testing.T, device string, puts *[]DeviceUpdate) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/locations/loc-1/rooms/room-2":
+				w.Write([]byte(`{"roomId": "room-2", "locationId": "loc-1", "name": "Kitchen"}`))
+			case r.URL.Path == "/devices/dev-1" && r.Method == http.MethodGet:
+				w.Write([]byte(device))
+			case r.URL.Path == "/devices/dev-1" && r.Method == http.MethodPut:
+				var update DeviceUpdate
+				json.NewDecoder(r.Body).Decode(&update)
+				*puts = append(*puts, update)
+				w.Write([]byte(`{"deviceId": "dev-1", "locationId": "loc-1", "roomId": "` + update.RoomID + `"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	t.Run("moves to another room", func(t *testing.T) {
+		var puts []DeviceUpdate
+		server := newServer(t, `{"deviceId": "dev-1", "locationId": "loc-1", "roomId": "room-1"}`, &puts)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		device, err := client.MoveDevice(context.Background(), "dev-1", "loc-1", "room-2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if device.RoomID != "room-2" || len(puts) != 1 || puts[0] != (DeviceUpdate{RoomID: "room-2"}) {
+			t.Errorf("device = %+v, updates = %+v", device, puts)
+		}
+	})
+
+	t.Run("already in room", func(t *testing.T) {
+		var puts []DeviceUpdate
+		server := newServer(t, `{"deviceId": "dev-1", "locationId": "loc-1", "roomId": "room-2"}`, &puts)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		device, err := client.MoveDevice(context.Background(), "dev-1", "loc-1", "room-2")
+		if err != nil || device.RoomID != "room-2" || len(puts) != 0 {
+			t.Errorf("device = %+v, err = %v, updates = %+v", device, err, puts)
+		}
+	})
+
+	t.Run("other location", func(t *testing.T) {
+		var puts []DeviceUpdate
+		server := newServer(t, `{"deviceId": "dev-1", "locationId": "loc-9", "roomId": "room-1"}`, &puts)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		_, err := client.MoveDevice(context.Background(), "dev-1", "loc-1", "room-2")
+		if !errors.Is(err, ErrLocationChange) || len(puts) != 0 {
+			t.Errorf("err = %v, updates = %+v, want ErrLocationChange and no update", err, puts)
+		}
+	})
+
+	t.Run("room not in location", func(t *testing.T) {
+		var puts []DeviceUpdate
+		server := newServer(t, `{"deviceId": "dev-1", "locationId": "loc-1"}`, &puts)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.MoveDevice(context.Background(), "dev-1", "loc-1", "room-3"); !IsNotFound(err) || len(puts) != 0 {
+			t.Errorf("err = %v, updates = %+v, want not found and no update", err, puts)
+		}
+	})
+
+	t.Run("validates IDs", func(t *testing.T) {
+		client, _ := NewClient("token")
+		tests := []struct {
+			deviceID, locationID, roomID string
+			wantErr                      error
+		}{
+			{"", "loc-1", "room-1", ErrEmptyDeviceID},
+			{"dev-1", "", "room-1", ErrEmptyLocationID},
+			{"dev-1", "loc-1", "", ErrEmptyRoomID},
+		}
+		for _, tt := range tests {
+			if _, err := client.MoveDevice(context.Background(), tt.deviceID, tt.locationID, tt.roomID); err != tt.wantErr {
+				t.Errorf("MoveDevice(%q, %q, %q) error = %v, want %v", tt.deviceID, tt.locationID, tt.roomID, err, tt.wantErr)
+			}
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package smartthings
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// FindDeviceByLabel returns the device whose label matches label, ignoring
+// case. Devices without a label are matched by name. If several devices
+// share the label, the first one listed is returned. Returns
+// ErrDeviceNotFound if no device matches.
+//
+// Example:
+//
+//	device, err := client.FindDeviceByLabel(ctx, "Living Room Lamp")
+//	if errors.Is(err, smartthings.ErrDeviceNotFound) {
+//	    // No such device
+//	}
+func (c *Client) FindDeviceByLabel(ctx context.Context, label string) (*Device, error) {
+	if label == "" {
+		return nil, ErrEmptyLabel
+	}
+
+	devices, err := c.ListAllDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range devices {
+		if strings.EqualFold(displayName(&devices[i]), label) {
+			return &devices[i], nil
+		}
+	}
+	return nil, ErrDeviceNotFound
+}
+
+// SearchDevices returns all devices whose label or name matches query,
+// ignoring case. A device matches if the query is a substring of its label or
+// name, or if every word of the query begins one of its words (so "lamp liv"
+// matches "Living Room Lamp"). Search may return multiple devices; they are ordered
+// best match first: exact matches, then prefix matches, then substring
+// matches, then word matches. An empty result is not an error.
+func (c *Client) SearchDevices(ctx context.Context, query string) ([]Device, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	devices, err := c.ListAllDevices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type match struct {
+		device Device
+		score  int
+	}
+	var matches []match
+	for _, device := range devices {
+		score := max(matchScore(query, device.Label), matchScore(query, device.Name))
+		if score > 0 {
+			matches = append(matches, match{device, score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b match) int { return b.score - a.score })
+
+	results := make([]Device, len(matches))
+	for i, m := range matches {
+		results[i] = m.device
+	}
+	return results, nil
+}
+
+// displayName returns a device's label, falling back to its name.
+func displayName(device *Device) string {
+	if device.Label != "" {
+		return device.Label
+	}
+	return device.Name
+}
+
+// matchScore rates how well a lowercase query matches text:
+// 4 exact, 3 prefix, 2 substring, 1 all query words present, 0 no match.
+func matchScore(query, text string) int {
+	text = strings.ToLower(text)
+	switch {
+	case text == "":
+		return 0
+	case text == query:
+		return 4
+	case strings.HasPrefix(text, query):
+		return 3
+	case strings.Contains(text, query):
+		return 2
+	}
+
+	words := strings.Fields(text)
+	for _, q := range strings.Fields(query) {
+		if !slices.ContainsFunc(words, func(w string) bool { return strings.HasPrefix(w, q) }) {
+			return 0
+		}
+	}
+	return 1
+}
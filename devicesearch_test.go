@@ -0,0 +1,92 @@
+package smartthings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newDeviceSearchServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items":[
+			{"deviceId":"d1","label":"Living Room Lamp","name":"c2c-switch"},
+			{"deviceId":"d2","label":"Lamp","name":"zigbee-bulb"},
+			{"deviceId":"d3","label":"Kitchen Lamp Strip","name":"strip"},
+			{"deviceId":"d4","label":"","name":"Garage Door"},
+			{"deviceId":"d5","label":"Lampshade Motor","name":"motor"}
+		],"_links":{}}`))
+	}))
+}
+
+func TestClient_FindDeviceByLabel(t *testing.T) {
+	server := newDeviceSearchServer(t)
+	defer server.Close()
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+
+	tests := []struct {
+		name    string
+		label   string
+		wantID  string
+		wantErr error
+	}{
+		{"exact", "Living Room Lamp", "d1", nil},
+		{"case insensitive", "living room LAMP", "d1", nil},
+		{"falls back to name", "garage door", "d4", nil},
+		{"substring is not exact", "Living Room", "", ErrDeviceNotFound},
+		{"empty label", "", "", ErrEmptyLabel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device, err := client.FindDeviceByLabel(context.Background(), tt.label)
+			if err != tt.wantErr {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && device.DeviceID != tt.wantID {
+				t.Errorf("DeviceID = %q, want %q", device.DeviceID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestClient_SearchDevices(t *testing.T) {
+	server := newDeviceSearchServer(t)
+	defer server.Close()
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+
+	tests := []struct {
+		name    string
+		query   string
+		wantIDs []string
+	}{
+		{"ranked matches", "lamp", []string{"d2", "d5", "d1", "d3"}},
+		{"word order independent", "lamp liv", []string{"d1"}},
+		{"matches name", "bulb", []string{"d2"}},
+		{"no matches", "thermostat", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices, err := client.SearchDevices(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(devices) != len(tt.wantIDs) {
+				t.Fatalf("got %d devices, want %v", len(devices), tt.wantIDs)
+			}
+			for i, id := range tt.wantIDs {
+				if devices[i].DeviceID != id {
+					t.Errorf("devices[%d] = %s, want %s", i, devices[i].DeviceID, id)
+				}
+			}
+		})
+	}
+
+	t.Run("empty query", func(t *testing.T) {
+		if _, err := client.SearchDevices(context.Background(), "  "); err != ErrEmptyQuery {
+			t.Errorf("expected ErrEmptyQuery, got %v", err)
+		}
+	})
+}
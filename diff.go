@@ -0,0 +1,114 @@
+package smartthings
+
+import (
+	"reflect"
+	"sort"
+)
+
+// StatusChange describes a single attribute that differs between two Status snapshots.
+type StatusChange struct {
+	Component  string // Component ID ("main" for single-component status)
+	Capability string // Capability name, e.g. "switch"
+	Attribute  string // Attribute name, e.g. "switch"
+	OldValue   any    // Previous value (nil if the attribute was added)
+	NewValue   any    // Current value (nil if the attribute was removed)
+}
+
+// DiffStatus compares two Status snapshots and returns the attributes whose
+// values changed, sorted by component, capability, and attribute.
+//
+// Both single-component status (from GetDeviceStatus) and multi-component
+// status (from GetDeviceStatusAllComponents) are supported. Only attribute
+// values are compared; timestamp-only updates are not reported. Attributes
+// that appear or disappear are reported with a nil OldValue or NewValue.
+//
+// Example:
+//
+//	for _, change := range smartthings.DiffStatus(previous, current) {
+//	    fmt.Printf("%s.%s: %v -> %v\n", change.Capability, change.Attribute, change.OldValue, change.NewValue)
+//	}
+func DiffStatus(old, new Status) []StatusChange {
+	oldValues := flattenStatus(old)
+	newValues := flattenStatus(new)
+
+	var changes []StatusChange
+	for key, oldVal := range oldValues {
+		newVal, ok := newValues[key]
+		if ok && reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+		changes = append(changes, key.change(oldVal, newVal))
+	}
+	for key, newVal := range newValues {
+		if _, ok := oldValues[key]; !ok {
+			changes = append(changes, key.change(nil, newVal))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Component != b.Component {
+			return a.Component < b.Component
+		}
+		if a.Capability != b.Capability {
+			return a.Capability < b.Capability
+		}
+		return a.Attribute < b.Attribute
+	})
+
+	return changes
+}
+
+// attributeKey identifies an attribute within a status snapshot.
+type attributeKey struct {
+	component  string
+	capability string
+	attribute  string
+}
+
+// change builds a StatusChange for the attribute.
+func (k attributeKey) change(oldVal, newVal any) StatusChange {
+	return StatusChange{
+		Component:  k.component,
+		Capability: k.capability,
+		Attribute:  k.attribute,
+		OldValue:   oldVal,
+		NewValue:   newVal,
+	}
+}
+
+// flattenStatus walks a status map and returns the value of every attribute.
+// An attribute is a map containing a "value" key. Attributes found two levels
+// deep (capability/attribute) are assigned to the "main" component.
+func flattenStatus(status Status) map[attributeKey]any {
+	values := make(map[attributeKey]any)
+	var walk func(data map[string]any, path []string)
+	walk = func(data map[string]any, path []string) {
+		for key, v := range data {
+			var m map[string]any
+			switch v := v.(type) {
+			case Status:
+				m = v
+			case map[string]any:
+				m = v
+			default:
+				continue
+			}
+			p := append(path[:len(path):len(path)], key)
+			if value, isAttr := m["value"]; isAttr {
+				switch len(p) {
+				case 2:
+					values[attributeKey{"main", p[0], p[1]}] = value
+				case 3:
+					values[attributeKey{p[0], p[1], p[2]}] = value
+				}
+				continue
+			}
+			if len(p) < 3 {
+				walk(m, p)
+			}
+		}
+	}
+	walk(status, nil)
+	return values
+}
@@ -0,0 +1,95 @@
+package smartthings
+
+import (
+	"testing"
+)
+
+func TestDiffStatus(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		status := Status{
+			"switch": map[string]any{"switch": map[string]any{"value": "on", "timestamp": "2024-01-01T00:00:00Z"}},
+		}
+		newer := Status{
+			"switch": map[string]any{"switch": map[string]any{"value": "on", "timestamp": "2024-01-02T00:00:00Z"}},
+		}
+		if changes := DiffStatus(status, newer); len(changes) != 0 {
+			t.Errorf("expected no changes, got %+v", changes)
+		}
+	})
+
+	t.Run("changed, added, and removed attributes", func(t *testing.T) {
+		old := Status{
+			"switch":      map[string]any{"switch": map[string]any{"value": "off"}},
+			"switchLevel": map[string]any{"level": map[string]any{"value": float64(50)}},
+		}
+		newer := Status{
+			"switch":       map[string]any{"switch": map[string]any{"value": "on"}},
+			"colorControl": map[string]any{"hue": map[string]any{"value": float64(10)}},
+		}
+
+		changes := DiffStatus(old, newer)
+		if len(changes) != 3 {
+			t.Fatalf("got %d changes, want 3: %+v", len(changes), changes)
+		}
+
+		want := []StatusChange{
+			{Component: "main", Capability: "colorControl", Attribute: "hue", OldValue: nil, NewValue: float64(10)},
+			{Component: "main", Capability: "switch", Attribute: "switch", OldValue: "off", NewValue: "on"},
+			{Component: "main", Capability: "switchLevel", Attribute: "level", OldValue: float64(50), NewValue: nil},
+		}
+		for i, w := range want {
+			if changes[i] != w {
+				t.Errorf("changes[%d] = %+v, want %+v", i, changes[i], w)
+			}
+		}
+	})
+
+	t.Run("multi-component status", func(t *testing.T) {
+		// Components are typed Status, as GetDeviceStatusAllComponents returns them.
+		old := Status{
+			"main":    Status{"contactSensor": map[string]any{"contact": map[string]any{"value": "closed"}}},
+			"freezer": Status{"temperatureMeasurement": map[string]any{"temperature": map[string]any{"value": float64(-18)}}},
+		}
+		newer := Status{
+			"main":    Status{"contactSensor": map[string]any{"contact": map[string]any{"value": "closed"}}},
+			"freezer": Status{"temperatureMeasurement": map[string]any{"temperature": map[string]any{"value": float64(-15)}}},
+		}
+
+		changes := DiffStatus(old, newer)
+		if len(changes) != 1 {
+			t.Fatalf("got %d changes, want 1: %+v", len(changes), changes)
+		}
+		c := changes[0]
+		if c.Component != "freezer" || c.Capability != "temperatureMeasurement" || c.Attribute != "temperature" {
+			t.Errorf("change = %+v, want freezer/temperatureMeasurement/temperature", c)
+		}
+		if c.OldValue != float64(-18) || c.NewValue != float64(-15) {
+			t.Errorf("values = %v -> %v, want -18 -> -15", c.OldValue, c.NewValue)
+		}
+	})
+
+	t.Run("nested values compared deeply", func(t *testing.T) {
+		old := Status{
+			"mediaInputSource": map[string]any{"supportedInputSources": map[string]any{"value": []any{"HDMI1", "HDMI2"}}},
+		}
+		same := Status{
+			"mediaInputSource": map[string]any{"supportedInputSources": map[string]any{"value": []any{"HDMI1", "HDMI2"}}},
+		}
+		if changes := DiffStatus(old, same); len(changes) != 0 {
+			t.Errorf("expected no changes, got %+v", changes)
+		}
+	})
+
+	t.Run("nil snapshots", func(t *testing.T) {
+		newer := Status{
+			"switch": map[string]any{"switch": map[string]any{"value": "on"}},
+		}
+		changes := DiffStatus(nil, newer)
+		if len(changes) != 1 || changes[0].OldValue != nil || changes[0].NewValue != "on" {
+			t.Errorf("DiffStatus(nil, new) = %+v", changes)
+		}
+		if changes := DiffStatus(nil, nil); len(changes) != 0 {
+			t.Errorf("DiffStatus(nil, nil) = %+v, want empty", changes)
+		}
+	})
+}
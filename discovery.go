@@ -108,6 +108,30 @@ func (d *Discovery) FindHubs(ctx context.Context) ([]DiscoveredHub, error) {
 	})
 }
 
+// DiscoverHubs discovers SmartThings Hubs on the local network using SSDP
+// with the default timeout. It is shorthand for NewDiscovery(0).FindHubs(ctx).
+//
+// Example:
+//
+//	hubs, err := smartthings.DiscoverHubs(ctx)
+//	if err != nil || len(hubs) == 0 {
+//	    return err
+//	}
+//	local, err := smartthings.NewHubLocalClient(hubs[0].LocalConfig(token))
+func DiscoverHubs(ctx context.Context) ([]DiscoveredHub, error) {
+	return NewDiscovery(0).FindHubs(ctx)
+}
+
+// LocalConfig returns a HubLocalConfig for connecting to the hub with the
+// given local API token and default settings for everything else.
+func (h DiscoveredHub) LocalConfig(token string) *HubLocalConfig {
+	return &HubLocalConfig{
+		HubIP:   h.IP,
+		HubPort: h.Port,
+		Token:   token,
+	}
+}
+
 // FindTVs discovers Samsung TVs on the local network using SSDP.
 // Samsung TVs respond to SSDP with urn:samsung.com:device:RemoteControlReceiver:1.
 func (d *Discovery) FindTVs(ctx context.Context) ([]DiscoveredTV, error) {
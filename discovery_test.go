@@ -512,3 +512,33 @@ func TestDiscovery_Integration(t *testing.T) {
 	// 	t.Logf("TV: %s:%d (%s)", tv.IP, tv.Port, tv.Name)
 	// }
 }
+
+func TestDiscoverHubs(t *testing.T) {
+	hubResponse := "HTTP/1.1 200 OK\r\n" +
+		"LOCATION: http://192.168.1.100:39500/description.xml\r\n" +
+		"SERVER: SmartThings Hub/1.0\r\n" +
+		"ST: urn:SmartThingsCommunity:device:Hub:1\r\n" +
+		"\r\n"
+	serverAddr, cleanup := mockSSDPServer(t, []string{hubResponse})
+	defer cleanup()
+
+	originalAddr := ssdpMulticastAddr
+	ssdpMulticastAddr = serverAddr
+	defer func() { ssdpMulticastAddr = originalAddr }()
+
+	hubs, err := DiscoverHubs(context.Background())
+	if err != nil {
+		t.Fatalf("DiscoverHubs failed: %v", err)
+	}
+	if len(hubs) != 1 || hubs[0].IP != "192.168.1.100" || hubs[0].Port != 39500 {
+		t.Fatalf("hubs = %+v", hubs)
+	}
+
+	cfg := hubs[0].LocalConfig("local-token")
+	if cfg.HubIP != "192.168.1.100" || cfg.HubPort != 39500 || cfg.Token != "local-token" {
+		t.Errorf("LocalConfig = %+v", cfg)
+	}
+	if _, err := NewHubLocalClient(cfg); err != nil {
+		t.Errorf("NewHubLocalClient(LocalConfig) failed: %v", err)
+	}
+}
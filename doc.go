@@ -26,6 +26,11 @@
 //	store := smartthings.NewFileTokenStore("/path/to/tokens.json")
 //	client, err := smartthings.NewOAuthClient(config, store)
 //
+// SmartThings does not support the OAuth device authorization grant. On
+// headless devices, use a personal access token, or print the authorization
+// URL and have the user paste back the code from the redirect (see
+// GetAuthorizationURLWithPKCE and ExchangeCodeWithPKCE).
+//
 // # Basic Usage
 //
 // List all devices:
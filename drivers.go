@@ -176,6 +176,9 @@ func (c *Client) UploadDriver(ctx context.Context, archiveData []byte) (*EdgeDri
 		return nil, ErrEmptyDriverArchive
 	}
 
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
 	// Use custom request for multipart upload
 	url := c.baseURL + "/drivers/package"
 
@@ -187,6 +190,9 @@ func (c *Client) UploadDriver(ctx context.Context, archiveData []byte) (*EdgeDri
 	req.Header.Set("Authorization", "Bearer "+c.token)
 	req.Header.Set("Content-Type", "application/zip")
 	req.Header.Set("Accept", "application/json")
+	if id := c.requestID(ctx); id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
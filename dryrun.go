@@ -0,0 +1,43 @@
+package smartthings
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// WithDryRun makes the client skip the network for mutating requests
+// (POST, PUT, PATCH, DELETE) such as ExecuteCommand, CreateRule, and
+// DeleteDevice. Each skipped request succeeds with an empty JSON object as
+// its response body and is logged at info level as "dry_run" if a logger is
+// configured. GET requests are still sent, so reads return real data.
+//
+// Example:
+//
+//	client, _ := st.NewClient(token, st.WithDryRun(), st.WithLogger(logger))
+//	err := client.ExecuteCommand(ctx, deviceID, st.NewCommand("switch", "on")) // not sent
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// DryRun reports whether the client was created with WithDryRun.
+func (c *Client) DryRun() bool {
+	return c.dryRun
+}
+
+// skipDryRun reports whether a request must be skipped in dry-run mode,
+// logging it if so.
+func (c *Client) skipDryRun(ctx context.Context, method, path string) bool {
+	if !c.dryRun || method == http.MethodGet {
+		return false
+	}
+	if c.logger != nil {
+		c.logger.LogAttrs(ctx, slog.LevelInfo, "dry_run",
+			slog.String("method", method),
+			slog.String("path", path),
+		)
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package smartthings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithDryRun(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		json.NewEncoder(w).Encode(Device{DeviceID: "device-123", Label: "Lamp"})
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	client, _ := NewClient("token", WithBaseURL(server.URL), WithDryRun(), WithLogger(logger))
+	ctx := context.Background()
+
+	if !client.DryRun() {
+		t.Fatal("expected DryRun() to be true")
+	}
+
+	t.Run("mutations skip the network", func(t *testing.T) {
+		methods = nil
+		if err := client.ExecuteCommand(ctx, "device-123", NewCommand("switch", "on")); err != nil {
+			t.Errorf("ExecuteCommand: %v", err)
+		}
+		if err := client.DeleteDevice(ctx, "device-123"); err != nil {
+			t.Errorf("DeleteDevice: %v", err)
+		}
+		rule, err := client.CreateRule(ctx, "loc-1", &RuleCreate{Name: "Nightly", Actions: []RuleAction{{}}})
+		if err != nil || rule == nil {
+			t.Errorf("CreateRule = %v, %v", rule, err)
+		}
+		if len(methods) != 0 {
+			t.Errorf("expected no requests, got %v", methods)
+		}
+		if got := strings.Count(logs.String(), "msg=dry_run"); got != 3 {
+			t.Errorf("expected 3 dry_run log lines, got %d:\n%s", got, logs.String())
+		}
+	})
+
+	t.Run("reads still go out", func(t *testing.T) {
+		methods = nil
+		device, err := client.GetDevice(ctx, "device-123")
+		if err != nil {
+			t.Fatalf("GetDevice: %v", err)
+		}
+		if device.Label != "Lamp" || len(methods) != 1 || methods[0] != http.MethodGet {
+			t.Errorf("device = %+v, methods = %v", device, methods)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if client.DryRun() {
+			t.Error("expected DryRun() to be false")
+		}
+	})
+}
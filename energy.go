@@ -0,0 +1,156 @@
+package smartthings
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+)
+
+// EnergyUsageSummary aggregates a device's energy and power readings over a
+// history window. Use GetEnergyUsage to compute it from device states.
+type EnergyUsageSummary struct {
+	TotalKWh     float64   `json:"totalKWh"`     // Energy consumed in the window
+	PeakWatts    float64   `json:"peakWatts"`    // Highest power reading
+	AverageWatts float64   `json:"averageWatts"` // Mean power reading, or TotalKWh spread over the window when no power readings exist
+	Readings     int       `json:"readings"`     // Number of energy and power states used
+	Start        time.Time `json:"start"`        // Timestamp of the earliest reading
+	End          time.Time `json:"end"`          // Timestamp of the latest reading
+}
+
+// energyReading is a single timestamped energy or power value.
+type energyReading struct {
+	at    time.Time
+	value float64
+}
+
+// GetEnergyUsage fetches a device's powerConsumptionReport, energyMeter, and
+// powerMeter states within the opts window (all pages) and summarizes them.
+//
+// Energy counters are cumulative: the total is the sum of increases between
+// consecutive readings. A reading lower than the previous one is treated as a
+// counter reset, so the new reading itself counts as energy used since the
+// reset. powerConsumptionReport is preferred over energyMeter when a device
+// reports both, to avoid counting the same energy twice.
+//
+// Example:
+//
+//	after := time.Now().Add(-24 * time.Hour)
+//	usage, err := client.GetEnergyUsage(ctx, deviceID, &smartthings.HistoryOptions{After: &after})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("%.2f kWh, peak %.0f W\n", usage.TotalKWh, usage.PeakWatts)
+func (c *Client) GetEnergyUsage(ctx context.Context, deviceID string, opts *HistoryOptions) (*EnergyUsageSummary, error) {
+	if deviceID == "" {
+		return nil, ErrEmptyDeviceID
+	}
+
+	reqOpts := &HistoryOptions{Max: 200}
+	if opts != nil {
+		reqOpts.Before = opts.Before
+		reqOpts.After = opts.After
+		reqOpts.Page = opts.Page
+		if opts.Max > 0 {
+			reqOpts.Max = opts.Max
+		}
+	}
+
+	var reportEnergy, meterEnergy, power []energyReading
+	for {
+		resp, err := c.GetDeviceStates(ctx, deviceID, reqOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, state := range resp.Items {
+			switch state.Capability {
+			case "powerConsumptionReport":
+				report, ok := state.Value.(map[string]any)
+				if !ok {
+					continue
+				}
+				// Samsung reports cumulative energy in Wh.
+				if wh, ok := GetFloat(report, "energy"); ok {
+					reportEnergy = append(reportEnergy, energyReading{state.Timestamp, wh / 1000})
+				}
+				if w, ok := GetFloat(report, "power"); ok {
+					power = append(power, energyReading{state.Timestamp, w})
+				}
+			case "energyMeter":
+				if v, ok := toFloat(state.Value); ok && state.Attribute == "energy" {
+					if strings.EqualFold(state.Unit, "Wh") {
+						v /= 1000
+					}
+					meterEnergy = append(meterEnergy, energyReading{state.Timestamp, v})
+				}
+			case "powerMeter":
+				if v, ok := toFloat(state.Value); ok && state.Attribute == "power" {
+					power = append(power, energyReading{state.Timestamp, v})
+				}
+			}
+		}
+
+		if resp.Links.Next == "" || len(resp.Items) == 0 {
+			break
+		}
+		reqOpts.Page++
+	}
+
+	energy := reportEnergy
+	if len(energy) == 0 {
+		energy = meterEnergy
+	}
+	return summarizeEnergy(energy, power), nil
+}
+
+// summarizeEnergy computes an EnergyUsageSummary from cumulative energy
+// readings (kWh) and power readings (W), which may be in any order.
+func summarizeEnergy(energy, power []energyReading) *EnergyUsageSummary {
+	byTime := func(a, b energyReading) int { return a.at.Compare(b.at) }
+	slices.SortStableFunc(energy, byTime)
+	slices.SortStableFunc(power, byTime)
+
+	summary := &EnergyUsageSummary{Readings: len(energy) + len(power)}
+	for i := 1; i < len(energy); i++ {
+		if delta := energy[i].value - energy[i-1].value; delta >= 0 {
+			summary.TotalKWh += delta
+		} else {
+			// Counter reset or rollover: count from zero.
+			summary.TotalKWh += energy[i].value
+		}
+	}
+
+	var sum float64
+	for _, p := range power {
+		sum += p.value
+		summary.PeakWatts = max(summary.PeakWatts, p.value)
+	}
+
+	for _, series := range [][]energyReading{energy, power} {
+		if len(series) == 0 {
+			continue
+		}
+		if summary.Start.IsZero() || series[0].at.Before(summary.Start) {
+			summary.Start = series[0].at
+		}
+		if last := series[len(series)-1].at; last.After(summary.End) {
+			summary.End = last
+		}
+	}
+
+	if len(power) > 0 {
+		summary.AverageWatts = sum / float64(len(power))
+	} else if hours := energySpan(energy).Hours(); hours > 0 {
+		summary.AverageWatts = summary.TotalKWh * 1000 / hours
+	}
+	return summary
+}
+
+// energySpan returns the time between the first and last sorted readings.
+func energySpan(readings []energyReading) time.Duration {
+	if len(readings) < 2 {
+		return 0
+	}
+	return readings[len(readings)-1].at.Sub(readings[0].at)
+}
@@ -0,0 +1,163 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_GetEnergyUsage(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+	report := func(h int, wh, w float64) DeviceState {
+		return DeviceState{
+			ComponentID: "main",
+			Capability:  "powerConsumptionReport",
+			Attribute:   "powerConsumption",
+			Value:       map[string]any{"energy": wh, "power": w},
+			Timestamp:   at(h),
+		}
+	}
+	approx := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	t.Run("powerConsumptionReport across pages", func(t *testing.T) {
+		var pages []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pages = append(pages, r.URL.Query().Get("page"))
+			var resp PagedStates
+			if r.URL.Query().Get("page") == "" {
+				// Newest first, as the API returns them.
+				resp.Items = []DeviceState{report(2, 3000, 500), report(1, 2000, 1500)}
+				resp.Links.Next = "next"
+			} else {
+				resp.Items = []DeviceState{report(0, 1000, 100)}
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		usage, err := client.GetEnergyUsage(context.Background(), "device-123", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pages) != 2 || pages[1] != "1" {
+			t.Errorf("unexpected pages requested: %v", pages)
+		}
+		if !approx(usage.TotalKWh, 2) {
+			t.Errorf("TotalKWh = %v, want 2", usage.TotalKWh)
+		}
+		if usage.PeakWatts != 1500 {
+			t.Errorf("PeakWatts = %v, want 1500", usage.PeakWatts)
+		}
+		if !approx(usage.AverageWatts, 700) {
+			t.Errorf("AverageWatts = %v, want 700", usage.AverageWatts)
+		}
+		if !usage.Start.Equal(at(0)) || !usage.End.Equal(at(2)) {
+			t.Errorf("window = %v - %v, want %v - %v", usage.Start, usage.End, at(0), at(2))
+		}
+		if usage.Readings != 6 {
+			t.Errorf("Readings = %d, want 6", usage.Readings)
+		}
+	})
+
+	t.Run("energyMeter with counter reset", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			meter := func(h int, v float64) DeviceState {
+				return DeviceState{Capability: "energyMeter", Attribute: "energy", Value: v, Unit: "kWh", Timestamp: at(h)}
+			}
+			json.NewEncoder(w).Encode(PagedStates{Items: []DeviceState{
+				meter(0, 10), meter(1, 12), meter(2, 0.5), meter(3, 1.5),
+				{Capability: "switch", Attribute: "switch", Value: "on", Timestamp: at(1)},
+			}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		usage, err := client.GetEnergyUsage(context.Background(), "device-123", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 10 -> 12 (+2), reset to 0.5 (+0.5), 0.5 -> 1.5 (+1)
+		if !approx(usage.TotalKWh, 3.5) {
+			t.Errorf("TotalKWh = %v, want 3.5", usage.TotalKWh)
+		}
+		if usage.PeakWatts != 0 {
+			t.Errorf("PeakWatts = %v, want 0", usage.PeakWatts)
+		}
+		// No power readings: 3.5 kWh over 3 hours.
+		if !approx(usage.AverageWatts, 3500.0/3) {
+			t.Errorf("AverageWatts = %v, want %v", usage.AverageWatts, 3500.0/3)
+		}
+	})
+
+	t.Run("energyMeter in Wh with powerMeter", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(PagedStates{Items: []DeviceState{
+				{Capability: "energyMeter", Attribute: "energy", Value: 1000.0, Unit: "Wh", Timestamp: at(0)},
+				{Capability: "energyMeter", Attribute: "energy", Value: 1500.0, Unit: "Wh", Timestamp: at(1)},
+				{Capability: "powerMeter", Attribute: "power", Value: 250.0, Unit: "W", Timestamp: at(0)},
+				{Capability: "powerMeter", Attribute: "power", Value: 750.0, Unit: "W", Timestamp: at(1)},
+			}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		usage, err := client.GetEnergyUsage(context.Background(), "device-123", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !approx(usage.TotalKWh, 0.5) {
+			t.Errorf("TotalKWh = %v, want 0.5", usage.TotalKWh)
+		}
+		if usage.PeakWatts != 750 || usage.AverageWatts != 500 {
+			t.Errorf("PeakWatts = %v, AverageWatts = %v, want 750 and 500", usage.PeakWatts, usage.AverageWatts)
+		}
+	})
+
+	t.Run("passes window options", func(t *testing.T) {
+		after := at(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("after"); got != after.Format(time.RFC3339) {
+				t.Errorf("after = %q, want %q", got, after.Format(time.RFC3339))
+			}
+			if got := r.URL.Query().Get("max"); got != "200" {
+				t.Errorf("max = %q, want 200", got)
+			}
+			json.NewEncoder(w).Encode(PagedStates{})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		usage, err := client.GetEnergyUsage(context.Background(), "device-123", &HistoryOptions{After: &after})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if usage.TotalKWh != 0 || usage.Readings != 0 {
+			t.Errorf("expected empty summary, got %+v", usage)
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if _, err := client.GetEnergyUsage(context.Background(), "", nil); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.GetEnergyUsage(context.Background(), "device-123", nil); !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
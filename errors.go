@@ -12,16 +12,32 @@ var (
 	ErrUnauthorized = errors.New("smartthings: unauthorized (invalid or expired token)")
 	ErrEmptyToken   = errors.New("smartthings: API token cannot be empty")
 
+	// Configuration errors
+	ErrInvalidBaseURL = errors.New("smartthings: base URL must be an absolute http or https URL")
+
 	// Resource errors
-	ErrNotFound      = errors.New("smartthings: resource not found")
-	ErrDeviceOffline = errors.New("smartthings: device is offline")
+	ErrNotFound          = errors.New("smartthings: resource not found")
+	ErrDeviceOffline     = errors.New("smartthings: device is offline")
+	ErrWaitTimeout       = errors.New("smartthings: timed out waiting for device state")
+	ErrComponentNotFound = errors.New("smartthings: component not found")
+	ErrDeviceNotFound    = errors.New("smartthings: no device matches label")
+	ErrAppNotFound       = errors.New("smartthings: no TV app matches name")
+	ErrModeNotFound      = errors.New("smartthings: no mode matches name")
 
 	// Rate limiting
-	ErrRateLimited = errors.New("smartthings: rate limited (too many requests)")
+	ErrRateLimited           = errors.New("smartthings: rate limited (too many requests)")
+	ErrRateLimitWaitExceeded = errors.New("smartthings: rate limit reset is beyond the maximum wait")
+
+	// Circuit breaker
+	ErrCircuitOpen = errors.New("smartthings: circuit breaker is open")
 
 	// Device validation errors
 	ErrEmptyDeviceID    = errors.New("smartthings: device ID cannot be empty")
 	ErrEmptyComponentID = errors.New("smartthings: component ID cannot be empty")
+	ErrEmptyLabel       = errors.New("smartthings: label cannot be empty")
+	ErrEmptyQuery       = errors.New("smartthings: search query cannot be empty")
+	ErrInvalidCommand   = errors.New("smartthings: command does not match capability definition")
+	ErrLocationChange   = errors.New("smartthings: the API cannot move a device to another location")
 
 	// TV/media validation errors
 	ErrEmptyInputID   = errors.New("smartthings: input ID cannot be empty")
@@ -30,6 +46,12 @@ var (
 	ErrEmptyMode      = errors.New("smartthings: mode cannot be empty")
 	ErrInvalidChannel = errors.New("smartthings: channel must be non-negative")
 
+	// Thermostat validation errors
+	ErrInvalidThermostatMode = errors.New("smartthings: thermostat mode must be heat, emergency heat, or cool")
+
+	// Lock validation errors
+	ErrInvalidLockTimeout = errors.New("smartthings: unlock timeout must be positive")
+
 	// Location validation errors
 	ErrEmptyLocationID   = errors.New("smartthings: location ID cannot be empty")
 	ErrEmptyLocationName = errors.New("smartthings: location name cannot be empty")
@@ -44,17 +66,24 @@ var (
 	// Rule validation errors
 	ErrEmptyRuleID   = errors.New("smartthings: rule ID cannot be empty")
 	ErrEmptyRuleName = errors.New("smartthings: rule name cannot be empty")
+	ErrInvalidRule   = errors.New("smartthings: invalid rule")
 
 	// Schedule validation errors
 	ErrEmptyScheduleName = errors.New("smartthings: schedule name cannot be empty")
 
 	// InstalledApp/Subscription validation errors
-	ErrEmptyInstalledAppID = errors.New("smartthings: installed app ID cannot be empty")
-	ErrEmptySubscriptionID = errors.New("smartthings: subscription ID cannot be empty")
-	ErrInvalidSubscription = errors.New("smartthings: invalid subscription configuration")
+	ErrEmptyInstalledAppID     = errors.New("smartthings: installed app ID cannot be empty")
+	ErrEmptyConfigID           = errors.New("smartthings: configuration ID cannot be empty")
+	ErrEmptyInstalledAppConfig = errors.New("smartthings: installed app configuration cannot be nil")
+	ErrEmptySubscriptionID     = errors.New("smartthings: subscription ID cannot be empty")
+	ErrInvalidSubscription     = errors.New("smartthings: invalid subscription configuration")
+
+	// History validation errors
+	ErrInvalidCursor = errors.New("smartthings: invalid history cursor")
 
 	// Capability validation errors
-	ErrEmptyCapabilityID = errors.New("smartthings: capability ID cannot be empty")
+	ErrEmptyCapabilityID        = errors.New("smartthings: capability ID cannot be empty")
+	ErrInvalidCapabilityVersion = errors.New("smartthings: capability version must be positive")
 
 	// Mode validation errors
 	ErrEmptyModeID = errors.New("smartthings: mode ID cannot be empty")
@@ -77,6 +106,9 @@ var (
 	// Hub validation errors
 	ErrEmptyHubID = errors.New("smartthings: hub ID cannot be empty")
 
+	// Hub local API errors
+	ErrReconnectAttemptsExhausted = errors.New("smartthings: hub local reconnect attempts exhausted")
+
 	// Device preference validation errors
 	ErrEmptyPreferenceID   = errors.New("smartthings: preference ID cannot be empty")
 	ErrEmptyPreferenceName = errors.New("smartthings: preference name cannot be empty")
@@ -93,6 +125,7 @@ var (
 	ErrEmptyNotificationRequest  = errors.New("smartthings: notification request cannot be nil")
 	ErrEmptyNotificationType     = errors.New("smartthings: notification type cannot be empty")
 	ErrEmptyNotificationMessages = errors.New("smartthings: notification messages cannot be empty")
+	ErrInvalidNotification       = errors.New("smartthings: invalid notification")
 
 	// Organization validation errors
 	ErrEmptyOrganizationID = errors.New("smartthings: organization ID cannot be empty")
@@ -104,6 +137,8 @@ var (
 	// Schema validation errors
 	ErrEmptySchemaAppName        = errors.New("smartthings: schema app name cannot be empty")
 	ErrEmptyInstalledSchemaAppID = errors.New("smartthings: installed schema app ID cannot be empty")
+	ErrInvalidSchemaCallback     = errors.New("smartthings: schema callback URL and access token are required")
+	ErrEmptyExternalDeviceID     = errors.New("smartthings: external device ID cannot be empty")
 
 	// Service subscription validation errors
 	ErrEmptyServiceSubscriptionRequest = errors.New("smartthings: service subscription request cannot be nil")
@@ -156,7 +191,12 @@ func IsNotFound(err error) bool {
 }
 
 // IsRateLimited returns true if the error indicates rate limiting.
+// Use errors.As with *RateLimitError to get the Retry-After details.
 func IsRateLimited(err error) bool {
+	var rle *RateLimitError
+	if errors.As(err, &rle) {
+		return true
+	}
 	if errors.Is(err, ErrRateLimited) {
 		return true
 	}
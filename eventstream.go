@@ -0,0 +1,175 @@
+package smartthings
+
+import (
+	"context"
+	"iter"
+	"sort"
+	"time"
+)
+
+// DefaultStreamPollInterval is the default interval between polls in DeviceEventStream.
+const DefaultStreamPollInterval = 5 * time.Second
+
+// streamDedupWindow is how far behind the newest seen event the stream still
+// tracks event keys. History queries are issued with second precision, so
+// events within this window may be returned again by the API.
+const streamDedupWindow = time.Second
+
+// WithStreamPollInterval sets how often DeviceEventStream polls for new events.
+// Defaults to DefaultStreamPollInterval if not set or non-positive.
+func WithStreamPollInterval(interval time.Duration) Option {
+	return func(c *Client) {
+		c.streamPollInterval = interval
+	}
+}
+
+// streamCursor tracks the events already emitted for a single device.
+type streamCursor struct {
+	since  time.Time            // Events before this time are never emitted
+	latest time.Time            // Timestamp of the newest emitted event
+	seen   map[string]time.Time // Emitted event keys and their timestamps
+}
+
+// eventKey identifies an event for de-duplication purposes.
+func eventKey(e DeviceEvent) string {
+	return e.Timestamp.UTC().Format(time.RFC3339Nano) + "|" + e.ComponentID + "|" + e.Capability + "|" + e.Attribute
+}
+
+// floor returns the earliest timestamp the cursor will still emit.
+func (sc *streamCursor) floor() time.Time {
+	if sc.latest.IsZero() {
+		return sc.since
+	}
+	if f := sc.latest.Add(-streamDedupWindow); f.After(sc.since) {
+		return f
+	}
+	return sc.since
+}
+
+// accept filters events down to those not yet emitted, in chronological order,
+// and records them as seen. If advance is false, the floor is left where it
+// is, so older events on pages that could not be fetched are still emitted
+// by a later poll.
+func (sc *streamCursor) accept(events []DeviceEvent, advance bool) []DeviceEvent {
+	floor := sc.floor()
+	fresh := make([]DeviceEvent, 0, len(events))
+	for _, e := range events {
+		if e.Timestamp.Before(floor) {
+			continue
+		}
+		key := eventKey(e)
+		if _, ok := sc.seen[key]; ok {
+			continue
+		}
+		sc.seen[key] = e.Timestamp
+		fresh = append(fresh, e)
+		if advance && e.Timestamp.After(sc.latest) {
+			sc.latest = e.Timestamp
+		}
+	}
+
+	sort.SliceStable(fresh, func(i, j int) bool {
+		return fresh[i].Timestamp.Before(fresh[j].Timestamp)
+	})
+
+	// Forget keys that have fallen below the floor; they are filtered by time instead.
+	floor = sc.floor()
+	for key, ts := range sc.seen {
+		if ts.Before(floor) {
+			delete(sc.seen, key)
+		}
+	}
+
+	return fresh
+}
+
+// DeviceEventStream returns an iterator that streams new events for the given
+// devices by polling GetDeviceEvents. It is the cloud equivalent of the
+// real-time events provided by HubLocalClient.
+//
+// Only events that occur after iteration starts are emitted. Each event is
+// emitted at most once, even if a poll is repeated after a transient error.
+// Errors are yielded without ending the stream; stop iterating to end it.
+// The stream ends without an error when the context is canceled.
+//
+// Example:
+//
+//	stream, err := client.DeviceEventStream(ctx, []string{"device-1", "device-2"})
+//	if err != nil {
+//	    return err
+//	}
+//	for event, err := range stream {
+//	    if err != nil {
+//	        log.Printf("poll failed: %v", err)
+//	        continue
+//	    }
+//	    fmt.Printf("%s %s.%s = %v\n", event.DeviceID, event.Capability, event.Attribute, event.Value)
+//	}
+func (c *Client) DeviceEventStream(ctx context.Context, deviceIDs []string) (iter.Seq2[DeviceEvent, error], error) {
+	if len(deviceIDs) == 0 {
+		return nil, ErrEmptyDeviceID
+	}
+	for _, id := range deviceIDs {
+		if id == "" {
+			return nil, ErrEmptyDeviceID
+		}
+	}
+
+	ids := append([]string(nil), deviceIDs...)
+	interval := c.streamPollInterval
+	if interval <= 0 {
+		interval = DefaultStreamPollInterval
+	}
+
+	return func(yield func(DeviceEvent, error) bool) {
+		start := time.Now()
+		cursors := make(map[string]*streamCursor, len(ids))
+		for _, id := range ids {
+			cursors[id] = &streamCursor{since: start, seen: make(map[string]time.Time)}
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for _, id := range ids {
+				cursor := cursors[id]
+				after := cursor.floor()
+				opts := &HistoryOptions{After: &after}
+
+				var batch []DeviceEvent
+				var pollErr error
+				for event, err := range c.DeviceEvents(ctx, id, opts) {
+					if err != nil {
+						pollErr = err
+						break
+					}
+					batch = append(batch, event)
+				}
+
+				if ctx.Err() != nil {
+					return
+				}
+
+				// Emit what was fetched before reporting a partial failure.
+				// Pages arrive newest first, so the events on the pages that
+				// failed are older than these: keep the floor until a poll
+				// completes, and let seen drop the repeats.
+				for _, event := range cursor.accept(batch, pollErr == nil) {
+					if !yield(event, nil) {
+						return
+					}
+				}
+				if pollErr != nil && !yield(DeviceEvent{}, pollErr) {
+					return
+				}
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,188 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_DeviceEventStream(t *testing.T) {
+	t.Run("empty device IDs", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if _, err := client.DeviceEventStream(context.Background(), nil); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+		if _, err := client.DeviceEventStream(context.Background(), []string{"device-1", ""}); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("de-duplicates across polls and errors", func(t *testing.T) {
+		base := time.Now().Add(time.Hour).Truncate(time.Second)
+		first := DeviceEvent{DeviceID: "device-1", ComponentID: "main", Capability: "switch", Attribute: "switch", Value: "on", Timestamp: base}
+		second := DeviceEvent{DeviceID: "device-1", ComponentID: "main", Capability: "switch", Attribute: "switch", Value: "off", Timestamp: base.Add(time.Minute)}
+
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/devices/device-1/events" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/devices/device-1/events")
+			}
+			if r.URL.Query().Get("after") == "" {
+				t.Error("expected after query param")
+			}
+			switch calls.Add(1) {
+			case 1:
+				json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{first}})
+			case 2:
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":{"message":"temporary failure"}}`))
+			default:
+				// The API returns newest first and repeats already-seen events.
+				json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{second, first}})
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithStreamPollInterval(5*time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		stream, err := client.DeviceEventStream(ctx, []string{"device-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var got []DeviceEvent
+		var errs int
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for event, err := range stream {
+				if err != nil {
+					errs++
+					continue
+				}
+				got = append(got, event)
+			}
+		}()
+
+		// Let several polls return the same events before stopping.
+		for calls.Load() < 6 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+		<-done
+
+		if errs != 1 {
+			t.Errorf("got %d errors, want 1", errs)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got %d events, want 2", len(got))
+		}
+		if got[0].Value != "on" || got[1].Value != "off" {
+			t.Errorf("events = %v, %v; want on, off", got[0].Value, got[1].Value)
+		}
+	})
+
+	t.Run("emits older pages after a partial failure", func(t *testing.T) {
+		base := time.Now().Add(time.Hour).Truncate(time.Second)
+		older := DeviceEvent{DeviceID: "device-1", ComponentID: "main", Capability: "switch", Attribute: "switch", Value: "on", Timestamp: base}
+		newer := DeviceEvent{DeviceID: "device-1", ComponentID: "main", Capability: "switch", Attribute: "switch", Value: "off", Timestamp: base.Add(time.Minute)}
+
+		var page2Calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("page") == "" {
+				json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{newer}, Links: Links{Next: "next"}})
+				return
+			}
+			if page2Calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":{"message":"temporary failure"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{older}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithStreamPollInterval(5*time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		stream, _ := client.DeviceEventStream(ctx, []string{"device-1"})
+		var got []DeviceEvent
+		var errs int
+		for event, err := range stream {
+			if err != nil {
+				errs++
+				continue
+			}
+			got = append(got, event)
+			if len(got) == 2 {
+				break
+			}
+		}
+
+		if errs != 1 {
+			t.Errorf("got %d errors, want 1", errs)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got %d events, want 2", len(got))
+		}
+		if got[0].Value != "off" || got[1].Value != "on" {
+			t.Errorf("events = %v, %v; want off, then on from the failed page", got[0].Value, got[1].Value)
+		}
+	})
+
+	t.Run("skips events before stream start", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{
+				{DeviceID: "device-1", Capability: "switch", Attribute: "switch", Value: "on", Timestamp: time.Now().Add(-time.Hour)},
+			}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithStreamPollInterval(5*time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		stream, _ := client.DeviceEventStream(ctx, []string{"device-1"})
+		for event, err := range stream {
+			t.Errorf("unexpected event %v (err %v)", event, err)
+		}
+	})
+
+	t.Run("stops cleanly on cancellation", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(PagedEvents{Items: []DeviceEvent{}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithStreamPollInterval(5*time.Millisecond))
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, _ := client.DeviceEventStream(ctx, []string{"device-1"})
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for _, err := range stream {
+				if err != nil && !errors.Is(err, context.Canceled) {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		}()
+
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("stream did not stop after cancellation")
+		}
+	})
+}
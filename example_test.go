@@ -232,10 +232,10 @@ func ExampleWithCache() {
 	ctx := context.Background()
 
 	// First call fetches from API
-	cap1, _ := client.GetCapability(ctx, "switch", 1)
+	cap1, _ := client.GetCapability(ctx, "custom.picturemode", 1)
 
 	// Second call returns cached result
-	cap2, _ := client.GetCapability(ctx, "switch", 1)
+	cap2, _ := client.GetCapability(ctx, "custom.picturemode", 1)
 
 	// Both return the same data
 	fmt.Printf("Cached: %v\n", cap1.ID == cap2.ID)
@@ -31,6 +31,7 @@ import (
 var (
 	webhookSecret string
 	apiClient     *st.Client
+	dispatcher    = newDispatcher()
 )
 
 func main() {
@@ -167,36 +168,34 @@ func handleConfirmation(w http.ResponseWriter, event *st.WebhookEvent) {
 }
 
 func handleEvent(w http.ResponseWriter, event *st.WebhookEvent) {
-	if event.EventData == nil {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
+	dispatcher.Dispatch(event)
+	w.WriteHeader(http.StatusOK)
+}
+
+// newDispatcher registers the device event handlers used by handleEvent.
+func newDispatcher() *st.WebhookDispatcher {
+	d := st.NewWebhookDispatcher()
 
-	for _, deviceEvent := range event.EventData.Events {
-		log.Printf("Device event: type=%s device=%s capability=%s attribute=%s value=%v",
-			deviceEvent.EventType,
-			deviceEvent.DeviceEvent.DeviceID,
-			deviceEvent.DeviceEvent.Capability,
-			deviceEvent.DeviceEvent.Attribute,
-			deviceEvent.DeviceEvent.Value,
-		)
-
-		// Example: React to motion sensor events
-		if deviceEvent.DeviceEvent.Capability == "motionSensor" &&
-			deviceEvent.DeviceEvent.Attribute == "motion" &&
-			deviceEvent.DeviceEvent.Value == "active" {
-			handleMotionDetected(deviceEvent.DeviceEvent.DeviceID)
+	d.OnDeviceEvent("*", "*", func(e st.DeviceEventDetail) {
+		log.Printf("Device event: device=%s capability=%s attribute=%s value=%v",
+			e.DeviceID, e.Capability, e.Attribute, e.Value)
+	})
+
+	// Example: React to motion sensor events
+	d.OnDeviceEvent("motionSensor", "motion", func(e st.DeviceEventDetail) {
+		if e.Value == "active" {
+			handleMotionDetected(e.DeviceID)
 		}
+	})
 
-		// Example: React to door/window sensor events
-		if deviceEvent.DeviceEvent.Capability == "contactSensor" &&
-			deviceEvent.DeviceEvent.Attribute == "contact" &&
-			deviceEvent.DeviceEvent.Value == "open" {
-			handleDoorOpened(deviceEvent.DeviceEvent.DeviceID)
+	// Example: React to door/window sensor events
+	d.OnDeviceEvent("contactSensor", "contact", func(e st.DeviceEventDetail) {
+		if e.Value == "open" {
+			handleDoorOpened(e.DeviceID)
 		}
-	}
+	})
 
-	w.WriteHeader(http.StatusOK)
+	return d
 }
 
 func handlePing(w http.ResponseWriter, event *st.WebhookEvent) {
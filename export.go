@@ -0,0 +1,121 @@
+package smartthings
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Account Export
+//
+// These helpers write resources as newline-delimited JSON (NDJSON), one
+// object per line in the same form the API returns, as they are fetched.
+// Devices are fetched a page at a time, so memory use does not grow with the
+// size of the account. Output written before an error is complete, valid lines.
+// ImportRules reads the same format back.
+
+// ExportDevices writes every device in the account to w as NDJSON.
+//
+// Example:
+//
+//	f, _ := os.Create("devices.ndjson")
+//	defer f.Close()
+//	if err := client.ExportDevices(ctx, f); err != nil {
+//	    return err
+//	}
+func (c *Client) ExportDevices(ctx context.Context, w io.Writer) error {
+	return exportNDJSON(w, c.Devices(ctx))
+}
+
+// ExportLocations writes every location in the account to w as NDJSON.
+func (c *Client) ExportLocations(ctx context.Context, w io.Writer) error {
+	return exportNDJSON(w, c.Locations(ctx))
+}
+
+// ExportRules writes the rules of every location in the account to w as
+// NDJSON, location by location.
+func (c *Client) ExportRules(ctx context.Context, w io.Writer) error {
+	for location, err := range c.Locations(ctx) {
+		if err != nil {
+			return err
+		}
+		if err := exportNDJSON(w, c.Rules(ctx, location.LocationID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportRules creates a rule in a location for each line of r, which holds
+// NDJSON RuleCreate objects. Lines written by ExportRules can be imported
+// as is; fields other than name and actions are ignored. Blank lines are
+// skipped.
+//
+// A line that fails to parse or to create is skipped and the import goes
+// on. The returned error joins one error per failed line, each prefixed
+// with its line number, along with any error reading r. The rules created
+// are returned even when err is non-nil.
+//
+// Example:
+//
+//	f, _ := os.Open("rules.ndjson")
+//	defer f.Close()
+//	created, err := client.ImportRules(ctx, locationID, f)
+//	fmt.Printf("imported %d rules\n", len(created))
+//	if err != nil {
+//	    log.Printf("some rules were not imported: %v", err)
+//	}
+func (c *Client) ImportRules(ctx context.Context, locationID string, r io.Reader) ([]Rule, error) {
+	if locationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+
+	var created []Rule
+	var errs []error
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, readErr := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if err := ctx.Err(); err != nil {
+				errs = append(errs, err)
+				break
+			}
+			var rule RuleCreate
+			if err := json.Unmarshal(line, &rule); err != nil {
+				errs = append(errs, fmt.Errorf("line %d: failed to parse rule: %w (line: %s)", lineNum, err, truncatePreview(line)))
+			} else if result, err := c.CreateRule(ctx, locationID, &rule); err != nil {
+				errs = append(errs, fmt.Errorf("line %d: rule %q: %w", lineNum, rule.Name, err))
+			} else {
+				created = append(created, *result)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			errs = append(errs, fmt.Errorf("failed to read rules: %w", readErr))
+			break
+		}
+	}
+	return created, errors.Join(errs...)
+}
+
+// exportNDJSON encodes each item of seq to w on its own line, stopping at the
+// first iteration or write error.
+func exportNDJSON[T any](w io.Writer, seq iter.Seq2[T, error]) error {
+	enc := json.NewEncoder(w)
+	for item, err := range seq {
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
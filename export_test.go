@@ -0,0 +1,197 @@
+package smartthings
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func exportServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/devices":
+			w.Write([]byte(`{"items": [{"deviceId": "dev-1", "label": "Lamp"}, {"deviceId": "dev-2", "label": "Lock"}]}`))
+		case "/locations":
+			w.Write([]byte(`{"items": [{"locationId": "loc-1", "name": "Home"}, {"locationId": "loc-2", "name": "Cabin"}]}`))
+		case "/rules":
+			loc := r.URL.Query().Get("locationId")
+			w.Write([]byte(`{"items": [{"id": "rule-` + loc + `", "name": "Rule", "actions": []}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// ndjsonField decodes each line of out and returns the given field.
+func ndjsonField(t *testing.T, out string, field string) []string {
+	t.Helper()
+	var values []string
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		var obj map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &obj); err != nil {
+			t.Fatalf("invalid NDJSON line %q: %v", scanner.Text(), err)
+		}
+		s, _ := obj[field].(string)
+		values = append(values, s)
+	}
+	return values
+}
+
+func TestClient_Export(t *testing.T) {
+	server := exportServer(t)
+	defer server.Close()
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+
+	tests := []struct {
+		name   string
+		export func(context.Context, *bytes.Buffer) error
+		field  string
+		want   []string
+	}{
+		{"devices", func(ctx context.Context, w *bytes.Buffer) error { return client.ExportDevices(ctx, w) }, "deviceId", []string{"dev-1", "dev-2"}},
+		{"locations", func(ctx context.Context, w *bytes.Buffer) error { return client.ExportLocations(ctx, w) }, "locationId", []string{"loc-1", "loc-2"}},
+		{"rules", func(ctx context.Context, w *bytes.Buffer) error { return client.ExportRules(ctx, w) }, "id", []string{"rule-loc-1", "rule-loc-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.export(context.Background(), &buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasSuffix(buf.String(), "\n") {
+				t.Error("output should end with a newline")
+			}
+			if got := ndjsonField(t, buf.String(), tt.field); !slices.Equal(got, tt.want) {
+				t.Errorf("%s = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (w failingWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestClient_Export_Errors(t *testing.T) {
+	t.Run("API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		var buf bytes.Buffer
+		if err := client.ExportDevices(context.Background(), &buf); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+		if err := client.ExportRules(context.Background(), &buf); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("expected no output, got %q", buf.String())
+		}
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		server := exportServer(t)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		writeErr := errors.New("disk full")
+		if err := client.ExportLocations(context.Background(), failingWriter{writeErr}); !errors.Is(err, writeErr) {
+			t.Errorf("expected write error, got %v", err)
+		}
+	})
+}
+
+func TestClient_ImportRules(t *testing.T) {
+	var names []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rules" || r.URL.Query().Get("locationId") != "loc-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["id"]; ok {
+			t.Error("exported id should not be sent")
+		}
+		name, _ := body["name"].(string)
+		if name == "Rejected" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"message": "invalid rule"}}`))
+			return
+		}
+		names = append(names, name)
+		json.NewEncoder(w).Encode(map[string]any{"id": "new-" + name, "name": name})
+	}))
+	defer server.Close()
+
+	input := strings.Join([]string{
+		`{"id": "rule-1", "name": "Night", "actions": []}`,
+		``,
+		`{"name": "Morning", "actions": [`,
+		`{"name": "Rejected", "actions": []}`,
+		`{"name": "Evening", "actions": []}`,
+	}, "\n")
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	created, err := client.ImportRules(context.Background(), "loc-1", strings.NewReader(input))
+	if len(created) != 2 || created[0].ID != "new-Night" || created[1].ID != "new-Evening" {
+		t.Errorf("created = %+v", created)
+	}
+	if !slices.Equal(names, []string{"Night", "Evening"}) {
+		t.Errorf("created names = %v", names)
+	}
+	if err == nil {
+		t.Fatal("expected errors for the failed lines")
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "line 3: failed to parse rule") || !strings.Contains(msg, `line 4: rule "Rejected"`) {
+		t.Errorf("error = %v", err)
+	}
+	if strings.Contains(msg, "line 5") {
+		t.Errorf("unexpected error for line 5: %v", err)
+	}
+}
+
+func TestClient_ImportRules_Errors(t *testing.T) {
+	client, _ := NewClient("token")
+
+	t.Run("empty location ID", func(t *testing.T) {
+		if _, err := client.ImportRules(context.Background(), "", strings.NewReader("")); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		created, err := client.ImportRules(context.Background(), "loc-1", strings.NewReader("\n\n"))
+		if err != nil || len(created) != 0 {
+			t.Errorf("ImportRules = %v, %v", created, err)
+		}
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := client.ImportRules(context.Background(), "loc-1", strings.NewReader(`{"actions": []}`))
+		if !errors.Is(err, ErrEmptyRuleName) {
+			t.Errorf("expected ErrEmptyRuleName, got %v", err)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := client.ImportRules(ctx, "loc-1", strings.NewReader(`{"name": "Night", "actions": []}`))
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
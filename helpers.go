@@ -140,18 +140,35 @@ func GetFloat(data map[string]any, keys ...string) (float64, bool) {
 }
 
 // GetBool navigates a nested map and returns a bool value.
+// Besides JSON booleans, it accepts the string conventions SmartThings uses
+// for two-state attributes (case-insensitive):
+//
+//	true:  "true", "on", "open", "yes"
+//	false: "false", "off", "closed", "no"
+//
+// Any other value, including other strings and numbers, returns ok=false.
 //
 // Example:
 //
-//	// Extract: status["switch"]["switch"]["value"] == true
+//	// Extract: status["switch"]["switch"]["value"] == "on"
 //	isOn, ok := GetBool(status, "switch", "switch", "value")
 func GetBool(data map[string]any, keys ...string) (bool, bool) {
 	val, ok := navigate(data, keys)
 	if !ok {
 		return false, false
 	}
-	b, ok := val.(bool)
-	return b, ok
+	switch v := val.(type) {
+	case bool:
+		return v, true
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "on", "open", "yes":
+			return true, true
+		case "false", "off", "closed", "no":
+			return false, true
+		}
+	}
+	return false, false
 }
 
 // GetMap navigates a nested map and returns a map[string]any value.
@@ -225,7 +242,8 @@ func navigate(data map[string]any, keys []string) (any, bool) {
 	return nil, false
 }
 
-// CelsiusToFahrenheit converts Celsius to Fahrenheit.
+// CelsiusToFahrenheit converts Celsius to Fahrenheit, truncating to a whole
+// degree; use CelsiusToFahrenheitFloat to keep decimals.
 // Returns 0 if the input is NaN, Inf, or would overflow int range.
 // For typical home appliance temperatures (-50°C to 500°C), this function
 // is safe and accurate.
@@ -245,6 +263,47 @@ func FahrenheitToCelsius(fahrenheit int) float64 {
 	return float64(fahrenheit-32) * 5 / 9
 }
 
+// CelsiusToFahrenheitFloat converts Celsius to Fahrenheit without rounding.
+// Unlike CelsiusToFahrenheit, which truncates to a whole degree, it keeps
+// decimals so callers can round as they need. NaN and Inf are returned as-is.
+func CelsiusToFahrenheitFloat(celsius float64) float64 {
+	return celsius*9/5 + 32
+}
+
+// FahrenheitToCelsiusFloat converts a fractional Fahrenheit temperature to
+// Celsius. NaN and Inf are returned as-is.
+func FahrenheitToCelsiusFloat(fahrenheit float64) float64 {
+	return (fahrenheit - 32) * 5 / 9
+}
+
+// NormalizeTemperature converts a temperature from fromUnit to toUnit, each
+// "C" or "F" as reported in a status attribute's unit (case-insensitive, with
+// or without a leading "°"). The value is returned unchanged if the units
+// match or either is empty or unknown, so a reading already in the requested
+// scale is never converted twice.
+//
+// Example:
+//
+//	value, _ := GetFloat(status, "temperatureMeasurement", "temperature", "value")
+//	unit, _ := GetString(status, "temperatureMeasurement", "temperature", "unit")
+//	fahrenheit := NormalizeTemperature(value, unit, "F")
+func NormalizeTemperature(value float64, fromUnit, toUnit string) float64 {
+	from, to := temperatureUnit(fromUnit), temperatureUnit(toUnit)
+	switch {
+	case from == "C" && to == "F":
+		return CelsiusToFahrenheitFloat(value)
+	case from == "F" && to == "C":
+		return FahrenheitToCelsiusFloat(value)
+	}
+	return value
+}
+
+// temperatureUnit normalizes a temperature unit to "C" or "F", or returns it
+// upper-cased if it is neither.
+func temperatureUnit(unit string) string {
+	return strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(unit), "°"))
+}
+
 // ToStringSlice converts a []any to []string, filtering out non-string values.
 // Useful for extracting supported options lists from Samsung API responses.
 //
@@ -299,9 +299,44 @@ func TestGetBool(t *testing.T) {
 			wantOk: false,
 		},
 		{
-			name:   "wrong type - string",
+			name:   "string true",
 			data:   map[string]any{"enabled": "true"},
 			keys:   []string{"enabled"},
+			want:   true,
+			wantOk: true,
+		},
+		{
+			name:   "switch on",
+			data:   map[string]any{"switch": map[string]any{"value": "on"}},
+			keys:   []string{"switch", "value"},
+			want:   true,
+			wantOk: true,
+		},
+		{
+			name:   "switch off",
+			data:   map[string]any{"switch": map[string]any{"value": "off"}},
+			keys:   []string{"switch", "value"},
+			want:   false,
+			wantOk: true,
+		},
+		{
+			name:   "contact open mixed case",
+			data:   map[string]any{"contact": "Open"},
+			keys:   []string{"contact"},
+			want:   true,
+			wantOk: true,
+		},
+		{
+			name:   "contact closed",
+			data:   map[string]any{"contact": "closed"},
+			keys:   []string{"contact"},
+			want:   false,
+			wantOk: true,
+		},
+		{
+			name:   "unrecognized string",
+			data:   map[string]any{"state": "paused"},
+			keys:   []string{"state"},
 			want:   false,
 			wantOk: false,
 		},
@@ -569,6 +604,57 @@ func TestFahrenheitToCelsius(t *testing.T) {
 	}
 }
 
+func TestCelsiusToFahrenheitFloat(t *testing.T) {
+	tests := []struct {
+		celsius    float64
+		fahrenheit float64
+	}{
+		{0, 32},
+		{100, 212},
+		{-40, -40},
+		{3.5, 38.3},
+		{-18.5, -1.3},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := CelsiusToFahrenheitFloat(tt.celsius)
+			if math.Abs(got-tt.fahrenheit) > 1e-9 {
+				t.Errorf("CelsiusToFahrenheitFloat(%v) = %v, want %v", tt.celsius, got, tt.fahrenheit)
+			}
+			if back := FahrenheitToCelsiusFloat(got); math.Abs(back-tt.celsius) > 1e-9 {
+				t.Errorf("FahrenheitToCelsiusFloat(%v) = %v, want %v", got, back, tt.celsius)
+			}
+		})
+	}
+
+	if got := CelsiusToFahrenheitFloat(math.NaN()); !math.IsNaN(got) {
+		t.Errorf("CelsiusToFahrenheitFloat(NaN) = %v, want NaN", got)
+	}
+}
+
+func TestNormalizeTemperature(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{100, "C", "F", 212},
+		{212, "F", "C", 100},
+		{-40, "c", "f", -40},
+		{20, "°C", "F", 68},
+		{72, "F", "F", 72},   // Already in the requested scale
+		{72, "", "C", 72},    // Unknown source unit
+		{21, "C", "K", 21},   // Unknown target unit
+		{21, " C ", "C", 21}, // Surrounding space
+	}
+	for _, tt := range tests {
+		if got := NormalizeTemperature(tt.value, tt.from, tt.to); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("NormalizeTemperature(%v, %q, %q) = %v, want %v", tt.value, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
 func TestNavigate(t *testing.T) {
 	tests := []struct {
 		name   string
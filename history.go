@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -37,6 +40,38 @@ type HistoryOptions struct {
 	After  *time.Time // Events after this time (exclusive)
 	Max    int        // Max results per page (1-200, default 20)
 	Page   int        // Page number (0-based)
+	Cursor string     // Resume point from GetDeviceEventsPage; replaces the other fields (events only)
+}
+
+// EventFilter selects device events by capability and attribute.
+// Empty Capabilities or Attributes match any value.
+//
+// For online/offline transitions, filter on the healthCheck capability:
+//
+//	smartthings.EventFilter{
+//	    Capabilities:    []string{"healthCheck"},
+//	    Attributes:      []string{"DeviceWatch-DeviceStatus"},
+//	    StateChangeOnly: true,
+//	}
+type EventFilter struct {
+	Capabilities    []string        // Capability IDs to include
+	Attributes      []string        // Attribute names to include
+	StateChangeOnly bool            // Only include events that changed the attribute's value
+	History         *HistoryOptions // Time window and page size; nil fetches all history
+}
+
+// Matches reports whether an event passes the filter.
+func (f EventFilter) Matches(event DeviceEvent) bool {
+	if f.StateChangeOnly && !event.StateChange {
+		return false
+	}
+	if len(f.Capabilities) > 0 && !slices.Contains(f.Capabilities, event.Capability) {
+		return false
+	}
+	if len(f.Attributes) > 0 && !slices.Contains(f.Attributes, event.Attribute) {
+		return false
+	}
+	return true
 }
 
 // PagedEvents is the paginated response for device events.
@@ -79,11 +114,51 @@ func buildHistoryQueryParams(opts *HistoryOptions) string {
 	return ""
 }
 
+// parseHistoryCursor decodes a cursor returned by GetDeviceEventsPage.
+func parseHistoryCursor(cursor string) (*HistoryOptions, error) {
+	params, err := url.ParseQuery(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	opts := &HistoryOptions{}
+	if v := params.Get("before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+		opts.Before = &t
+	}
+	if v := params.Get("after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+		opts.After = &t
+	}
+	for key, dst := range map[string]*int{"max": &opts.Max, "page": &opts.Page} {
+		if v := params.Get(key); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: bad %s %q", ErrInvalidCursor, key, v)
+			}
+			*dst = n
+		}
+	}
+	return opts, nil
+}
+
 // GetDeviceEvents returns the event history for a device.
 func (c *Client) GetDeviceEvents(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedEvents, error) {
 	if deviceID == "" {
 		return nil, ErrEmptyDeviceID
 	}
+	if opts != nil && opts.Cursor != "" {
+		var err error
+		if opts, err = parseHistoryCursor(opts.Cursor); err != nil {
+			return nil, err
+		}
+	}
 
 	path := "/devices/" + deviceID + "/events" + buildHistoryQueryParams(opts)
 	data, err := c.get(ctx, path)
@@ -99,6 +174,50 @@ func (c *Client) GetDeviceEvents(ctx context.Context, deviceID string, opts *His
 	return &resp, nil
 }
 
+// GetDeviceEventsPage returns one page of a device's event history and a
+// cursor for the next page, or "" on the last page. The cursor is an opaque
+// string that can be persisted and passed back as HistoryOptions.Cursor to
+// resume, for example in a later run of the program.
+//
+// Example:
+//
+//	opts := &smartthings.HistoryOptions{Cursor: savedCursor}
+//	page, cursor, err := client.GetDeviceEventsPage(ctx, deviceID, opts)
+//	if err != nil {
+//	    return err
+//	}
+//	process(page.Items)
+//	saveCursor(cursor)
+func (c *Client) GetDeviceEventsPage(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedEvents, string, error) {
+	if deviceID == "" {
+		return nil, "", ErrEmptyDeviceID
+	}
+
+	current := &HistoryOptions{}
+	if opts != nil {
+		if opts.Cursor != "" {
+			var err error
+			if current, err = parseHistoryCursor(opts.Cursor); err != nil {
+				return nil, "", err
+			}
+		} else {
+			*current = *opts
+		}
+	}
+
+	resp, err := c.GetDeviceEvents(ctx, deviceID, current)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resp.Links.Next == "" || len(resp.Items) == 0 {
+		return resp, "", nil
+	}
+	next := *current
+	next.Page++
+	return resp, strings.TrimPrefix(buildHistoryQueryParams(&next), "?"), nil
+}
+
 // GetDeviceStates returns historical state snapshots for a device.
 func (c *Client) GetDeviceStates(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedStates, error) {
 	if deviceID == "" {
@@ -118,3 +237,64 @@ func (c *Client) GetDeviceStates(ctx context.Context, deviceID string, opts *His
 
 	return &resp, nil
 }
+
+// DecodeStateValue returns a state's value as type T. JSON numbers, which
+// decode as float64, are also converted to other numeric types; conversion
+// to an integer type requires a whole number. Returns false if the value
+// cannot be represented as T.
+//
+// Example:
+//
+//	for _, s := range states.Items {
+//	    if v, ok := smartthings.DecodeStateValue[string](s); ok {
+//	        fmt.Println(s.Timestamp, v) // e.g. switch "on"/"off"
+//	    }
+//	}
+func DecodeStateValue[T any](state DeviceState) (T, bool) {
+	if v, ok := state.Value.(T); ok {
+		return v, true
+	}
+
+	var result T
+	f, ok := toFloat(state.Value)
+	if !ok {
+		return result, false
+	}
+	if f != math.Trunc(f) {
+		// Only floating-point targets can hold a fractional value.
+		switch any(result).(type) {
+		case float64, float32:
+		default:
+			return result, false
+		}
+	}
+
+	switch p := any(&result).(type) {
+	case *float64:
+		*p = f
+	case *float32:
+		*p = float32(f)
+	case *int:
+		*p = int(f)
+	case *int32:
+		*p = int32(f)
+	case *int64:
+		*p = int64(f)
+	default:
+		return result, false
+	}
+	return result, true
+}
+
+// ExtractNumericSeries returns the numeric values of states in order, for
+// charting attributes such as temperature or power. States whose value is not
+// a number are skipped.
+func ExtractNumericSeries(states []DeviceState) []float64 {
+	series := make([]float64, 0, len(states))
+	for _, state := range states {
+		if v, ok := toFloat(state.Value); ok {
+			series = append(series, v)
+		}
+	}
+	return series
+}
@@ -3,8 +3,10 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -169,6 +171,76 @@ func TestClient_GetDeviceEvents(t *testing.T) {
 	})
 }
 
+func TestClient_GetDeviceEventsPage(t *testing.T) {
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		resp := PagedEvents{Items: []DeviceEvent{{DeviceID: "device-123", Capability: "switch"}}}
+		if r.URL.Query().Get("page") != "2" {
+			resp.Links.Next = "next"
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	t.Run("resumes from cursor", func(t *testing.T) {
+		queries = nil
+		page, cursor, err := client.GetDeviceEventsPage(ctx, "device-123", &HistoryOptions{After: &after, Max: 50})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(page.Items) != 1 || cursor == "" {
+			t.Fatalf("page = %+v, cursor = %q", page, cursor)
+		}
+
+		// Resume as a later run would, from the persisted cursor alone.
+		var pages int
+		for cursor != "" {
+			_, cursor, err = client.GetDeviceEventsPage(ctx, "device-123", &HistoryOptions{Cursor: cursor})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			pages++
+		}
+		if pages != 2 || len(queries) != 3 {
+			t.Fatalf("pages = %d, queries = %v", pages, queries)
+		}
+		want := "after=" + url.QueryEscape(after.Format(time.RFC3339)) + "&max=50&page=2"
+		if queries[2] != want {
+			t.Errorf("last query = %q, want %q", queries[2], want)
+		}
+	})
+
+	t.Run("cursor overrides other options", func(t *testing.T) {
+		queries = nil
+		_, _, err := client.GetDeviceEventsPage(ctx, "device-123", &HistoryOptions{Cursor: "max=10&page=1", Max: 99})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if queries[0] != "max=10&page=1" {
+			t.Errorf("query = %q, want max=10&page=1", queries[0])
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		for _, cursor := range []string{"page=abc", "before=yesterday", "max=%zz"} {
+			if _, _, err := client.GetDeviceEventsPage(ctx, "device-123", &HistoryOptions{Cursor: cursor}); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("cursor %q: expected ErrInvalidCursor, got %v", cursor, err)
+			}
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		if _, _, err := client.GetDeviceEventsPage(ctx, "", nil); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
 func TestClient_GetDeviceStates(t *testing.T) {
 	t.Run("successful response", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -297,3 +369,78 @@ func TestBuildHistoryQueryParams(t *testing.T) {
 		}
 	})
 }
+
+func TestDecodeStateValue(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v, ok := DecodeStateValue[string](DeviceState{Value: "on"})
+		if !ok || v != "on" {
+			t.Errorf("got (%q, %v), want (\"on\", true)", v, ok)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		v, ok := DecodeStateValue[float64](DeviceState{Value: 21.5})
+		if !ok || v != 21.5 {
+			t.Errorf("got (%v, %v), want (21.5, true)", v, ok)
+		}
+	})
+
+	t.Run("whole number to int", func(t *testing.T) {
+		v, ok := DecodeStateValue[int](DeviceState{Value: 72.0})
+		if !ok || v != 72 {
+			t.Errorf("got (%v, %v), want (72, true)", v, ok)
+		}
+	})
+
+	t.Run("fraction to int fails", func(t *testing.T) {
+		if v, ok := DecodeStateValue[int64](DeviceState{Value: 72.5}); ok {
+			t.Errorf("expected failure, got %v", v)
+		}
+	})
+
+	t.Run("fraction to float32", func(t *testing.T) {
+		v, ok := DecodeStateValue[float32](DeviceState{Value: 72.5})
+		if !ok || v != 72.5 {
+			t.Errorf("got (%v, %v), want (72.5, true)", v, ok)
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		if _, ok := DecodeStateValue[string](DeviceState{Value: 1.0}); ok {
+			t.Error("expected number to not decode as string")
+		}
+		if _, ok := DecodeStateValue[float64](DeviceState{Value: "on"}); ok {
+			t.Error("expected string to not decode as float64")
+		}
+		if _, ok := DecodeStateValue[bool](DeviceState{Value: 1.0}); ok {
+			t.Error("expected number to not decode as bool")
+		}
+	})
+
+	t.Run("decoded from JSON", func(t *testing.T) {
+		var state DeviceState
+		json.Unmarshal([]byte(`{"value":{"hue":30}}`), &state)
+		v, ok := DecodeStateValue[map[string]any](state)
+		if !ok || v["hue"] != 30.0 {
+			t.Errorf("got (%v, %v)", v, ok)
+		}
+	})
+}
+
+func TestExtractNumericSeries(t *testing.T) {
+	states := []DeviceState{{Value: 20.5}, {Value: "unavailable"}, {Value: 21.0}, {Value: nil}, {Value: 22}}
+	got := ExtractNumericSeries(states)
+	want := []float64{20.5, 21, 22}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("series[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := ExtractNumericSeries(nil); len(got) != 0 {
+		t.Errorf("expected empty series, got %v", got)
+	}
+}
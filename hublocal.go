@@ -95,22 +95,24 @@ type HubLocalClient struct {
 	pongMu   sync.RWMutex
 
 	// Reconnect configuration
-	reconnectEnabled  bool
-	reconnectDelay    time.Duration
-	reconnectMaxDelay time.Duration
-	onReconnect       func()
-	onDisconnect      func(error)
+	reconnectEnabled     bool
+	reconnectDelay       time.Duration
+	reconnectMaxDelay    time.Duration
+	reconnectMaxAttempts int
+	onReconnect          func()
+	onDisconnect         func(error)
 
 	// Subscription tracking for resubscription after reconnect
-	subscriptions   []string // Device IDs subscribed to
-	subscribeAll    bool     // Whether SubscribeAll was called
+	subscriptions   []string            // Device IDs subscribed to
+	subscribeAll    bool                // Whether SubscribeAll was called
+	capabilities    map[string]struct{} // Capability filter set by SubscribeCapabilities
 	subscriptionsMu sync.RWMutex
 
 	// Reconnect state
-	reconnecting   bool
-	reconnectMu    sync.Mutex
-	manualClose    bool // True if Close() was called explicitly
-	eventBufferSz  int
+	reconnecting  bool
+	reconnectMu   sync.Mutex
+	manualClose   bool // True if Close() was called explicitly
+	eventBufferSz int
 }
 
 // HubLocalConfig configures the HubLocalClient.
@@ -136,6 +138,12 @@ type HubLocalConfig struct {
 	// ReconnectMaxDelay is the maximum delay between reconnection attempts (default: 5m).
 	ReconnectMaxDelay time.Duration
 
+	// ReconnectMaxAttempts is the number of consecutive failed reconnection
+	// attempts after which the client gives up (default: 0, unlimited). When
+	// exhausted, an error wrapping ErrReconnectAttemptsExhausted is sent on
+	// Errors, OnDisconnect is called with it, and both channels are closed.
+	ReconnectMaxAttempts int
+
 	// OnReconnect is called when a reconnection occurs.
 	OnReconnect func()
 
@@ -144,7 +152,9 @@ type HubLocalConfig struct {
 }
 
 // NewHubLocalClient creates a new client for connecting to a SmartThings Hub's local API.
-// The token should be obtained from the hub's local API authentication.
+// The token should be obtained from the hub's local API authentication; the
+// hub does not expose a public endpoint for requesting one, so it must be
+// provisioned out of band. Use DiscoverHubs to find the hub's IP and port.
 func NewHubLocalClient(cfg *HubLocalConfig) (*HubLocalClient, error) {
 	if cfg == nil {
 		return nil, errors.New("HubLocalClient: config is required")
@@ -183,18 +193,19 @@ func NewHubLocalClient(cfg *HubLocalConfig) (*HubLocalClient, error) {
 	}
 
 	return &HubLocalClient{
-		hubIP:             cfg.HubIP,
-		hubPort:           port,
-		token:             cfg.Token,
-		events:            make(chan HubLocalEvent, bufSize),
-		errors:            make(chan error, 10),
-		done:              make(chan struct{}),
-		eventBufferSz:     bufSize,
-		reconnectEnabled:  reconnectEnabled,
-		reconnectDelay:    reconnectDelay,
-		reconnectMaxDelay: reconnectMaxDelay,
-		onReconnect:       cfg.OnReconnect,
-		onDisconnect:      cfg.OnDisconnect,
+		hubIP:                cfg.HubIP,
+		hubPort:              port,
+		token:                cfg.Token,
+		events:               make(chan HubLocalEvent, bufSize),
+		errors:               make(chan error, 10),
+		done:                 make(chan struct{}),
+		eventBufferSz:        bufSize,
+		reconnectEnabled:     reconnectEnabled,
+		reconnectDelay:       reconnectDelay,
+		reconnectMaxDelay:    reconnectMaxDelay,
+		reconnectMaxAttempts: cfg.ReconnectMaxAttempts,
+		onReconnect:          cfg.OnReconnect,
+		onDisconnect:         cfg.OnDisconnect,
 	}, nil
 }
 
@@ -225,7 +236,7 @@ func (c *HubLocalClient) Connect(ctx context.Context) error {
 	go c.readLoop()
 
 	// Start ping loop in background
-	go c.pingLoop()
+	go c.pingLoop(c.done)
 
 	return nil
 }
@@ -467,7 +478,7 @@ func (c *HubLocalClient) handleTextMessage(payload []byte) {
 		return
 	}
 
-	if msg.DeviceEvent != nil {
+	if msg.DeviceEvent != nil && c.wantsCapability(msg.DeviceEvent.Capability) {
 		select {
 		case c.events <- *msg.DeviceEvent:
 		default:
@@ -532,14 +543,15 @@ func (c *HubLocalClient) sendPong(payload []byte) {
 	}
 }
 
-// pingLoop sends periodic ping frames to keep the connection alive.
-func (c *HubLocalClient) pingLoop() {
+// pingLoop sends periodic ping frames to keep the connection alive until
+// done, the connection's done channel, is closed.
+func (c *HubLocalClient) pingLoop(done <-chan struct{}) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-c.done:
+		case <-done:
 			return
 		case <-ticker.C:
 			// Check if we've received a pong recently
@@ -610,6 +622,53 @@ func (c *HubLocalClient) Close() error {
 	return err
 }
 
+// CloseAndDrain closes the connection like Close and returns the events that
+// were still buffered in the Events channel, so they are not lost on
+// shutdown. It waits for the read loop to close the channel, discarding any
+// pending errors so the loop cannot block; ctx bounds that wait, and the
+// events collected so far are returned with ctx's error if it expires.
+// Events received concurrently by another reader of Events are not returned.
+func (c *HubLocalClient) CloseAndDrain(ctx context.Context) ([]HubLocalEvent, error) {
+	wasConnected := c.IsConnected()
+	err := c.Close()
+
+	var events []HubLocalEvent
+	if !wasConnected {
+		// No read loop will close the channel; take what is buffered.
+		for {
+			select {
+			case ev, ok := <-c.events:
+				if !ok {
+					return events, err
+				}
+				events = append(events, ev)
+			default:
+				return events, err
+			}
+		}
+	}
+
+	errs := c.errors
+	for {
+		select {
+		case ev, ok := <-c.events:
+			if !ok {
+				return events, err
+			}
+			events = append(events, ev)
+		case _, ok := <-errs:
+			if !ok {
+				errs = nil
+			}
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			return events, err
+		}
+	}
+}
+
 // IsConnected returns true if the client is currently connected.
 func (c *HubLocalClient) IsConnected() bool {
 	c.connMu.Lock()
@@ -694,6 +753,53 @@ func (c *HubLocalClient) SubscribeAll(ctx context.Context) error {
 	return c.sendFrame(wsOpcodeText, data)
 }
 
+// SubscribeCapabilities limits the Events channel to events from the given
+// capabilities (e.g. "motionSensor", "contactSensor"). Repeated calls add to
+// the set. The hub's local API cannot subscribe by capability, so filtering
+// happens client-side: if no devices have been subscribed with Subscribe,
+// this also calls SubscribeAll so the hub sends events at all. The filter is
+// kept across reconnects, and the underlying subscription is restored like
+// any other.
+//
+// Example:
+//
+//	client.SubscribeCapabilities(ctx, []string{"motionSensor", "contactSensor"})
+//	for event := range client.Events() {
+//	    // Only motion and contact events arrive here
+//	}
+func (c *HubLocalClient) SubscribeCapabilities(ctx context.Context, capabilities []string) error {
+	if len(capabilities) == 0 {
+		return errors.New("at least one capability is required")
+	}
+
+	c.subscriptionsMu.Lock()
+	if c.capabilities == nil {
+		c.capabilities = make(map[string]struct{}, len(capabilities))
+	}
+	for _, capability := range capabilities {
+		c.capabilities[capability] = struct{}{}
+	}
+	needsSubscription := !c.subscribeAll && len(c.subscriptions) == 0
+	c.subscriptionsMu.Unlock()
+
+	if needsSubscription {
+		return c.SubscribeAll(ctx)
+	}
+	return nil
+}
+
+// wantsCapability reports whether events for capability pass the filter set
+// by SubscribeCapabilities. Without a filter, every event passes.
+func (c *HubLocalClient) wantsCapability(capability string) bool {
+	c.subscriptionsMu.RLock()
+	defer c.subscriptionsMu.RUnlock()
+	if len(c.capabilities) == 0 {
+		return true
+	}
+	_, ok := c.capabilities[capability]
+	return ok
+}
+
 // reconnectLoop handles automatic reconnection with exponential backoff.
 func (c *HubLocalClient) reconnectLoop() {
 	c.reconnectMu.Lock()
@@ -712,6 +818,7 @@ func (c *HubLocalClient) reconnectLoop() {
 
 	delay := c.reconnectDelay
 	ctx := context.Background()
+	attempts := 0
 
 	for {
 		// Check if manually closed
@@ -733,10 +840,16 @@ func (c *HubLocalClient) reconnectLoop() {
 		}
 		c.reconnectMu.Unlock()
 
-		// Reset connection state for reconnect
+		// Reset connection state for reconnect, stopping the previous
+		// connection's ping loop
 		c.connMu.Lock()
 		c.conn = nil
 		c.reader = nil
+		select {
+		case <-c.done:
+		default:
+			close(c.done)
+		}
 		c.done = make(chan struct{})
 		c.connMu.Unlock()
 
@@ -744,6 +857,12 @@ func (c *HubLocalClient) reconnectLoop() {
 		wsURL := fmt.Sprintf("ws://%s:%d/events", c.hubIP, c.hubPort)
 		conn, err := c.dialWebSocket(ctx, wsURL)
 		if err != nil {
+			attempts++
+			if c.reconnectMaxAttempts > 0 && attempts >= c.reconnectMaxAttempts {
+				c.giveUpReconnect(fmt.Errorf("%w after %d attempts: %w", ErrReconnectAttemptsExhausted, attempts, err))
+				return
+			}
+
 			// Increase delay with exponential backoff
 			delay = time.Duration(float64(delay) * 1.5)
 			if delay > c.reconnectMaxDelay {
@@ -766,7 +885,7 @@ func (c *HubLocalClient) reconnectLoop() {
 
 		// Start read and ping loops
 		go c.readLoop()
-		go c.pingLoop()
+		go c.pingLoop(c.done)
 
 		// Restore subscriptions
 		c.subscriptionsMu.RLock()
@@ -800,6 +919,37 @@ func (c *HubLocalClient) reconnectLoop() {
 	}
 }
 
+// giveUpReconnect reports a terminal reconnection error and closes the
+// channels, as readLoop does when reconnection is disabled.
+func (c *HubLocalClient) giveUpReconnect(err error) {
+	// Make room for the terminal error if earlier errors filled the buffer.
+	select {
+	case c.errors <- err:
+	default:
+		select {
+		case <-c.errors:
+		default:
+		}
+		select {
+		case c.errors <- err:
+		default:
+		}
+	}
+
+	if c.onDisconnect != nil {
+		c.onDisconnect(err)
+	}
+
+	// Clear the flag before closing, so IsReconnecting is false once the
+	// channels report closed.
+	c.reconnectMu.Lock()
+	c.reconnecting = false
+	c.reconnectMu.Unlock()
+
+	close(c.events)
+	close(c.errors)
+}
+
 // SetReconnectEnabled enables or disables automatic reconnection.
 func (c *HubLocalClient) SetReconnectEnabled(enabled bool) {
 	c.reconnectMu.Lock()
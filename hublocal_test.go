@@ -7,6 +7,7 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -610,6 +611,78 @@ func TestHubLocalClient_SubscribeAll(t *testing.T) {
 	}
 }
 
+func TestHubLocalClient_SubscribeCapabilities(t *testing.T) {
+	sendEvent := func(server *mockWebSocketServer, conn net.Conn, capability string) {
+		eventJSON, _ := json.Marshal(map[string]any{
+			"messageType": "deviceEvent",
+			"deviceEvent": map[string]any{"deviceId": "device-123", "capability": capability},
+		})
+		server.sendFrame(conn, wsOpcodeText, eventJSON)
+	}
+
+	msgTypes := make(chan string, 1)
+	server := newMockWebSocketServer(t)
+	server.onMessage = func(conn net.Conn, opcode byte, payload []byte) {
+		if opcode != wsOpcodeText {
+			return
+		}
+		var msg map[string]any
+		json.Unmarshal(payload, &msg)
+		msgTypes <- msg["messageType"].(string)
+
+		// Filtered out, then delivered
+		sendEvent(server, conn, "switch")
+		sendEvent(server, conn, "motionSensor")
+	}
+	defer server.close()
+
+	parts := strings.Split(server.addr(), ":")
+	port := 0
+	fmt.Sscanf(parts[1], "%d", &port)
+
+	client, _ := NewHubLocalClient(&HubLocalConfig{
+		HubIP:   parts[0],
+		HubPort: port,
+		Token:   "test-token",
+	})
+
+	ctx := context.Background()
+	if err := client.SubscribeCapabilities(ctx, nil); err == nil {
+		t.Error("expected error for empty capabilities")
+	}
+
+	_ = client.Connect(ctx)
+	defer client.Close()
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := client.SubscribeCapabilities(ctx, []string{"motionSensor", "contactSensor"}); err != nil {
+		t.Fatalf("SubscribeCapabilities: %v", err)
+	}
+
+	select {
+	case msgType := <-msgTypes:
+		if msgType != "subscribeAll" {
+			t.Errorf("messageType = %q, want %q", msgType, "subscribeAll")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for subscribeAll message")
+	}
+
+	select {
+	case ev := <-client.Events():
+		if ev.Capability != "motionSensor" {
+			t.Errorf("Capability = %q, want %q (switch should be filtered)", ev.Capability, "motionSensor")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+
+	if !client.wantsCapability("contactSensor") || client.wantsCapability("switch") {
+		t.Error("unexpected capability filter")
+	}
+}
+
 func TestHubLocalClient_Close(t *testing.T) {
 	server := newMockWebSocketServer(t)
 	defer server.close()
@@ -642,6 +715,145 @@ func TestHubLocalClient_Close(t *testing.T) {
 	}
 }
 
+func TestHubLocalClient_CloseAndDrain(t *testing.T) {
+	t.Run("returns buffered events", func(t *testing.T) {
+		connChan := make(chan net.Conn, 1)
+		server := newMockWebSocketServer(t)
+		server.onConnect = func(conn net.Conn, reader *bufio.Reader) {
+			connChan <- conn
+		}
+		defer server.close()
+
+		parts := strings.Split(server.addr(), ":")
+		port := 0
+		fmt.Sscanf(parts[1], "%d", &port)
+
+		client, _ := NewHubLocalClient(&HubLocalConfig{
+			HubIP:   parts[0],
+			HubPort: port,
+			Token:   "test-token",
+		})
+		if err := client.Connect(context.Background()); err != nil {
+			t.Fatalf("Connect: %v", err)
+		}
+
+		var serverConn net.Conn
+		select {
+		case serverConn = <-connChan:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for connection")
+		}
+
+		for _, id := range []string{"d1", "d2", "d3"} {
+			eventJSON, _ := json.Marshal(map[string]any{
+				"messageType": "deviceEvent",
+				"deviceEvent": map[string]any{"deviceId": id, "capability": "switch"},
+			})
+			server.sendFrame(serverConn, wsOpcodeText, eventJSON)
+		}
+		// An unparseable message queues an error that must not block shutdown
+		server.sendFrame(serverConn, wsOpcodeText, []byte("not json"))
+		time.Sleep(100 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		events, err := client.CloseAndDrain(ctx)
+		if err != nil {
+			t.Fatalf("CloseAndDrain: %v", err)
+		}
+		if len(events) != 3 || events[0].DeviceID != "d1" || events[2].DeviceID != "d3" {
+			t.Errorf("events = %+v, want d1..d3", events)
+		}
+		if client.IsConnected() {
+			t.Error("expected client to be disconnected")
+		}
+
+		// Draining again is a no-op
+		if events, err := client.CloseAndDrain(ctx); err != nil || len(events) != 0 {
+			t.Errorf("second CloseAndDrain = (%v, %v), want no events", events, err)
+		}
+	})
+
+	t.Run("never connected", func(t *testing.T) {
+		client, _ := NewHubLocalClient(&HubLocalConfig{HubIP: "127.0.0.1", Token: "test-token"})
+		events, err := client.CloseAndDrain(context.Background())
+		if err != nil || len(events) != 0 {
+			t.Errorf("CloseAndDrain = (%v, %v), want no events", events, err)
+		}
+	})
+}
+
+func TestHubLocalClient_ReconnectMaxAttempts(t *testing.T) {
+	connected := make(chan struct{})
+	server := newMockWebSocketServer(t)
+	server.onConnect = func(conn net.Conn, reader *bufio.Reader) {
+		close(connected)
+	}
+
+	parts := strings.Split(server.addr(), ":")
+	port := 0
+	fmt.Sscanf(parts[1], "%d", &port)
+
+	disconnects := make(chan error, 10)
+	client, _ := NewHubLocalClient(&HubLocalConfig{
+		HubIP:                parts[0],
+		HubPort:              port,
+		Token:                "test-token",
+		ReconnectDelay:       10 * time.Millisecond,
+		ReconnectMaxDelay:    20 * time.Millisecond,
+		ReconnectMaxAttempts: 2,
+		OnDisconnect:         func(err error) { disconnects <- err },
+	})
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	select {
+	case <-connected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for connection")
+	}
+
+	// Drop the connection and refuse new ones so every reconnect fails
+	server.close()
+
+	var terminal error
+	timeout := time.After(5 * time.Second)
+	for terminal == nil {
+		select {
+		case err, ok := <-client.Errors():
+			if !ok {
+				t.Fatal("errors channel closed without terminal error")
+			}
+			if errors.Is(err, ErrReconnectAttemptsExhausted) {
+				terminal = err
+			}
+		case <-timeout:
+			t.Fatal("timeout waiting for terminal error")
+		}
+	}
+
+	select {
+	case _, ok := <-client.Events():
+		if ok {
+			t.Error("expected events channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("events channel was not closed")
+	}
+
+	var last error
+	for len(disconnects) > 0 {
+		last = <-disconnects
+	}
+	if !errors.Is(last, ErrReconnectAttemptsExhausted) {
+		t.Errorf("last OnDisconnect error = %v, want ErrReconnectAttemptsExhausted", last)
+	}
+
+	if client.IsReconnecting() {
+		t.Error("expected IsReconnecting to be false after giving up")
+	}
+}
+
 func TestHubLocalClient_PingPong(t *testing.T) {
 	pongReceived := make(chan struct{})
 
@@ -15,6 +15,15 @@ type Hub struct {
 	Owner           string `json:"owner,omitempty"`
 	SerialNumber    string `json:"serialNumber,omitempty"`
 	FirmwareVersion string `json:"firmwareVersion,omitempty"`
+	UpdateAvailable bool   `json:"updateAvailable,omitempty"`
+	State           string `json:"state,omitempty"` // ONLINE, OFFLINE, UNKNOWN; not set by GetHub
+}
+
+// HubFirmware contains a hub's firmware information.
+type HubFirmware struct {
+	HubID           string `json:"hubId"`
+	Version         string `json:"version,omitempty"`
+	UpdateAvailable bool   `json:"updateAvailable,omitempty"`
 }
 
 // HubCharacteristics contains detailed hub characteristics.
@@ -71,20 +80,15 @@ type installedDriverListResponse struct {
 
 // GetHub returns a hub by ID.
 // This uses the standard devices endpoint since /hubdevices/{id} is not available.
+// State is left empty; use Client.HubIsOnline to check the hub's health.
 func (c *Client) GetHub(ctx context.Context, hubID string) (*Hub, error) {
 	if hubID == "" {
 		return nil, ErrEmptyHubID
 	}
 
-	// Use GetDevice since /hubdevices/{id} doesn't exist
-	device, err := c.GetDevice(ctx, hubID)
+	device, err := c.getHubDevice(ctx, hubID)
 	if err != nil {
-		return nil, fmt.Errorf("GetHub: get device: %w", err)
-	}
-
-	// Verify it's actually a hub
-	if device.Type != DeviceTypeHUB {
-		return nil, fmt.Errorf("GetHub: device %s is not a hub (type: %s)", hubID, device.Type)
+		return nil, fmt.Errorf("GetHub: %w", err)
 	}
 
 	hub := &Hub{
@@ -97,11 +101,72 @@ func (c *Client) GetHub(ctx context.Context, hubID string) (*Hub, error) {
 	if device.Hub != nil {
 		hub.EUI = device.Hub.HubEUI
 		hub.FirmwareVersion = device.Hub.FirmwareVersion
+		hub.UpdateAvailable = device.Hub.UpdateAvailable
 	}
-
 	return hub, nil
 }
 
+// GetHubFirmware returns the firmware version of a hub and whether the API
+// reports an update as available.
+func (c *Client) GetHubFirmware(ctx context.Context, hubID string) (*HubFirmware, error) {
+	if hubID == "" {
+		return nil, ErrEmptyHubID
+	}
+
+	device, err := c.getHubDevice(ctx, hubID)
+	if err != nil {
+		return nil, fmt.Errorf("GetHubFirmware: %w", err)
+	}
+
+	firmware := &HubFirmware{HubID: device.DeviceID}
+	if device.Hub != nil {
+		firmware.Version = device.Hub.FirmwareVersion
+		firmware.UpdateAvailable = device.Hub.UpdateAvailable
+	}
+	return firmware, nil
+}
+
+// HubIsOnline reports whether a hub's State is ONLINE. GetHub does not set
+// State; use Client.HubIsOnline to look up a hub's health.
+func HubIsOnline(hub *Hub) bool {
+	return hub != nil && hub.State == "ONLINE"
+}
+
+// HubIsOnline reports whether a hub's device health is ONLINE. It makes a
+// single health request and, unlike GetHub, does not fetch the hub itself.
+//
+// Example:
+//
+//	online, err := client.HubIsOnline(ctx, hubID)
+//	if err == nil && !online {
+//	    alert("hub offline")
+//	}
+func (c *Client) HubIsOnline(ctx context.Context, hubID string) (bool, error) {
+	if hubID == "" {
+		return false, ErrEmptyHubID
+	}
+	health, err := c.GetDeviceHealth(ctx, hubID)
+	if err != nil {
+		return false, fmt.Errorf("HubIsOnline: %w", err)
+	}
+	return health.State == "ONLINE", nil
+}
+
+// getHubDevice fetches a hub's device record and verifies that it is a hub.
+func (c *Client) getHubDevice(ctx context.Context, hubID string) (*Device, error) {
+	// Use GetDevice since /hubdevices/{id} doesn't exist
+	device, err := c.GetDevice(ctx, hubID)
+	if err != nil {
+		return nil, fmt.Errorf("get device: %w", err)
+	}
+
+	// Verify it's actually a hub
+	if device.Type != DeviceTypeHUB {
+		return nil, fmt.Errorf("device %s is not a hub (type: %s)", hubID, device.Type)
+	}
+	return device, nil
+}
+
 // GetHubCharacteristics returns detailed characteristics for a hub.
 func (c *Client) GetHubCharacteristics(ctx context.Context, hubID string) (HubCharacteristics, error) {
 	if hubID == "" {
@@ -63,6 +63,153 @@ func TestClient_GetHub(t *testing.T) {
 	}
 }
 
+func TestClient_GetHub_Firmware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/devices/hub1" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"deviceId":"hub1","label":"Hub","type":"HUB","hub":{"firmwareVersion":"000.055.00019","updateAvailable":true}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("test-token", WithBaseURL(server.URL))
+	hub, err := client.GetHub(context.Background(), "hub1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hub.FirmwareVersion != "000.055.00019" || !hub.UpdateAvailable {
+		t.Errorf("unexpected firmware fields: %+v", hub)
+	}
+	if hub.State != "" {
+		t.Errorf("State = %q, want empty", hub.State)
+	}
+}
+
+func TestClient_HubIsOnline(t *testing.T) {
+	tests := []struct {
+		name       string
+		hubID      string
+		response   string
+		statusCode int
+		want       bool
+		wantErr    bool
+	}{
+		{name: "online", hubID: "hub1", response: `{"deviceId":"hub1","state":"ONLINE"}`, statusCode: http.StatusOK, want: true},
+		{name: "offline", hubID: "hub1", response: `{"deviceId":"hub1","state":"OFFLINE"}`, statusCode: http.StatusOK},
+		{name: "health error", hubID: "hub1", statusCode: http.StatusNotFound, wantErr: true},
+		{name: "empty hub ID", hubID: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/devices/"+tt.hubID+"/health" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("test-token", WithBaseURL(server.URL))
+			online, err := client.HubIsOnline(context.Background(), tt.hubID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if online != tt.want {
+				t.Errorf("HubIsOnline() = %v, want %v", online, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetHubFirmware(t *testing.T) {
+	tests := []struct {
+		name        string
+		hubID       string
+		response    string
+		statusCode  int
+		wantVersion string
+		wantUpdate  bool
+		wantErr     bool
+	}{
+		{
+			name:        "firmware with update",
+			hubID:       "hub1",
+			response:    `{"deviceId":"hub1","type":"HUB","hub":{"firmwareVersion":"000.055.00019","updateAvailable":true}}`,
+			statusCode:  http.StatusOK,
+			wantVersion: "000.055.00019",
+			wantUpdate:  true,
+		},
+		{
+			name:       "no hub info",
+			hubID:      "hub1",
+			response:   `{"deviceId":"hub1","type":"HUB"}`,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "not a hub",
+			hubID:      "device1",
+			response:   `{"deviceId":"device1","type":"ZIGBEE"}`,
+			statusCode: http.StatusOK,
+			wantErr:    true,
+		},
+		{
+			name:    "empty hub ID",
+			hubID:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("test-token", WithBaseURL(server.URL))
+			firmware, err := client.GetHubFirmware(context.Background(), tt.hubID)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if firmware.Version != tt.wantVersion || firmware.UpdateAvailable != tt.wantUpdate {
+				t.Errorf("got %+v, want version %q update %v", firmware, tt.wantVersion, tt.wantUpdate)
+			}
+		})
+	}
+}
+
+func TestHubIsOnline(t *testing.T) {
+	tests := []struct {
+		name string
+		hub  *Hub
+		want bool
+	}{
+		{"online", &Hub{State: "ONLINE"}, true},
+		{"offline", &Hub{State: "OFFLINE"}, false},
+		{"unknown state", &Hub{}, false},
+		{"nil hub", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HubIsOnline(tt.hub); got != tt.want {
+				t.Errorf("HubIsOnline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_GetHubCharacteristics(t *testing.T) {
 	tests := []struct {
 		name       string
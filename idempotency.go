@@ -0,0 +1,83 @@
+package smartthings
+
+import (
+	"context"
+	"errors"
+)
+
+// IdempotencyKeyHeader is the request header carrying the key set with
+// ContextWithIdempotencyKey.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyCtx is the context key for the idempotency key.
+type idempotencyKeyCtx struct{}
+
+// retryGuardCtx is the context key for a create operation's retry guard.
+type retryGuardCtx struct{}
+
+// errAlreadyCreated stops a retry whose resource was created by an earlier attempt.
+var errAlreadyCreated = errors.New("smartthings: resource already created")
+
+// ContextWithIdempotencyKey returns a context that makes create operations
+// (CreateRule, CreateLocation) safe to retry. POST requests made with the
+// context send key in the Idempotency-Key header, and the same header is
+// replayed on every retry.
+//
+// The SmartThings API does not document idempotency header support, so the
+// client also deduplicates by name: before creating, and before each retry,
+// it looks for an existing resource with the same name and returns that
+// instead of creating another one.
+//
+// Example:
+//
+//	ctx := smartthings.ContextWithIdempotencyKey(ctx, "nightly-rule-v1")
+//	rule, err := client.CreateRule(ctx, locationID, ruleCreate)
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// idempotencyKey returns the idempotency key carried by ctx, if any.
+func idempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	return key
+}
+
+// retryGuard returns the guard installed by createIdempotent, if any. It
+// reports whether the resource being created already exists.
+func retryGuard(ctx context.Context) func() bool {
+	guard, _ := ctx.Value(retryGuardCtx{}).(func() bool)
+	return guard
+}
+
+// createIdempotent runs create, deduplicating with find when ctx carries an
+// idempotency key. find returns the existing resource, or nil if there is none.
+func createIdempotent[T any](ctx context.Context, find func(context.Context) (*T, error), create func(context.Context) (*T, error)) (*T, error) {
+	if idempotencyKey(ctx) == "" {
+		return create(ctx)
+	}
+
+	existing, err := find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	// An attempt that timed out may still have created the resource, so
+	// check again before each retry. The guard uses the outer ctx so that
+	// its own requests are not guarded.
+	guard := func() bool {
+		found, err := find(ctx)
+		if err != nil || found == nil {
+			return false
+		}
+		existing = found
+		return true
+	}
+	created, err := create(context.WithValue(ctx, retryGuardCtx{}, guard))
+	if errors.Is(err, errAlreadyCreated) {
+		return existing, nil
+	}
+	return created, err
+}
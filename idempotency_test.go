@@ -0,0 +1,157 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// ruleServer is a fake rules API. failPosts makes that many POSTs return 502
+// after (when created is set) storing the rule anyway.
+type ruleServer struct {
+	mu        sync.Mutex
+	rules     []Rule
+	posts     int
+	lists     int
+	keys      []string
+	failPosts int
+	created   bool
+}
+
+func (s *ruleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		s.lists++
+		json.NewEncoder(w).Encode(map[string]any{"items": s.rules})
+	case http.MethodPost:
+		s.posts++
+		s.keys = append(s.keys, r.Header.Get(IdempotencyKeyHeader))
+		var req RuleCreate
+		json.NewDecoder(r.Body).Decode(&req)
+		rule := Rule{ID: "rule-" + req.Name, Name: req.Name}
+		if s.posts <= s.failPosts {
+			if s.created {
+				s.rules = append(s.rules, rule)
+			}
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		s.rules = append(s.rules, rule)
+		json.NewEncoder(w).Encode(rule)
+	}
+}
+
+func TestCreateRule_Idempotency(t *testing.T) {
+	retry := WithRetry(&RetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1})
+	newRule := &RuleCreate{Name: "Nightly", Actions: []RuleAction{{}}}
+
+	t.Run("without key", func(t *testing.T) {
+		fake := &ruleServer{rules: []Rule{{ID: "rule-old", Name: "Nightly"}}}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		rule, err := client.CreateRule(context.Background(), "loc-1", newRule)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rule.ID != "rule-Nightly" || fake.lists != 0 || fake.keys[0] != "" {
+			t.Errorf("rule = %+v, lists = %d, keys = %q", rule, fake.lists, fake.keys)
+		}
+	})
+
+	t.Run("returns existing rule with the same name", func(t *testing.T) {
+		fake := &ruleServer{rules: []Rule{{ID: "rule-old", Name: "Nightly"}}}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+		rule, err := client.CreateRule(ctx, "loc-1", newRule)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rule.ID != "rule-old" {
+			t.Errorf("rule.ID = %q, want rule-old", rule.ID)
+		}
+		if fake.posts != 0 {
+			t.Errorf("expected no POST, got %d", fake.posts)
+		}
+	})
+
+	t.Run("retry after a failed attempt that created the rule", func(t *testing.T) {
+		fake := &ruleServer{failPosts: 1, created: true}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), retry)
+		ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+		rule, err := client.CreateRule(ctx, "loc-1", newRule)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rule.Name != "Nightly" {
+			t.Errorf("rule = %+v", rule)
+		}
+		if fake.posts != 1 || len(fake.rules) != 1 {
+			t.Errorf("posts = %d, rules = %d, want 1 and 1", fake.posts, len(fake.rules))
+		}
+	})
+
+	t.Run("header replayed on retry", func(t *testing.T) {
+		fake := &ruleServer{failPosts: 1}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), retry)
+		ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+		if _, err := client.CreateRule(ctx, "loc-1", newRule); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(fake.keys) != 2 || fake.keys[0] != "key-1" || fake.keys[1] != "key-1" {
+			t.Errorf("keys = %q, want key-1 on both attempts", fake.keys)
+		}
+		if len(fake.rules) != 1 {
+			t.Errorf("expected 1 rule, got %d", len(fake.rules))
+		}
+	})
+}
+
+func TestCreateLocation_Idempotency(t *testing.T) {
+	var posts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts++
+			json.NewEncoder(w).Encode(Location{LocationID: "loc-new", Name: "Cabin"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"items": []Location{{LocationID: "loc-1", Name: "Home"}}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+
+	loc, err := client.CreateLocation(ctx, &LocationCreate{Name: "Home"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loc.LocationID != "loc-1" || posts != 0 {
+		t.Errorf("location = %+v, posts = %d, want existing loc-1 without POST", loc, posts)
+	}
+
+	loc, err = client.CreateLocation(ctx, &LocationCreate{Name: "Cabin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loc.LocationID != "loc-new" || posts != 1 {
+		t.Errorf("location = %+v, posts = %d, want created loc-new", loc, posts)
+	}
+}
@@ -181,7 +181,7 @@ func (c *Client) GetInstalledAppConfig(ctx context.Context, installedAppID, conf
 		return nil, ErrEmptyInstalledAppID
 	}
 	if configID == "" {
-		return nil, fmt.Errorf("smartthings: configuration ID cannot be empty")
+		return nil, ErrEmptyConfigID
 	}
 
 	data, err := c.get(ctx, "/installedapps/"+installedAppID+"/configs/"+configID)
@@ -197,6 +197,51 @@ func (c *Client) GetInstalledAppConfig(ctx context.Context, installedAppID, conf
 	return &config, nil
 }
 
+// installedAppConfigUpdate is the request body for updating a configuration.
+type installedAppConfigUpdate struct {
+	Config map[string][]ConfigEntry `json:"config"`
+}
+
+// UpdateInstalledAppConfig replaces the values of an installed app
+// configuration with config.Config and returns the updated configuration.
+// The other fields of config, such as IDs, status, and dates, are set by the
+// API and ignored.
+//
+// Example:
+//
+//	cfg, err := client.GetCurrentInstalledAppConfig(ctx, installedAppID)
+//	if err != nil || cfg == nil {
+//	    return err
+//	}
+//	cfg.Config["threshold"] = []smartthings.ConfigEntry{{
+//	    ValueType:    smartthings.ConfigValueTypeString,
+//	    StringConfig: &smartthings.StringConfig{Value: "75"},
+//	}}
+//	cfg, err = client.UpdateInstalledAppConfig(ctx, installedAppID, cfg.ConfigurationID, cfg)
+func (c *Client) UpdateInstalledAppConfig(ctx context.Context, installedAppID, configID string, config *InstalledAppConfiguration) (*InstalledAppConfiguration, error) {
+	if installedAppID == "" {
+		return nil, ErrEmptyInstalledAppID
+	}
+	if configID == "" {
+		return nil, ErrEmptyConfigID
+	}
+	if config == nil {
+		return nil, ErrEmptyInstalledAppConfig
+	}
+
+	data, err := c.put(ctx, "/installedapps/"+installedAppID+"/configs/"+configID, installedAppConfigUpdate{Config: config.Config})
+	if err != nil {
+		return nil, err
+	}
+
+	var updated InstalledAppConfiguration
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse updated installed app config: %w (body: %s)", err, truncatePreview(data))
+	}
+
+	return &updated, nil
+}
+
 // GetCurrentInstalledAppConfig returns the current (latest authorized) configuration.
 // Returns nil if no authorized configuration exists.
 func (c *Client) GetCurrentInstalledAppConfig(ctx context.Context, installedAppID string) (*InstalledAppConfiguration, error) {
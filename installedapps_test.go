@@ -355,8 +355,8 @@ func TestClient_GetInstalledAppConfig(t *testing.T) {
 	t.Run("empty config ID", func(t *testing.T) {
 		client, _ := NewClient("token")
 		_, err := client.GetInstalledAppConfig(context.Background(), "app-123", "")
-		if err == nil {
-			t.Fatal("expected error for empty config ID")
+		if err != ErrEmptyConfigID {
+			t.Errorf("expected ErrEmptyConfigID, got %v", err)
 		}
 	})
 
@@ -440,3 +440,58 @@ func TestClient_GetCurrentInstalledAppConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_UpdateInstalledAppConfig(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		var body map[string]json.RawMessage
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/installedapps/app-123/configs/config-456" {
+				t.Errorf("request = %s %s", r.Method, r.URL.Path)
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(`{"installedAppId": "app-123", "configurationId": "config-456", "configurationStatus": "STAGED",
+				"config": {"threshold": [{"valueType": "STRING", "stringConfig": {"value": "75"}}]}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		updated, err := client.UpdateInstalledAppConfig(context.Background(), "app-123", "config-456", &InstalledAppConfiguration{
+			ConfigurationStatus: "AUTHORIZED",
+			Config: map[string][]ConfigEntry{
+				"threshold": {{ValueType: ConfigValueTypeString, StringConfig: &StringConfig{Value: "75"}}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updated.ConfigurationStatus != "STAGED" || updated.Config["threshold"][0].StringConfig.Value != "75" {
+			t.Errorf("updated = %+v", updated)
+		}
+		if len(body) != 1 || body["config"] == nil {
+			t.Errorf("request body should contain only config, got %v", body)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		config := &InstalledAppConfiguration{}
+		tests := []struct {
+			name           string
+			installedAppID string
+			configID       string
+			config         *InstalledAppConfiguration
+			wantErr        error
+		}{
+			{"empty installed app ID", "", "config-456", config, ErrEmptyInstalledAppID},
+			{"empty config ID", "app-123", "", config, ErrEmptyConfigID},
+			{"nil config", "app-123", "config-456", nil, ErrEmptyInstalledAppConfig},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := client.UpdateInstalledAppConfig(context.Background(), tt.installedAppID, tt.configID, tt.config); err != tt.wantErr {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+}
@@ -113,7 +113,7 @@ func TestIntegration_GetDeviceStatus(t *testing.T) {
 
 func TestIntegration_Capabilities(t *testing.T) {
 	token := getTestToken(t)
-	client, err := NewClient(token)
+	client, err := NewClient(token, WithForceRemoteCapabilities())
 	if err != nil {
 		t.Fatalf("NewClient: %v", err)
 	}
@@ -2,6 +2,7 @@ package smartthings
 
 import (
 	"context"
+	"io"
 	"iter"
 	"time"
 )
@@ -16,17 +17,29 @@ type SmartThingsClient interface {
 	ListDevices(ctx context.Context) ([]Device, error)
 	ListDevicesWithOptions(ctx context.Context, opts *ListDevicesOptions) (*PagedDevices, error)
 	ListAllDevices(ctx context.Context) ([]Device, error)
+	ListDevicesByLocation(ctx context.Context, locationIDs []string) (map[string][]Device, error)
 	GetDevice(ctx context.Context, deviceID string) (*Device, error)
+	GetDeviceCached(ctx context.Context, deviceID string) (*Device, bool, error)
 	GetDeviceStatus(ctx context.Context, deviceID string) (Status, error)
 	GetDeviceFullStatus(ctx context.Context, deviceID string) (map[string]Status, error)
 	GetDeviceStatusAllComponents(ctx context.Context, deviceID string) (Status, error)
 	GetComponentStatus(ctx context.Context, deviceID, componentID string) (Status, error)
+	GetCapabilityStatus(ctx context.Context, deviceID, componentID, capabilityID string) (Status, error)
 	ExecuteCommand(ctx context.Context, deviceID string, cmd Command) error
 	ExecuteCommands(ctx context.Context, deviceID string, cmds []Command) error
+	ExecuteCommandSequence(ctx context.Context, deviceID string, steps []CommandStep) error
+	ValidateCommand(ctx context.Context, cmd Command) error
 	ExecuteComponentCommand(ctx context.Context, deviceID, component, capability, command string, args ...any) error
+	ExecuteCommandOnComponent(ctx context.Context, deviceID, componentID string, cmd Command) error
+	ExecuteCommandAndRefresh(ctx context.Context, deviceID string, cmd Command) (Status, error)
 	DeleteDevice(ctx context.Context, deviceID string) error
 	UpdateDevice(ctx context.Context, deviceID string, update *DeviceUpdate) (*Device, error)
+	MoveDevice(ctx context.Context, deviceID, targetLocationID, targetRoomID string) (*Device, error)
 	GetDeviceHealth(ctx context.Context, deviceID string) (*DeviceHealth, error)
+	DeviceHasCapability(ctx context.Context, deviceID, capability string) (bool, error)
+	FindDeviceByLabel(ctx context.Context, label string) (*Device, error)
+	SearchDevices(ctx context.Context, query string) ([]Device, error)
+	WaitForDeviceState(ctx context.Context, deviceID, capability, attribute string, want any, opts *WaitOptions) error
 	Devices(ctx context.Context) iter.Seq2[Device, error]
 	DevicesWithOptions(ctx context.Context, opts *ListDevicesOptions) iter.Seq2[Device, error]
 
@@ -36,7 +49,21 @@ type SmartThingsClient interface {
 
 	ExecuteCommandBatch(ctx context.Context, deviceIDs []string, cmd Command, cfg *BatchConfig) []BatchResult
 	ExecuteCommandsBatch(ctx context.Context, batch []BatchCommand, cfg *BatchConfig) []BatchResult
+	ExecuteScenesBatch(ctx context.Context, sceneIDs []string, cfg *BatchConfig) []BatchResult
 	GetDeviceStatusBatch(ctx context.Context, deviceIDs []string, cfg *BatchConfig) []BatchStatusResult
+	GetDeviceHealthBatch(ctx context.Context, deviceIDs []string, cfg *BatchConfig) []BatchHealthResult
+	AssignDevicesToRoom(ctx context.Context, locationID, roomID string, deviceIDs []string) []BatchResult
+	AssignDevicesToRoomWithConfig(ctx context.Context, locationID, roomID string, deviceIDs []string, cfg *BatchConfig) []BatchResult
+	GetLocationInventory(ctx context.Context, locationID string) ([]DeviceWithStatus, error)
+
+	// ============================================================================
+	// Export Operations
+	// ============================================================================
+
+	ExportDevices(ctx context.Context, w io.Writer) error
+	ExportLocations(ctx context.Context, w io.Writer) error
+	ExportRules(ctx context.Context, w io.Writer) error
+	ImportRules(ctx context.Context, locationID string, r io.Reader) ([]Rule, error)
 
 	// ============================================================================
 	// Location Operations
@@ -54,6 +81,7 @@ type SmartThingsClient interface {
 	// ============================================================================
 
 	ListRooms(ctx context.Context, locationID string) ([]Room, error)
+	ListRoomsWithOptions(ctx context.Context, locationID string, opts *ListOptions) (*PagedRooms, error)
 	GetRoom(ctx context.Context, locationID, roomID string) (*Room, error)
 	CreateRoom(ctx context.Context, locationID string, room *RoomCreate) (*Room, error)
 	UpdateRoom(ctx context.Context, locationID, roomID string, update *RoomUpdate) (*Room, error)
@@ -65,8 +93,11 @@ type SmartThingsClient interface {
 	// ============================================================================
 
 	ListScenes(ctx context.Context, locationID string) ([]Scene, error)
+	ListScenesWithOptions(ctx context.Context, locationID string, opts *ListOptions) (*PagedScenes, error)
 	GetScene(ctx context.Context, sceneID string) (*Scene, error)
 	ExecuteScene(ctx context.Context, sceneID string) error
+	ExecuteSceneWithResult(ctx context.Context, sceneID string) (*SceneExecutionResult, error)
+	BuildSceneFromDevices(ctx context.Context, locationID string, deviceIDs []string) (*SceneCreate, error)
 	Scenes(ctx context.Context, locationID string) iter.Seq2[Scene, error]
 
 	// ============================================================================
@@ -77,6 +108,9 @@ type SmartThingsClient interface {
 	ListCapabilitiesWithOptions(ctx context.Context, opts *ListCapabilitiesOptions) ([]CapabilityReference, error)
 	GetCapability(ctx context.Context, capabilityID string, version int) (*Capability, error)
 	Capabilities(ctx context.Context) iter.Seq2[CapabilityReference, error]
+	CapabilitiesWithOptions(ctx context.Context, opts *ListCapabilitiesOptions) iter.Seq2[CapabilityReference, error]
+	GetCapabilityLocalization(ctx context.Context, capabilityID string, version int, locale string) (*CapabilityLocalization, error)
+	ListCapabilityLocalizations(ctx context.Context, capabilityID string, version int) ([]LocaleReference, error)
 
 	// ============================================================================
 	// Subscription Operations (webhooks)
@@ -84,6 +118,7 @@ type SmartThingsClient interface {
 
 	ListSubscriptions(ctx context.Context, installedAppID string) ([]Subscription, error)
 	CreateSubscription(ctx context.Context, installedAppID string, sub *SubscriptionCreate) (*Subscription, error)
+	SubscribeLocationDevices(ctx context.Context, installedAppID, locationID string) ([]Subscription, error)
 	DeleteSubscription(ctx context.Context, installedAppID, subscriptionID string) error
 	DeleteAllSubscriptions(ctx context.Context, installedAppID string) error
 	Subscriptions(ctx context.Context, installedAppID string) iter.Seq2[Subscription, error]
@@ -93,11 +128,16 @@ type SmartThingsClient interface {
 	// ============================================================================
 
 	ListRules(ctx context.Context, locationID string) ([]Rule, error)
+	ListRulesForDevice(ctx context.Context, locationID, deviceID string) ([]Rule, error)
 	GetRule(ctx context.Context, ruleID string) (*Rule, error)
 	CreateRule(ctx context.Context, locationID string, rule *RuleCreate) (*Rule, error)
 	UpdateRule(ctx context.Context, ruleID string, rule *RuleUpdate) (*Rule, error)
 	DeleteRule(ctx context.Context, ruleID string) error
+	DeleteAllRules(ctx context.Context, locationID string) error
 	ExecuteRule(ctx context.Context, ruleID string) error
+	EnableRule(ctx context.Context, ruleID string) error
+	DisableRule(ctx context.Context, ruleID string) error
+	TestRule(ctx context.Context, ruleID string) (*RuleTestResult, error)
 	Rules(ctx context.Context, locationID string) iter.Seq2[Rule, error]
 
 	// ============================================================================
@@ -119,6 +159,7 @@ type SmartThingsClient interface {
 	DeleteInstalledApp(ctx context.Context, installedAppID string) error
 	ListInstalledAppConfigs(ctx context.Context, installedAppID string) ([]InstalledAppConfigItem, error)
 	GetInstalledAppConfig(ctx context.Context, installedAppID, configID string) (*InstalledAppConfiguration, error)
+	UpdateInstalledAppConfig(ctx context.Context, installedAppID, configID string, config *InstalledAppConfiguration) (*InstalledAppConfiguration, error)
 	GetCurrentInstalledAppConfig(ctx context.Context, installedAppID string) (*InstalledAppConfiguration, error)
 	InstalledApps(ctx context.Context, locationID string) iter.Seq2[InstalledApp, error]
 
@@ -130,6 +171,7 @@ type SmartThingsClient interface {
 	GetMode(ctx context.Context, locationID, modeID string) (*Mode, error)
 	GetCurrentMode(ctx context.Context, locationID string) (*Mode, error)
 	SetCurrentMode(ctx context.Context, locationID, modeID string) (*Mode, error)
+	SetModeForPresence(ctx context.Context, locationID string, anyonePresent bool, homeMode, awayMode string) error
 	Modes(ctx context.Context, locationID string) iter.Seq2[Mode, error]
 
 	// ============================================================================
@@ -137,8 +179,12 @@ type SmartThingsClient interface {
 	// ============================================================================
 
 	GetDeviceEvents(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedEvents, error)
+	GetDeviceEventsPage(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedEvents, string, error)
 	GetDeviceStates(ctx context.Context, deviceID string, opts *HistoryOptions) (*PagedStates, error)
+	GetEnergyUsage(ctx context.Context, deviceID string, opts *HistoryOptions) (*EnergyUsageSummary, error)
 	DeviceEvents(ctx context.Context, deviceID string, opts *HistoryOptions) iter.Seq2[DeviceEvent, error]
+	DeviceEventsFiltered(ctx context.Context, deviceID string, filter EventFilter) iter.Seq2[DeviceEvent, error]
+	DeviceEventStream(ctx context.Context, deviceIDs []string) (iter.Seq2[DeviceEvent, error], error)
 
 	// ============================================================================
 	// App Operations
@@ -187,12 +233,15 @@ type SmartThingsClient interface {
 	CreatePresentationConfig(ctx context.Context, config *PresentationDeviceConfigCreate) (*PresentationDeviceConfig, error)
 	GetPresentationConfig(ctx context.Context, presentationID, manufacturerName string) (*PresentationDeviceConfig, error)
 	GetDevicePresentation(ctx context.Context, presentationID, manufacturerName string) (*PresentationDevicePresentation, error)
+	BuildDeviceUIModel(ctx context.Context, deviceID string) (*DeviceUIModel, error)
 
 	// ============================================================================
 	// Hub Operations
 	// ============================================================================
 
 	GetHub(ctx context.Context, hubID string) (*Hub, error)
+	GetHubFirmware(ctx context.Context, hubID string) (*HubFirmware, error)
+	HubIsOnline(ctx context.Context, hubID string) (bool, error)
 	GetHubCharacteristics(ctx context.Context, hubID string) (HubCharacteristics, error)
 	ListEnrolledChannels(ctx context.Context, hubID string) ([]EnrolledChannel, error)
 	ListInstalledDrivers(ctx context.Context, hubID string, deviceID string) ([]InstalledDriver, error)
@@ -241,6 +290,7 @@ type SmartThingsClient interface {
 	CreateStandardVirtualDevice(ctx context.Context, req *VirtualDeviceStandardCreateRequest) (*Device, error)
 	ListVirtualDevices(ctx context.Context, opts *VirtualDeviceListOptions) ([]Device, error)
 	CreateVirtualDeviceEvents(ctx context.Context, deviceID string, events []VirtualDeviceEvent) (*VirtualDeviceEventsResponse, error)
+	CreateVirtualDeviceWithState(ctx context.Context, req *VirtualDeviceCreateRequest, initialStates []VirtualDeviceEvent) (*Device, error)
 
 	// ============================================================================
 	// Schema (C2C Connector) Operations
@@ -256,6 +306,7 @@ type SmartThingsClient interface {
 	ListInstalledSchemaApps(ctx context.Context, locationID string) ([]InstalledSchemaApp, error)
 	GetInstalledSchemaApp(ctx context.Context, isaID string) (*InstalledSchemaApp, error)
 	DeleteInstalledSchemaApp(ctx context.Context, isaID string) error
+	PushSchemaDeviceState(ctx context.Context, callback SchemaCallback, states []SchemaDeviceState) error
 	SchemaApps(ctx context.Context, includeAllOrganizations bool) iter.Seq2[SchemaApp, error]
 	InstalledSchemaApps(ctx context.Context, locationID string) iter.Seq2[InstalledSchemaApp, error]
 
@@ -281,6 +332,7 @@ type SmartThingsClient interface {
 	// ============================================================================
 
 	CreateNotification(ctx context.Context, req *NotificationRequest) (*NotificationResponse, error)
+	SendNotification(ctx context.Context, locationID, title, message string) (*NotificationResponse, error)
 
 	// ============================================================================
 	// Service (Weather/Air Quality) Operations
@@ -290,6 +342,7 @@ type SmartThingsClient interface {
 	GetServiceCapabilitiesList(ctx context.Context, locationID string) ([]ServiceCapability, error)
 	GetServiceCapability(ctx context.Context, capability ServiceCapability, locationID string) (*ServiceCapabilityData, error)
 	GetServiceCapabilitiesData(ctx context.Context, capabilities []ServiceCapability, locationID string) (*ServiceCapabilityData, error)
+	WatchServiceCapability(ctx context.Context, capability ServiceCapability, locationID string, interval time.Duration) iter.Seq2[*ServiceCapabilityData, error]
 	CreateServiceSubscription(ctx context.Context, req *ServiceSubscriptionRequest, installedAppID, locationID string) (*ServiceNewSubscription, error)
 	UpdateServiceSubscription(ctx context.Context, subscriptionID string, req *ServiceSubscriptionRequest, installedAppID, locationID string) (*ServiceNewSubscription, error)
 	DeleteServiceSubscription(ctx context.Context, subscriptionID, installedAppID, locationID string) error
@@ -307,7 +360,9 @@ type SmartThingsClient interface {
 	SetTVInput(ctx context.Context, deviceID, inputID string) error
 	SetTVChannel(ctx context.Context, deviceID string, channel int) error
 	SendTVKey(ctx context.Context, deviceID, key string) error
+	SendTVKeys(ctx context.Context, deviceID string, keys []string, delay time.Duration) error
 	LaunchTVApp(ctx context.Context, deviceID, appID string) error
+	LaunchTVAppByName(ctx context.Context, deviceID, name string) error
 	TVPlay(ctx context.Context, deviceID string) error
 	TVPause(ctx context.Context, deviceID string) error
 	TVStop(ctx context.Context, deviceID string) error
@@ -315,9 +370,60 @@ type SmartThingsClient interface {
 	TVChannelDown(ctx context.Context, deviceID string) error
 	TVVolumeUp(ctx context.Context, deviceID string) error
 	TVVolumeDown(ctx context.Context, deviceID string) error
+	SetTVArtMode(ctx context.Context, deviceID string, on bool) error
 	SetPictureMode(ctx context.Context, deviceID, mode string) error
 	SetSoundMode(ctx context.Context, deviceID, mode string) error
 
+	// ============================================================================
+	// Light Control Operations
+	// ============================================================================
+
+	SetColor(ctx context.Context, deviceID string, hue, saturation float64) error
+	SetColorTemperature(ctx context.Context, deviceID string, kelvin int) error
+	SetLevel(ctx context.Context, deviceID string, level int) error
+	SetLevelWithRate(ctx context.Context, deviceID string, level, rate int) error
+
+	// ============================================================================
+	// Thermostat Operations
+	// ============================================================================
+
+	SetThermostatSetpoint(ctx context.Context, deviceID string, mode ThermostatMode, temp float64) error
+	SetThermostatMode(ctx context.Context, deviceID string, mode ThermostatMode) error
+
+	// ============================================================================
+	// Lock Operations
+	// ============================================================================
+
+	LockDevice(ctx context.Context, deviceID string) error
+	UnlockDevice(ctx context.Context, deviceID string) error
+	UnlockWithTimeout(ctx context.Context, deviceID string, seconds int) error
+
+	// ============================================================================
+	// Robot Vacuum Operations
+	// ============================================================================
+
+	StartCleaning(ctx context.Context, deviceID string) error
+	PauseCleaning(ctx context.Context, deviceID string) error
+	ReturnToDock(ctx context.Context, deviceID string) error
+	SetCleaningMode(ctx context.Context, deviceID, mode string) error
+
+	// ============================================================================
+	// Air Conditioner Operations
+	// ============================================================================
+
+	SetACMode(ctx context.Context, deviceID, mode string) error
+	SetACSetpoint(ctx context.Context, deviceID string, temp float64) error
+	SetFanMode(ctx context.Context, deviceID, mode string) error
+
+	// ============================================================================
+	// Window Shade Operations
+	// ============================================================================
+
+	OpenShade(ctx context.Context, deviceID string) error
+	CloseShade(ctx context.Context, deviceID string) error
+	PauseShade(ctx context.Context, deviceID string) error
+	SetShadeLevel(ctx context.Context, deviceID string, level int) error
+
 	// ============================================================================
 	// Rate Limit Operations
 	// ============================================================================
@@ -327,6 +433,7 @@ type SmartThingsClient interface {
 	RemainingRequests() int
 	ShouldThrottle(threshold int) bool
 	WaitForRateLimit(ctx context.Context) error
+	WaitForRateLimitWithCap(ctx context.Context, maxWait time.Duration) error
 	WaitForRateLimitErr(ctx context.Context, err error) error
 
 	// ============================================================================
@@ -335,6 +442,7 @@ type SmartThingsClient interface {
 
 	InvalidateCache(resourceType string, ids ...string)
 	InvalidateCapabilityCache()
+	InvalidateDeviceStatus(deviceID string)
 
 	// ============================================================================
 	// Token Operations
@@ -342,6 +450,9 @@ type SmartThingsClient interface {
 
 	Token() string
 	SetToken(token string)
+	UserAgent() string
+	DryRun() bool
+	CircuitState() CircuitState
 
 	// ============================================================================
 	// Logging Operations
@@ -0,0 +1,157 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Color temperature limits (Kelvin) accepted by SetColorTemperature.
+const (
+	MinColorTemperature = 1000
+	MaxColorTemperature = 30000
+)
+
+// Light Control Helpers
+//
+// These helpers cover the standard SmartThings lighting capabilities
+// (switchLevel, colorControl, colorTemperature) used by dimmers, smart bulbs,
+// and light strips.
+
+// ExtractColorControl extracts color state from a device status.
+// Hue and saturation use the SmartThings 0-100 scale. Hex is computed from
+// hue and saturation at full brightness. Returns nil if the status has no
+// colorControl capability.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if color := smartthings.ExtractColorControl(status); color != nil {
+//	    fmt.Println(color.Hex) // "#FF0000"
+//	}
+func ExtractColorControl(status Status) *ColorControlStatus {
+	colorCap, ok := GetMap(status, "colorControl")
+	if !ok {
+		return nil
+	}
+
+	result := &ColorControlStatus{}
+
+	// Path: colorControl.hue.value
+	if hue, ok := GetFloat(colorCap, "hue", "value"); ok {
+		result.Hue = hue
+	}
+
+	// Path: colorControl.saturation.value
+	if saturation, ok := GetFloat(colorCap, "saturation", "value"); ok {
+		result.Saturation = saturation
+	}
+
+	if kelvin, ok := GetColorTemperature(status); ok {
+		result.ColorTemperature = &kelvin
+	}
+
+	result.Hex = HueSaturationToHex(result.Hue, result.Saturation)
+
+	return result
+}
+
+// HueSaturationToHex converts a SmartThings hue and saturation (both 0-100)
+// to an RGB hex string at full brightness. Out-of-range values are clamped.
+//
+// Example:
+//
+//	HueSaturationToHex(0, 100)  // "#FF0000"
+//	HueSaturationToHex(0, 0)    // "#FFFFFF"
+func HueSaturationToHex(hue, saturation float64) string {
+	h := math.Mod(clampPercent(hue)/100*360, 360)
+	s := clampPercent(saturation) / 100
+
+	// HSV to RGB with value fixed at 1
+	c := s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := 1 - c
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	toByte := func(v float64) int {
+		return int(math.Round((v + m) * 255))
+	}
+	return fmt.Sprintf("#%02X%02X%02X", toByte(r), toByte(g), toByte(b))
+}
+
+// clampPercent clamps a value to the 0-100 range, treating NaN as 0.
+func clampPercent(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return max(0, min(v, 100))
+}
+
+// SetColor sets a light's color using SmartThings hue and saturation (both 0-100).
+// Values outside the range are clamped.
+func (c *Client) SetColor(ctx context.Context, deviceID string, hue, saturation float64) error {
+	color := map[string]any{
+		"hue":        clampPercent(hue),
+		"saturation": clampPercent(saturation),
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("colorControl", "setColor", color))
+}
+
+// GetColorTemperature extracts the color temperature in Kelvin from a device status.
+// Path: colorTemperature.colorTemperature.value
+func GetColorTemperature(status Status) (int, bool) {
+	return GetInt(status, "colorTemperature", "colorTemperature", "value")
+}
+
+// SetColorTemperature sets a light's color temperature in Kelvin.
+// Values are clamped to MinColorTemperature-MaxColorTemperature.
+func (c *Client) SetColorTemperature(ctx context.Context, deviceID string, kelvin int) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	kelvin = max(MinColorTemperature, min(kelvin, MaxColorTemperature))
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("colorTemperature", "setColorTemperature", kelvin))
+}
+
+// GetLevel extracts the dimmer level (0-100) from a device status.
+// Path: switchLevel.level.value
+func GetLevel(status Status) (int, bool) {
+	return GetInt(status, "switchLevel", "level", "value")
+}
+
+// SetLevel sets a dimmer's level (0-100). Values outside the range are clamped.
+func (c *Client) SetLevel(ctx context.Context, deviceID string, level int) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	level = max(0, min(level, 100))
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("switchLevel", "setLevel", level))
+}
+
+// SetLevelWithRate sets a dimmer's level (0-100) with a transition rate,
+// the optional second argument of setLevel, which most devices interpret as
+// the transition time in seconds. The level is clamped to 0-100 and a
+// negative rate is treated as 0. Devices that do not support transitions
+// ignore the rate.
+func (c *Client) SetLevelWithRate(ctx context.Context, deviceID string, level, rate int) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	level = max(0, min(level, 100))
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("switchLevel", "setLevel", level, max(0, rate)))
+}
@@ -0,0 +1,264 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractColorControl(t *testing.T) {
+	t.Run("hue and saturation", func(t *testing.T) {
+		status := Status{
+			"colorControl": map[string]any{
+				"hue":        map[string]any{"value": float64(0)},
+				"saturation": map[string]any{"value": 100},
+			},
+		}
+		color := ExtractColorControl(status)
+		if color == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if color.Hue != 0 {
+			t.Errorf("Hue = %v, want 0", color.Hue)
+		}
+		if color.Saturation != 100 {
+			t.Errorf("Saturation = %v, want 100", color.Saturation)
+		}
+		if color.Hex != "#FF0000" {
+			t.Errorf("Hex = %q, want %q", color.Hex, "#FF0000")
+		}
+		if color.ColorTemperature != nil {
+			t.Errorf("ColorTemperature = %v, want nil", *color.ColorTemperature)
+		}
+	})
+
+	t.Run("with color temperature", func(t *testing.T) {
+		status := Status{
+			"colorControl": map[string]any{
+				"hue":        map[string]any{"value": 33.333333},
+				"saturation": map[string]any{"value": float64(100)},
+			},
+			"colorTemperature": map[string]any{
+				"colorTemperature": map[string]any{"value": float64(2700), "unit": "K"},
+			},
+		}
+		color := ExtractColorControl(status)
+		if color == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if color.ColorTemperature == nil || *color.ColorTemperature != 2700 {
+			t.Errorf("ColorTemperature = %v, want 2700", color.ColorTemperature)
+		}
+		if color.Hex != "#00FF00" {
+			t.Errorf("Hex = %q, want %q", color.Hex, "#00FF00")
+		}
+	})
+
+	t.Run("no colorControl", func(t *testing.T) {
+		status := Status{
+			"switch": map[string]any{"switch": map[string]any{"value": "on"}},
+		}
+		if color := ExtractColorControl(status); color != nil {
+			t.Errorf("expected nil, got %+v", color)
+		}
+	})
+}
+
+func TestHueSaturationToHex(t *testing.T) {
+	tests := []struct {
+		name       string
+		hue        float64
+		saturation float64
+		want       string
+	}{
+		{"red", 0, 100, "#FF0000"},
+		{"blue", 66.666667, 100, "#0000FF"},
+		{"white", 50, 0, "#FFFFFF"},
+		{"full hue wraps to red", 100, 100, "#FF0000"},
+		{"half saturation", 0, 50, "#FF8080"},
+		{"clamped", -10, 150, "#FF0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HueSaturationToHex(tt.hue, tt.saturation); got != tt.want {
+				t.Errorf("HueSaturationToHex(%v, %v) = %q, want %q", tt.hue, tt.saturation, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_SetColor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req CommandRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		cmd := req.Commands[0]
+		if cmd.Capability != "colorControl" || cmd.Command != "setColor" {
+			t.Errorf("command = %s.%s, want colorControl.setColor", cmd.Capability, cmd.Command)
+		}
+		if len(cmd.Arguments) != 1 {
+			t.Fatalf("expected 1 argument, got %d", len(cmd.Arguments))
+		}
+		color, ok := cmd.Arguments[0].(map[string]any)
+		if !ok {
+			t.Fatalf("argument type = %T, want map", cmd.Arguments[0])
+		}
+		if color["hue"] != float64(25) {
+			t.Errorf("hue = %v, want 25", color["hue"])
+		}
+		if color["saturation"] != float64(100) {
+			t.Errorf("saturation = %v, want 100 (clamped)", color["saturation"])
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	if err := client.SetColor(context.Background(), "light-1", 25, 120); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.SetColor(context.Background(), "", 25, 50); err != ErrEmptyDeviceID {
+		t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+	}
+}
+
+func TestGetColorTemperature(t *testing.T) {
+	status := Status{
+		"colorTemperature": map[string]any{
+			"colorTemperature": map[string]any{"value": 4000},
+		},
+	}
+	kelvin, ok := GetColorTemperature(status)
+	if !ok || kelvin != 4000 {
+		t.Errorf("GetColorTemperature() = %d, %v; want 4000, true", kelvin, ok)
+	}
+
+	if _, ok := GetColorTemperature(Status{}); ok {
+		t.Error("expected false for missing colorTemperature")
+	}
+}
+
+func TestClient_SetColorTemperature(t *testing.T) {
+	tests := []struct {
+		name       string
+		kelvin     int
+		wantKelvin int
+	}{
+		{"normal", 2700, 2700},
+		{"min", 1000, 1000},
+		{"max", 30000, 30000},
+		{"below min clamped", 500, 1000},
+		{"above max clamped", 50000, 30000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != "colorTemperature" || cmd.Command != "setColorTemperature" {
+					t.Errorf("command = %s.%s, want colorTemperature.setColorTemperature", cmd.Capability, cmd.Command)
+				}
+				if len(cmd.Arguments) != 1 {
+					t.Fatalf("expected 1 argument, got %d", len(cmd.Arguments))
+				}
+				if kelvin := int(cmd.Arguments[0].(float64)); kelvin != tt.wantKelvin {
+					t.Errorf("kelvin = %d, want %d", kelvin, tt.wantKelvin)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := client.SetColorTemperature(context.Background(), "light-1", tt.kelvin); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.SetColorTemperature(context.Background(), "", 2700); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
+func TestGetLevel(t *testing.T) {
+	status := Status{
+		"switchLevel": map[string]any{
+			"level": map[string]any{"value": 75.0},
+		},
+	}
+	level, ok := GetLevel(status)
+	if !ok || level != 75 {
+		t.Errorf("GetLevel() = %d, %v; want 75, true", level, ok)
+	}
+
+	if _, ok := GetLevel(Status{}); ok {
+		t.Error("expected false for missing switchLevel")
+	}
+}
+
+func TestClient_SetLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    int
+		rate     int // -1 calls SetLevel
+		wantArgs []float64
+	}{
+		{"level", 40, -1, []float64{40}},
+		{"below min clamped", -10, -1, []float64{0}},
+		{"above max clamped", 150, -1, []float64{100}},
+		{"with rate", 60, 5, []float64{60, 5}},
+		{"with rate clamped", 120, -3, []float64{100, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != "switchLevel" || cmd.Command != "setLevel" {
+					t.Errorf("command = %s.%s, want switchLevel.setLevel", cmd.Capability, cmd.Command)
+				}
+				if len(cmd.Arguments) != len(tt.wantArgs) {
+					t.Fatalf("arguments = %v, want %v", cmd.Arguments, tt.wantArgs)
+				}
+				for i, want := range tt.wantArgs {
+					if got := cmd.Arguments[i].(float64); got != want {
+						t.Errorf("argument %d = %v, want %v", i, got, want)
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			var err error
+			if tt.rate == -1 {
+				err = client.SetLevel(context.Background(), "light-1", tt.level)
+			} else {
+				err = client.SetLevelWithRate(context.Background(), "light-1", tt.level, tt.rate)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.SetLevel(context.Background(), "", 50); err != ErrEmptyDeviceID {
+			t.Errorf("SetLevel: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetLevelWithRate(context.Background(), "", 50, 2); err != ErrEmptyDeviceID {
+			t.Errorf("SetLevelWithRate: expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
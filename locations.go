@@ -86,22 +86,38 @@ func (c *Client) GetLocation(ctx context.Context, locationID string) (*Location,
 }
 
 // CreateLocation creates a new location.
+// With a context from ContextWithIdempotencyKey, an existing location with the
+// same name is returned instead of creating a duplicate.
 func (c *Client) CreateLocation(ctx context.Context, location *LocationCreate) (*Location, error) {
 	if location == nil || location.Name == "" {
 		return nil, ErrEmptyLocationName
 	}
 
-	data, err := c.post(ctx, "/locations", location)
-	if err != nil {
-		return nil, err
+	find := func(ctx context.Context) (*Location, error) {
+		locations, err := c.ListLocations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range locations {
+			if locations[i].Name == location.Name {
+				return &locations[i], nil
+			}
+		}
+		return nil, nil
 	}
-
-	var created Location
-	if err := json.Unmarshal(data, &created); err != nil {
-		return nil, fmt.Errorf("failed to parse created location: %w (body: %s)", err, truncatePreview(data))
-	}
-
-	return &created, nil
+	return createIdempotent(ctx, find, func(ctx context.Context) (*Location, error) {
+		data, err := c.post(ctx, "/locations", location)
+		if err != nil {
+			return nil, err
+		}
+
+		var created Location
+		if err := json.Unmarshal(data, &created); err != nil {
+			return nil, fmt.Errorf("failed to parse created location: %w (body: %s)", err, truncatePreview(data))
+		}
+
+		return &created, nil
+	})
 }
 
 // UpdateLocation updates an existing location.
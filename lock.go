@@ -0,0 +1,74 @@
+package smartthings
+
+import (
+	"context"
+	"time"
+)
+
+// Lock Helpers
+//
+// These helpers cover the standard SmartThings lock capability and the
+// lockCodes capability used by keypad locks.
+
+// ExtractLockStatus extracts lock state from a device status.
+// LastChangeTime is set from the lock attribute's timestamp when present.
+// Returns nil if the status has no lock capability.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if lock := smartthings.ExtractLockStatus(status); lock != nil && !lock.Locked {
+//	    fmt.Println("front door is unlocked")
+//	}
+func ExtractLockStatus(status Status) *LockStatus {
+	lockCap, ok := GetMap(status, "lock")
+	if !ok {
+		return nil
+	}
+
+	result := &LockStatus{}
+
+	// Path: lock.lock.value
+	if state, ok := GetString(lockCap, "lock", "value"); ok {
+		result.State = state
+		result.Locked = state == "locked"
+	}
+
+	// Path: lock.lock.timestamp
+	if ts, ok := GetString(lockCap, "lock", "timestamp"); ok && ts != "" {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil {
+			result.LastChangeTime = &t
+		}
+	}
+
+	return result
+}
+
+// LockDevice locks a smart lock.
+func (c *Client) LockDevice(ctx context.Context, deviceID string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("lock", "lock"))
+}
+
+// UnlockDevice unlocks a smart lock.
+func (c *Client) UnlockDevice(ctx context.Context, deviceID string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("lock", "unlock"))
+}
+
+// UnlockWithTimeout unlocks a lockCodes-capable lock and has it relock
+// automatically after the given number of seconds. While unlocked, the lock
+// reports the "unlocked with timeout" state.
+func (c *Client) UnlockWithTimeout(ctx context.Context, deviceID string, seconds int) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if seconds <= 0 {
+		return ErrInvalidLockTimeout
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("lockCodes", "unlockWithTimeout", seconds))
+}
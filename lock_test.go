@@ -0,0 +1,147 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestExtractLockStatus(t *testing.T) {
+	t.Run("locked with timestamp", func(t *testing.T) {
+		status := Status{
+			"lock": map[string]any{
+				"lock": map[string]any{"value": "locked", "timestamp": "2025-01-15T10:30:00Z"},
+			},
+		}
+
+		result := ExtractLockStatus(status)
+		if result == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if !result.Locked {
+			t.Error("Locked = false, want true")
+		}
+		if result.State != "locked" {
+			t.Errorf("State = %q, want %q", result.State, "locked")
+		}
+		want := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+		if result.LastChangeTime == nil || !result.LastChangeTime.Equal(want) {
+			t.Errorf("LastChangeTime = %v, want %v", result.LastChangeTime, want)
+		}
+	})
+
+	t.Run("unlocked with timeout", func(t *testing.T) {
+		status := Status{
+			"lock": map[string]any{"lock": map[string]any{"value": "unlocked with timeout"}},
+		}
+
+		result := ExtractLockStatus(status)
+		if result.Locked {
+			t.Error("Locked = true, want false")
+		}
+		if result.LastChangeTime != nil {
+			t.Errorf("LastChangeTime = %v, want nil", result.LastChangeTime)
+		}
+	})
+
+	t.Run("invalid timestamp ignored", func(t *testing.T) {
+		status := Status{
+			"lock": map[string]any{"lock": map[string]any{"value": "locked", "timestamp": "yesterday"}},
+		}
+		if result := ExtractLockStatus(status); result.LastChangeTime != nil {
+			t.Errorf("LastChangeTime = %v, want nil", result.LastChangeTime)
+		}
+	})
+
+	t.Run("no lock capability", func(t *testing.T) {
+		status := Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}}
+		if result := ExtractLockStatus(status); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
+
+func TestClient_LockCommands(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(c *Client) error
+		wantCapability string
+		wantCommand    string
+		wantArgs       []any
+	}{
+		{
+			name:           "lock",
+			call:           func(c *Client) error { return c.LockDevice(context.Background(), "lock-1") },
+			wantCapability: "lock",
+			wantCommand:    "lock",
+		},
+		{
+			name:           "unlock",
+			call:           func(c *Client) error { return c.UnlockDevice(context.Background(), "lock-1") },
+			wantCapability: "lock",
+			wantCommand:    "unlock",
+		},
+		{
+			name:           "unlock with timeout",
+			call:           func(c *Client) error { return c.UnlockWithTimeout(context.Background(), "lock-1", 30) },
+			wantCapability: "lockCodes",
+			wantCommand:    "unlockWithTimeout",
+			wantArgs:       []any{float64(30)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/devices/lock-1/commands" {
+					t.Errorf("path = %q, want %q", r.URL.Path, "/devices/lock-1/commands")
+				}
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != tt.wantCapability || cmd.Command != tt.wantCommand {
+					t.Errorf("command = %s.%s, want %s.%s", cmd.Capability, cmd.Command, tt.wantCapability, tt.wantCommand)
+				}
+				if len(cmd.Arguments) != len(tt.wantArgs) {
+					t.Fatalf("arguments = %v, want %v", cmd.Arguments, tt.wantArgs)
+				}
+				for i, arg := range tt.wantArgs {
+					if cmd.Arguments[i] != arg {
+						t.Errorf("arguments[%d] = %v, want %v", i, cmd.Arguments[i], arg)
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := tt.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		ctx := context.Background()
+		if err := client.LockDevice(ctx, ""); err != ErrEmptyDeviceID {
+			t.Errorf("LockDevice: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.UnlockDevice(ctx, ""); err != ErrEmptyDeviceID {
+			t.Errorf("UnlockDevice: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.UnlockWithTimeout(ctx, "", 30); err != ErrEmptyDeviceID {
+			t.Errorf("UnlockWithTimeout: expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.UnlockWithTimeout(context.Background(), "lock-1", 0); err != ErrInvalidLockTimeout {
+			t.Errorf("expected ErrInvalidLockTimeout, got %v", err)
+		}
+	})
+}
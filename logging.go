@@ -27,6 +27,24 @@ func WithLogger(logger *slog.Logger) Option {
 	}
 }
 
+// WithSlog configures logger and wires it into the client, so that every
+// request, response, rate limit update, and device command is logged through
+// LogRequest, LogResponse, LogRateLimit, and LogDeviceCommand. Log records
+// carry method, path, status, duration_ms, device_id, capability, and
+// request_id (see WithRequestIDFromContext) attributes as applicable. Retries are logged once per attempt.
+// A nil logger disables logging.
+//
+// Example:
+//
+//	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+//	client, _ := st.NewClient("token", st.WithSlog(logger))
+func WithSlog(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+		c.logHooks = logger != nil
+	}
+}
+
 // LoggingTransport wraps an http.RoundTripper and logs requests/responses.
 type LoggingTransport struct {
 	Base   http.RoundTripper
@@ -91,10 +109,14 @@ func (c *Client) LogRequest(ctx context.Context, method, path string) {
 	if c.logger == nil {
 		return
 	}
-	c.logger.LogAttrs(ctx, slog.LevelDebug, "api_request",
+	attrs := []slog.Attr{
 		slog.String("method", method),
 		slog.String("path", path),
-	)
+	}
+	if id := c.requestID(ctx); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "api_request", attrs...)
 }
 
 // LogResponse logs an API response. This is the low-level logging method
@@ -117,8 +139,12 @@ func (c *Client) LogResponse(ctx context.Context, method, path string, statusCod
 		slog.String("path", path),
 		slog.Int("status", statusCode),
 		slog.Duration("duration", duration),
+		slog.Int64("duration_ms", duration.Milliseconds()),
 	}
 
+	if id := c.requestID(ctx); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
 	if err != nil {
 		attrs = append(attrs, slog.String("error", err.Error()))
 	}
@@ -126,6 +152,14 @@ func (c *Client) LogResponse(ctx context.Context, method, path string, statusCod
 	c.logger.LogAttrs(ctx, level, "api_response", attrs...)
 }
 
+// logResponse logs a completed HTTP attempt when WithSlog is configured.
+func (c *Client) logResponse(ctx context.Context, method, path string, statusCode int, start time.Time, err error) {
+	if !c.logHooks {
+		return
+	}
+	c.LogResponse(ctx, method, path, statusCode, time.Since(start), err)
+}
+
 // LogRateLimit logs rate limit information at info level.
 // Useful for monitoring API usage.
 func (c *Client) LogRateLimit(ctx context.Context, info RateLimitInfo) {
@@ -218,7 +252,6 @@ func NewLoggingClient(token string, logger *slog.Logger, opts ...Option) (*Clien
 	}
 
 	httpClient := &http.Client{
-		Timeout:   DefaultTimeout,
 		Transport: transport,
 	}
 
@@ -3,6 +3,7 @@ package smartthings
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -25,6 +26,82 @@ func TestWithLogger(t *testing.T) {
 	}
 }
 
+func TestWithSlog(t *testing.T) {
+	t.Run("logs requests, rate limits, and commands", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithSlog(logger))
+
+		if err := client.ExecuteCommand(context.Background(), "device-123", NewCommand("switch", "on")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		records := map[string]map[string]any{}
+		for line := range strings.Lines(buf.String()) {
+			var rec map[string]any
+			if err := json.Unmarshal([]byte(line), &rec); err != nil {
+				t.Fatalf("invalid log line %q: %v", line, err)
+			}
+			records[rec["msg"].(string)] = rec
+		}
+
+		if rec := records["api_request"]; rec == nil || rec["method"] != "POST" || rec["path"] != "/devices/device-123/commands" {
+			t.Errorf("api_request = %v", rec)
+		}
+		if rec := records["api_response"]; rec == nil || rec["status"] != float64(200) || rec["duration_ms"] == nil {
+			t.Errorf("api_response = %v", rec)
+		}
+		if rec := records["rate_limit"]; rec == nil || rec["remaining"] != float64(42) {
+			t.Errorf("rate_limit = %v", rec)
+		}
+		if rec := records["device_command"]; rec == nil || rec["device_id"] != "device-123" || rec["capability"] != "switch" {
+			t.Errorf("device_command = %v", rec)
+		}
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, nil))
+		client, _ := NewClient("token", WithBaseURL("http://127.0.0.1:0"), WithSlog(logger))
+
+		if _, err := client.ListLocations(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+		if !strings.Contains(buf.String(), "level=ERROR msg=api_response") {
+			t.Errorf("expected error-level api_response, got %q", buf.String())
+		}
+	})
+
+	t.Run("WithLogger alone does not log requests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithLogger(logger))
+		client.ListLocations(context.Background())
+
+		if buf.Len() != 0 {
+			t.Errorf("unexpected log output: %q", buf.String())
+		}
+	})
+
+	t.Run("nil logger", func(t *testing.T) {
+		client, _ := NewClient("token", WithSlog(nil))
+		if client.logHooks {
+			t.Error("nil logger should not enable logging")
+		}
+	})
+}
+
 func TestLoggingTransport(t *testing.T) {
 	t.Run("logs successful request", func(t *testing.T) {
 		var buf bytes.Buffer
@@ -0,0 +1,82 @@
+package smartthings
+
+import (
+	"slices"
+	"strings"
+)
+
+// MachineState is the normalized machine state of an appliance, as reported
+// by the machineState or operatingState attribute of its operating state
+// capability.
+type MachineState string
+
+const (
+	MachineStateRunning  MachineState = "running"  // "run" or "running"
+	MachineStatePaused   MachineState = "paused"   // "pause" or "paused"
+	MachineStateStopped  MachineState = "stopped"  // "stop" or "stopped"
+	MachineStateReady    MachineState = "ready"    // Powered on and waiting for a cycle
+	MachineStateIdle     MachineState = "idle"     // Not doing anything
+	MachineStateFinished MachineState = "finished" // A cycle has completed
+	MachineStateUnknown  MachineState = "unknown"  // Any other value
+)
+
+// ParseMachineState normalizes a machineState or operatingState value: the
+// short forms used by the standard operating state capabilities ("run",
+// "pause", "stop") and the long forms used by Samsung CE capabilities
+// ("running", "paused", "stopped") map to the same constant. Matching
+// ignores case and surrounding space. Unrecognized values return
+// MachineStateUnknown.
+func ParseMachineState(s string) MachineState {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "run", "running":
+		return MachineStateRunning
+	case "pause", "paused":
+		return MachineStatePaused
+	case "stop", "stopped":
+		return MachineStateStopped
+	case "ready":
+		return MachineStateReady
+	case "idle":
+		return MachineStateIdle
+	case "finished":
+		return MachineStateFinished
+	}
+	return MachineStateUnknown
+}
+
+// ExtractOperatingState returns the machine state of an appliance from the
+// first of its operating state capabilities (any capability whose name ends
+// in OperatingState, such as washerOperatingState or
+// samsungce.ovenOperatingState) that reports one, in capability name order.
+// The machineState attribute is preferred over operatingState. Returns false
+// if no operating state capability reports a state.
+//
+// Example:
+//
+//	if state, ok := smartthings.ExtractOperatingState(status); ok && state == smartthings.MachineStateRunning {
+//	    fmt.Println("cycle in progress")
+//	}
+func ExtractOperatingState(status Status) (MachineState, bool) {
+	opStates := FindOperatingStateCapabilities(status)
+	names := make([]string, 0, len(opStates))
+	for name := range opStates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if value, ok := machineStateValue(opStates[name]); ok {
+			return ParseMachineState(value), true
+		}
+	}
+	return "", false
+}
+
+// machineStateValue returns the raw machineState value of an operating state
+// capability, falling back to its operatingState value.
+func machineStateValue(opState map[string]any) (string, bool) {
+	if value, ok := GetString(opState, "machineState", "value"); ok {
+		return value, true
+	}
+	return GetString(opState, "operatingState", "value")
+}
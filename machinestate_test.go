@@ -0,0 +1,91 @@
+package smartthings
+
+import "testing"
+
+func TestParseMachineState(t *testing.T) {
+	tests := []struct {
+		in   string
+		want MachineState
+	}{
+		{"run", MachineStateRunning},
+		{"running", MachineStateRunning},
+		{" Running ", MachineStateRunning},
+		{"pause", MachineStatePaused},
+		{"paused", MachineStatePaused},
+		{"stop", MachineStateStopped},
+		{"stopped", MachineStateStopped},
+		{"ready", MachineStateReady},
+		{"idle", MachineStateIdle},
+		{"finished", MachineStateFinished},
+		{"", MachineStateUnknown},
+		{"descaling", MachineStateUnknown},
+	}
+	for _, tt := range tests {
+		if got := ParseMachineState(tt.in); got != tt.want {
+			t.Errorf("ParseMachineState(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestExtractOperatingState(t *testing.T) {
+	tests := []struct {
+		name   string
+		status Status
+		want   MachineState
+		wantOK bool
+	}{
+		{
+			name:   "standard machineState",
+			status: Status{"washerOperatingState": map[string]any{"machineState": map[string]any{"value": "run"}}},
+			want:   MachineStateRunning,
+			wantOK: true,
+		},
+		{
+			name:   "Samsung CE operatingState",
+			status: Status{"samsungce.dryerOperatingState": map[string]any{"operatingState": map[string]any{"value": "paused"}}},
+			want:   MachineStatePaused,
+			wantOK: true,
+		},
+		{
+			name: "machineState preferred over operatingState",
+			status: Status{"dishwasherOperatingState": map[string]any{
+				"machineState":   map[string]any{"value": "stop"},
+				"operatingState": map[string]any{"value": "running"},
+			}},
+			want:   MachineStateStopped,
+			wantOK: true,
+		},
+		{
+			name: "first capability by name that reports a state",
+			status: Status{
+				"ovenOperatingState":           map[string]any{"machineState": map[string]any{"value": "ready"}},
+				"custom.cooktopOperatingState": map[string]any{"cooktopOperatingState": map[string]any{"value": "run"}},
+				"samsungce.ovenOperatingState": map[string]any{"operatingState": map[string]any{"value": "running"}},
+			},
+			want:   MachineStateReady,
+			wantOK: true,
+		},
+		{
+			name:   "unrecognized value",
+			status: Status{"washerOperatingState": map[string]any{"machineState": map[string]any{"value": "descaling"}}},
+			want:   MachineStateUnknown,
+			wantOK: true,
+		},
+		{
+			name:   "no operating state capability",
+			status: Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}},
+		},
+		{
+			name:   "nil status",
+			status: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ExtractOperatingState(tt.status)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ExtractOperatingState() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package smartthings
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// Media Playback Helpers
+//
+// These helpers cover the standard SmartThings media capabilities
+// (mediaPlayback, audioTrackData, mediaTrackControl) used by speakers and
+// media players.
+
+// ExtractMediaPlaybackStatus extracts playback state and current track info
+// from a device status. Track data may be reported either as a nested object
+// or as a JSON-encoded string; both forms are handled. Returns nil if the
+// status has none of the media capabilities.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if media := smartthings.ExtractMediaPlaybackStatus(status); media != nil {
+//	    fmt.Printf("%s: %s - %s\n", media.PlaybackStatus, media.Artist, media.Title)
+//	}
+func ExtractMediaPlaybackStatus(status Status) *MediaPlaybackStatus {
+	_, hasPlayback := GetMap(status, "mediaPlayback")
+	_, hasTrack := GetMap(status, "audioTrackData")
+	_, hasControl := GetMap(status, "mediaTrackControl")
+	if !hasPlayback && !hasTrack && !hasControl {
+		return nil
+	}
+
+	result := &MediaPlaybackStatus{}
+
+	// Path: mediaPlayback.playbackStatus.value
+	if value, ok := GetString(status, "mediaPlayback", "playbackStatus", "value"); ok {
+		result.PlaybackStatus = value
+	}
+
+	// Path: mediaPlayback.supportedPlaybackCommands.value
+	result.SupportedPlaybackCommands = extractStrings(status, "mediaPlayback", "supportedPlaybackCommands", "value")
+
+	// Path: audioTrackData.audioTrackData.value (some devices use trackData)
+	for _, attr := range []string{"audioTrackData", "trackData"} {
+		if track := trackData(status, attr); track != nil {
+			result.Title, _ = GetString(track, "title")
+			result.Artist, _ = GetString(track, "artist")
+			result.Album, _ = GetString(track, "album")
+			break
+		}
+	}
+
+	// Path: mediaTrackControl.supportedTrackControlCommands.value
+	controls := extractStrings(status, "mediaTrackControl", "supportedTrackControlCommands", "value")
+	result.CanNextTrack = slices.Contains(controls, "nextTrack")
+	result.CanPreviousTrack = slices.Contains(controls, "previousTrack")
+
+	return result
+}
+
+// trackData returns the audioTrackData attribute value as a map, decoding it
+// from a JSON string if necessary. Returns nil if absent or unparseable.
+func trackData(status Status, attribute string) map[string]any {
+	if track, ok := GetMap(status, "audioTrackData", attribute, "value"); ok {
+		return track
+	}
+	raw, ok := GetString(status, "audioTrackData", attribute, "value")
+	if !ok || raw == "" {
+		return nil
+	}
+	var track map[string]any
+	if err := json.Unmarshal([]byte(raw), &track); err != nil {
+		return nil
+	}
+	return track
+}
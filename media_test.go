@@ -0,0 +1,89 @@
+package smartthings
+
+import "testing"
+
+func TestExtractMediaPlaybackStatus(t *testing.T) {
+	t.Run("nested track data", func(t *testing.T) {
+		status := Status{
+			"mediaPlayback": map[string]any{
+				"playbackStatus":            map[string]any{"value": "playing"},
+				"supportedPlaybackCommands": map[string]any{"value": []any{"play", "pause", "stop"}},
+			},
+			"audioTrackData": map[string]any{
+				"audioTrackData": map[string]any{"value": map[string]any{
+					"title": "Clair de Lune", "artist": "Debussy", "album": "Suite bergamasque",
+				}},
+			},
+			"mediaTrackControl": map[string]any{
+				"supportedTrackControlCommands": map[string]any{"value": []any{"nextTrack", "previousTrack"}},
+			},
+		}
+
+		result := ExtractMediaPlaybackStatus(status)
+		if result == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if result.PlaybackStatus != "playing" {
+			t.Errorf("PlaybackStatus = %q, want %q", result.PlaybackStatus, "playing")
+		}
+		if result.Title != "Clair de Lune" || result.Artist != "Debussy" || result.Album != "Suite bergamasque" {
+			t.Errorf("track = %q/%q/%q", result.Title, result.Artist, result.Album)
+		}
+		if len(result.SupportedPlaybackCommands) != 3 {
+			t.Errorf("SupportedPlaybackCommands = %v, want 3 commands", result.SupportedPlaybackCommands)
+		}
+		if !result.CanNextTrack || !result.CanPreviousTrack {
+			t.Errorf("CanNextTrack = %v, CanPreviousTrack = %v; want true, true", result.CanNextTrack, result.CanPreviousTrack)
+		}
+	})
+
+	t.Run("JSON string track data", func(t *testing.T) {
+		status := Status{
+			"audioTrackData": map[string]any{
+				"audioTrackData": map[string]any{"value": `{"title":"Song","artist":"Band","album":"Record"}`},
+			},
+		}
+
+		result := ExtractMediaPlaybackStatus(status)
+		if result.Title != "Song" || result.Artist != "Band" || result.Album != "Record" {
+			t.Errorf("track = %q/%q/%q, want Song/Band/Record", result.Title, result.Artist, result.Album)
+		}
+	})
+
+	t.Run("trackData attribute", func(t *testing.T) {
+		status := Status{
+			"audioTrackData": map[string]any{
+				"trackData": map[string]any{"value": map[string]any{"title": "Song"}},
+			},
+		}
+
+		if result := ExtractMediaPlaybackStatus(status); result.Title != "Song" {
+			t.Errorf("Title = %q, want %q", result.Title, "Song")
+		}
+	})
+
+	t.Run("malformed JSON string", func(t *testing.T) {
+		status := Status{
+			"mediaPlayback":  map[string]any{"playbackStatus": map[string]any{"value": "paused"}},
+			"audioTrackData": map[string]any{"audioTrackData": map[string]any{"value": "{not json"}},
+		}
+
+		result := ExtractMediaPlaybackStatus(status)
+		if result.Title != "" {
+			t.Errorf("Title = %q, want empty", result.Title)
+		}
+		if result.PlaybackStatus != "paused" {
+			t.Errorf("PlaybackStatus = %q, want %q", result.PlaybackStatus, "paused")
+		}
+		if result.CanNextTrack {
+			t.Error("CanNextTrack = true, want false")
+		}
+	})
+
+	t.Run("no media capabilities", func(t *testing.T) {
+		status := Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}}
+		if result := ExtractMediaPlaybackStatus(status); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
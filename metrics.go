@@ -0,0 +1,41 @@
+package smartthings
+
+import (
+	"strings"
+	"time"
+)
+
+// MetricsRecorder receives client metrics, e.g. to export them to Prometheus.
+// Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest is called once per HTTP attempt, including each retry.
+	// path excludes the query string but still contains resource IDs, so
+	// adapters with label cardinality limits should normalize it. code is 0
+	// if no response was received.
+	ObserveRequest(method, path string, code int, d time.Duration)
+
+	// SetRateLimitRemaining is called whenever a response carries an
+	// X-RateLimit-Remaining header.
+	SetRateLimitRemaining(remaining int)
+}
+
+// WithMetrics configures a recorder for request counts, latencies, and the
+// remaining rate limit. A nil recorder disables metrics.
+//
+// Example:
+//
+//	client, _ := st.NewClient("token", st.WithMetrics(promAdapter))
+func WithMetrics(m MetricsRecorder) Option {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// observeRequest reports a completed HTTP attempt to the metrics recorder.
+func (c *Client) observeRequest(method, path string, code int, start time.Time) {
+	if c.metrics == nil {
+		return
+	}
+	path, _, _ = strings.Cut(path, "?")
+	c.metrics.ObserveRequest(method, path, code, time.Since(start))
+}
@@ -0,0 +1,90 @@
+package smartthings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type observedRequest struct {
+	method, path string
+	code         int
+	d            time.Duration
+}
+
+type testMetrics struct {
+	mu        sync.Mutex
+	requests  []observedRequest
+	remaining []int
+}
+
+func (m *testMetrics) ObserveRequest(method, path string, code int, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, observedRequest{method, path, code, d})
+}
+
+func (m *testMetrics) SetRateLimitRemaining(remaining int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remaining = append(m.remaining, remaining)
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Run("observes requests and rate limit", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			if r.URL.Path == "/devices/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		m := &testMetrics{}
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithMetrics(m))
+
+		client.ListDevicesWithOptions(context.Background(), &ListDevicesOptions{Capability: []string{"switch"}})
+		client.GetDevice(context.Background(), "missing")
+
+		if len(m.requests) != 2 {
+			t.Fatalf("expected 2 observed requests, got %+v", m.requests)
+		}
+		if r := m.requests[0]; r.method != http.MethodGet || r.path != "/devices" || r.code != http.StatusOK || r.d <= 0 {
+			t.Errorf("unexpected first observation: %+v", r)
+		}
+		if r := m.requests[1]; r.path != "/devices/missing" || r.code != http.StatusNotFound {
+			t.Errorf("unexpected second observation: %+v", r)
+		}
+		if len(m.remaining) != 2 || m.remaining[0] != 42 {
+			t.Errorf("remaining = %v, want [42 42]", m.remaining)
+		}
+	})
+
+	t.Run("transport error reports code 0", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		m := &testMetrics{}
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithMetrics(m))
+		client.ListDevices(context.Background())
+
+		if len(m.requests) != 1 || m.requests[0].code != 0 {
+			t.Errorf("expected one observation with code 0, got %+v", m.requests)
+		}
+		if len(m.remaining) != 0 {
+			t.Errorf("expected no rate limit updates, got %v", m.remaining)
+		}
+	})
+
+	t.Run("nil disables metrics", func(t *testing.T) {
+		client, _ := NewClient("token", WithMetrics(nil))
+		if client.metrics != nil {
+			t.Error("expected metrics to be nil")
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // Mode represents a SmartThings location mode (e.g., "Home", "Away", "Night").
@@ -110,3 +111,53 @@ func (c *Client) SetCurrentMode(ctx context.Context, locationID, modeID string)
 
 	return &mode, nil
 }
+
+// SetModeForPresence sets a location's mode to homeMode if anyonePresent is
+// true and to awayMode otherwise, e.g. from a geofence or presence sensor
+// handler. Modes are matched by name or label, ignoring case. Both names are
+// checked, so a misspelled mode is reported whichever way presence goes;
+// an error wrapping ErrModeNotFound is returned if either is missing.
+//
+// Example:
+//
+//	present, _ := smartthings.ExtractPresenceSensor(status)
+//	err := client.SetModeForPresence(ctx, locationID, present, "Home", "Away")
+func (c *Client) SetModeForPresence(ctx context.Context, locationID string, anyonePresent bool, homeMode, awayMode string) error {
+	if locationID == "" {
+		return ErrEmptyLocationID
+	}
+	if homeMode == "" || awayMode == "" {
+		return ErrEmptyMode
+	}
+
+	modes, err := c.ListModes(ctx, locationID)
+	if err != nil {
+		return err
+	}
+
+	home, err := findMode(modes, homeMode)
+	if err != nil {
+		return err
+	}
+	away, err := findMode(modes, awayMode)
+	if err != nil {
+		return err
+	}
+
+	target := away
+	if anyonePresent {
+		target = home
+	}
+	_, err = c.SetCurrentMode(ctx, locationID, target.ID)
+	return err
+}
+
+// findMode returns the mode whose name or label matches name, ignoring case.
+func findMode(modes []Mode, name string) (*Mode, error) {
+	for i := range modes {
+		if strings.EqualFold(modes[i].Name, name) || strings.EqualFold(modes[i].Label, name) {
+			return &modes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrModeNotFound, name)
+}
@@ -3,8 +3,10 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -279,3 +281,81 @@ func TestClient_SetCurrentMode(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_SetModeForPresence(t *testing.T) {
+	newServer := func(t *testing.T, setModeID *string) *httptest.Server {
+		t.Helper()
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/locations/loc-1/modes":
+				w.Write([]byte(`{"items": [
+					{"id": "mode-home", "name": "Home"},
+					{"id": "mode-away", "name": "Away"},
+					{"id": "mode-vacation", "name": "Custom1", "label": "Vacation"}
+				]}`))
+			case r.Method == http.MethodPut && r.URL.Path == "/locations/loc-1/modes/current":
+				var req setCurrentModeRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				*setModeID = req.ModeID
+				w.Write([]byte(`{"id": "` + req.ModeID + `"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	tests := []struct {
+		name     string
+		present  bool
+		home     string
+		away     string
+		wantMode string
+	}{
+		{"someone present", true, "Home", "Away", "mode-home"},
+		{"nobody present", false, "Home", "Away", "mode-away"},
+		{"case-insensitive names", true, "home", "AWAY", "mode-home"},
+		{"matches labels", false, "Home", "vacation", "mode-vacation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var setModeID string
+			server := newServer(t, &setModeID)
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := client.SetModeForPresence(context.Background(), "loc-1", tt.present, tt.home, tt.away); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if setModeID != tt.wantMode {
+				t.Errorf("set mode %q, want %q", setModeID, tt.wantMode)
+			}
+		})
+	}
+
+	t.Run("unknown mode", func(t *testing.T) {
+		var setModeID string
+		server := newServer(t, &setModeID)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		// The away mode is misspelled; it is reported even though it is not selected.
+		err := client.SetModeForPresence(context.Background(), "loc-1", true, "Home", "Awya")
+		if !errors.Is(err, ErrModeNotFound) || !strings.Contains(err.Error(), "Awya") {
+			t.Errorf("expected ErrModeNotFound naming the mode, got %v", err)
+		}
+		if setModeID != "" {
+			t.Errorf("mode should not be set, got %q", setModeID)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.SetModeForPresence(context.Background(), "", true, "Home", "Away"); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+		if err := client.SetModeForPresence(context.Background(), "loc-1", true, "Home", ""); err != ErrEmptyMode {
+			t.Errorf("expected ErrEmptyMode, got %v", err)
+		}
+	})
+}
@@ -3,7 +3,10 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 )
 
 // NotificationRequestType represents the type of notification.
@@ -90,3 +93,135 @@ func (c *Client) CreateNotification(ctx context.Context, req *NotificationReques
 
 	return &resp, nil
 }
+
+// defaultNotificationLocale is the locale SendNotification writes its message under.
+const defaultNotificationLocale = "en"
+
+// SendNotification sends an alert with a title and message to mobile apps
+// at a location. Use NewNotification for other types, locales, template
+// replacements, deep links, or images.
+//
+// Example:
+//
+//	_, err := client.SendNotification(ctx, locationID, "Washer Complete", "Your laundry is done!")
+func (c *Client) SendNotification(ctx context.Context, locationID, title, message string) (*NotificationResponse, error) {
+	return NewNotification(locationID).
+		Message(defaultNotificationLocale, title, message).
+		Send(ctx, c)
+}
+
+// NotificationBuilder builds a NotificationRequest, checking required fields
+// before it is sent. Create one with NewNotification.
+type NotificationBuilder struct {
+	req NotificationRequest
+}
+
+// NewNotification starts an alert notification for a location.
+//
+// Example:
+//
+//	resp, err := smartthings.NewNotification(locationID).
+//	    Type(smartthings.NotificationTypeSuggestedAction).
+//	    Message("en", "Door Open", "{{device}} has been open for {{minutes}} minutes").
+//	    Replace("{{device}}", "Front Door").
+//	    Replace("{{minutes}}", "10").
+//	    DeepLink(smartthings.DeepLinkDevice, deviceID).
+//	    Send(ctx, client)
+func NewNotification(locationID string) *NotificationBuilder {
+	return &NotificationBuilder{req: NotificationRequest{
+		LocationID: locationID,
+		Type:       NotificationTypeAlert,
+	}}
+}
+
+// Type sets the notification type. The default is NotificationTypeAlert.
+func (b *NotificationBuilder) Type(t NotificationRequestType) *NotificationBuilder {
+	b.req.Type = t
+	return b
+}
+
+// Message sets the title and body shown to users of a locale (e.g. "en",
+// "ko"), replacing any earlier message for that locale.
+func (b *NotificationBuilder) Message(locale, title, body string) *NotificationBuilder {
+	if b.req.Messages == nil {
+		b.req.Messages = make(map[string]NotificationMessage)
+	}
+	b.req.Messages[locale] = NotificationMessage{Title: title, Body: body}
+	return b
+}
+
+// Replace adds a template replacement: occurrences of key in the messages
+// are replaced by value when the notification is delivered.
+func (b *NotificationBuilder) Replace(key, value string) *NotificationBuilder {
+	b.req.Replacements = append(b.req.Replacements, NotificationReplacement{Key: key, Value: value})
+	return b
+}
+
+// DeepLink sets what opens when the notification is tapped.
+func (b *NotificationBuilder) DeepLink(linkType DeepLinkType, id string) *NotificationBuilder {
+	b.req.DeepLink = &NotificationDeepLink{Type: linkType, ID: id}
+	return b
+}
+
+// Image sets an image shown with the notification.
+func (b *NotificationBuilder) Image(url string) *NotificationBuilder {
+	b.req.ImageURL = url
+	return b
+}
+
+// Build validates the notification and returns the request. It requires a
+// location ID, a type, and at least one message, every message needs a title
+// or body and a non-empty locale, every replacement needs a key, and a deep
+// link needs a type and ID. The returned request is a copy that later
+// builder calls do not change.
+func (b *NotificationBuilder) Build() (*NotificationRequest, error) {
+	if b.req.LocationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+	if b.req.Type == "" {
+		return nil, ErrEmptyNotificationType
+	}
+	if len(b.req.Messages) == 0 {
+		return nil, ErrEmptyNotificationMessages
+	}
+
+	var errs []error
+	for locale, msg := range b.req.Messages {
+		if locale == "" {
+			errs = append(errs, fmt.Errorf("%w: message locale is empty", ErrInvalidNotification))
+		}
+		if msg.Title == "" && msg.Body == "" {
+			errs = append(errs, fmt.Errorf("%w: message for locale %q has no title or body", ErrInvalidNotification, locale))
+		}
+	}
+	for i, r := range b.req.Replacements {
+		if r.Key == "" {
+			errs = append(errs, fmt.Errorf("%w: replacement %d has an empty key", ErrInvalidNotification, i))
+		}
+	}
+	if link := b.req.DeepLink; link != nil && (link.Type == "" || link.ID == "") {
+		errs = append(errs, fmt.Errorf("%w: deep link needs a type and ID", ErrInvalidNotification))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	req := b.req
+	req.Messages = maps.Clone(b.req.Messages)
+	req.Replacements = slices.Clone(b.req.Replacements)
+	if b.req.DeepLink != nil {
+		link := *b.req.DeepLink
+		req.DeepLink = &link
+	}
+	return &req, nil
+}
+
+// Send validates the notification with Build and sends it with
+// CreateNotification.
+func (b *NotificationBuilder) Send(ctx context.Context, client SmartThingsClient) (*NotificationResponse, error) {
+	req, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateNotification(ctx, req)
+}
@@ -2,6 +2,8 @@ package smartthings
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -104,3 +106,95 @@ func TestClient_CreateNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SendNotification(t *testing.T) {
+	var got NotificationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/notifications" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Write([]byte(`{"code": 200, "message": "Success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	resp, err := client.SendNotification(context.Background(), "loc-1", "Washer Complete", "Your laundry is done!")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Code != 200 {
+		t.Errorf("Code = %d, want 200", resp.Code)
+	}
+	want := NotificationMessage{Title: "Washer Complete", Body: "Your laundry is done!"}
+	if got.LocationID != "loc-1" || got.Type != NotificationTypeAlert || len(got.Messages) != 1 || got.Messages["en"] != want {
+		t.Errorf("request = %+v", got)
+	}
+
+	t.Run("validates before sending", func(t *testing.T) {
+		client, _ := NewClient("token", WithBaseURL("http://invalid.invalid"))
+		if _, err := client.SendNotification(context.Background(), "", "title", "body"); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+		if _, err := client.SendNotification(context.Background(), "loc-1", "", ""); !errors.Is(err, ErrInvalidNotification) {
+			t.Errorf("expected ErrInvalidNotification, got %v", err)
+		}
+	})
+}
+
+func TestNotificationBuilder(t *testing.T) {
+	t.Run("builds all fields", func(t *testing.T) {
+		b := NewNotification("loc-1").
+			Type(NotificationTypeSuggestedAction).
+			Message("en", "Door Open", "{{device}} is open").
+			Message("ko", "", "{{device}} 열림").
+			Replace("{{device}}", "Front Door").
+			DeepLink(DeepLinkDevice, "dev-1").
+			Image("https://example.com/door.png")
+		req, err := b.Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if req.LocationID != "loc-1" || req.Type != NotificationTypeSuggestedAction || len(req.Messages) != 2 {
+			t.Errorf("request = %+v", req)
+		}
+		if len(req.Replacements) != 1 || req.Replacements[0] != (NotificationReplacement{Key: "{{device}}", Value: "Front Door"}) {
+			t.Errorf("Replacements = %+v", req.Replacements)
+		}
+		if req.DeepLink == nil || *req.DeepLink != (NotificationDeepLink{Type: DeepLinkDevice, ID: "dev-1"}) {
+			t.Errorf("DeepLink = %+v", req.DeepLink)
+		}
+		if req.ImageURL != "https://example.com/door.png" {
+			t.Errorf("ImageURL = %q", req.ImageURL)
+		}
+
+		b.Message("en", "Changed", "").Replace("x", "y")
+		if req.Messages["en"].Title != "Door Open" || len(req.Replacements) != 1 {
+			t.Error("builder calls after Build changed the returned request")
+		}
+	})
+
+	tests := []struct {
+		name    string
+		builder *NotificationBuilder
+		wantErr error
+	}{
+		{"empty location", NewNotification("").Message("en", "t", "b"), ErrEmptyLocationID},
+		{"empty type", NewNotification("loc-1").Type("").Message("en", "t", "b"), ErrEmptyNotificationType},
+		{"no messages", NewNotification("loc-1"), ErrEmptyNotificationMessages},
+		{"empty message", NewNotification("loc-1").Message("en", "", ""), ErrInvalidNotification},
+		{"empty locale", NewNotification("loc-1").Message("", "t", "b"), ErrInvalidNotification},
+		{"empty replacement key", NewNotification("loc-1").Message("en", "t", "b").Replace("", "v"), ErrInvalidNotification},
+		{"deep link without ID", NewNotification("loc-1").Message("en", "t", "b").DeepLink(DeepLinkDevice, ""), ErrInvalidNotification},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.builder.Build(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Build() error = %v, want %v", err, tt.wantErr)
+			}
+			if _, err := tt.builder.Send(context.Background(), nil); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Send() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
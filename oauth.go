@@ -2,6 +2,9 @@ package smartthings
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,6 +39,47 @@ func DefaultScopes() []string {
 	}
 }
 
+// ValidateScopes returns the required scopes that granted does not cover, in
+// the order given. A granted scope covers a required scope when every
+// colon-separated part is equal or the granted part is "*", so "r:devices:*"
+// covers "r:devices:abc" and "r:devices:*". Returns nil if all are covered.
+//
+// Example:
+//
+//	if missing := smartthings.ValidateScopes(oauthClient.GrantedScopes(), []string{"x:devices:*"}); len(missing) > 0 {
+//	    return fmt.Errorf("re-authorize with scopes %v", missing)
+//	}
+func ValidateScopes(granted, required []string) (missing []string) {
+	for _, req := range required {
+		covered := false
+		for _, g := range granted {
+			if scopeCovers(g, req) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			missing = append(missing, req)
+		}
+	}
+	return missing
+}
+
+// scopeCovers reports whether the granted scope pattern covers the required scope.
+func scopeCovers(granted, required string) bool {
+	gParts := strings.Split(granted, ":")
+	rParts := strings.Split(required, ":")
+	if len(gParts) != len(rParts) {
+		return false
+	}
+	for i, part := range gParts {
+		if part != "*" && part != rParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // OAuthConfig holds the configuration for OAuth authentication
 type OAuthConfig struct {
 	ClientID     string
@@ -110,8 +154,59 @@ func GetAuthorizationURL(cfg *OAuthConfig, state string) string {
 	return authorizationEndpoint + "?" + params.Encode()
 }
 
+// GetAuthorizationURLWithPKCE returns an authorization URL using PKCE (RFC 7636)
+// with the S256 challenge method, along with the code verifier. Keep the
+// verifier (e.g. in the user's session) and pass it to ExchangeCodeWithPKCE.
+func GetAuthorizationURLWithPKCE(cfg *OAuthConfig, state string) (authURL, verifier string) {
+	verifier = newPKCEVerifier()
+	challenge := sha256.Sum256([]byte(verifier))
+
+	params := url.Values{}
+	params.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	params.Set("code_challenge_method", "S256")
+
+	return GetAuthorizationURL(cfg, state) + "&" + params.Encode(), verifier
+}
+
+// newPKCEVerifier returns a random 43-character PKCE code verifier.
+func newPKCEVerifier() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ExchangeCodeWithPKCE exchanges an authorization code obtained via
+// GetAuthorizationURLWithPKCE, sending the code verifier. The client secret is
+// also sent if cfg has one; public clients may leave it empty.
+func ExchangeCodeWithPKCE(ctx context.Context, cfg *OAuthConfig, code, verifier string) (*TokenResponse, error) {
+	return exchangeCodeWithPKCE(ctx, cfg, code, verifier, DefaultUserAgent)
+}
+
+// exchangeCodeWithPKCE exchanges a PKCE authorization code, sending the given User-Agent.
+func exchangeCodeWithPKCE(ctx context.Context, cfg *OAuthConfig, code, verifier, userAgent string) (*TokenResponse, error) {
+	if code == "" {
+		return nil, fmt.Errorf("authorization code is required")
+	}
+	if verifier == "" {
+		return nil, fmt.Errorf("code verifier is required")
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("redirect_uri", cfg.RedirectURL)
+	data.Set("code", code)
+	data.Set("code_verifier", verifier)
+
+	return doTokenRequestWithAuth(ctx, cfg.ClientID, cfg.ClientSecret, userAgent, data)
+}
+
 // ExchangeCode exchanges an authorization code for access and refresh tokens
 func ExchangeCode(ctx context.Context, cfg *OAuthConfig, code string) (*TokenResponse, error) {
+	return exchangeCode(ctx, cfg, code, DefaultUserAgent)
+}
+
+// exchangeCode exchanges an authorization code, sending the given User-Agent.
+func exchangeCode(ctx context.Context, cfg *OAuthConfig, code, userAgent string) (*TokenResponse, error) {
 	if code == "" {
 		return nil, fmt.Errorf("authorization code is required")
 	}
@@ -121,11 +216,16 @@ func ExchangeCode(ctx context.Context, cfg *OAuthConfig, code string) (*TokenRes
 	data.Set("redirect_uri", cfg.RedirectURL)
 	data.Set("code", code)
 
-	return doTokenRequestWithAuth(ctx, cfg.ClientID, cfg.ClientSecret, data)
+	return doTokenRequestWithAuth(ctx, cfg.ClientID, cfg.ClientSecret, userAgent, data)
 }
 
 // RefreshTokens refreshes the access token using a refresh token
 func RefreshTokens(ctx context.Context, cfg *OAuthConfig, refreshToken string) (*TokenResponse, error) {
+	return refreshTokens(ctx, cfg, refreshToken, DefaultUserAgent)
+}
+
+// refreshTokens refreshes the access token, sending the given User-Agent.
+func refreshTokens(ctx context.Context, cfg *OAuthConfig, refreshToken, userAgent string) (*TokenResponse, error) {
 	if refreshToken == "" {
 		return nil, fmt.Errorf("refresh token is required")
 	}
@@ -134,14 +234,17 @@ func RefreshTokens(ctx context.Context, cfg *OAuthConfig, refreshToken string) (
 	data.Set("grant_type", "refresh_token")
 	data.Set("refresh_token", refreshToken)
 
-	return doTokenRequestWithAuth(ctx, cfg.ClientID, cfg.ClientSecret, data)
+	return doTokenRequestWithAuth(ctx, cfg.ClientID, cfg.ClientSecret, userAgent, data)
 }
 
-// doTokenRequestWithAuth performs a token request using HTTP Basic Auth
-func doTokenRequestWithAuth(ctx context.Context, clientID, clientSecret string, data url.Values) (*TokenResponse, error) {
+// doTokenRequestWithAuth performs a token request using HTTP Basic Auth.
+// Public (PKCE) clients without a secret send only their client ID.
+func doTokenRequestWithAuth(ctx context.Context, clientID, clientSecret, userAgent string, data url.Values) (*TokenResponse, error) {
 	// Include credentials in body (required by SmartThings)
 	data.Set("client_id", clientID)
-	data.Set("client_secret", clientSecret)
+	if clientSecret != "" {
+		data.Set("client_secret", clientSecret)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(data.Encode()))
 	if err != nil {
@@ -150,7 +253,10 @@ func doTokenRequestWithAuth(ctx context.Context, clientID, clientSecret string,
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Accept", "application/json")
-	req.SetBasicAuth(clientID, clientSecret)
+	req.Header.Set("User-Agent", userAgent)
+	if clientSecret != "" {
+		req.SetBasicAuth(clientID, clientSecret)
+	}
 
 	client := &http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Do(req)
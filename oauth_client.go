@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
@@ -15,9 +16,17 @@ type OAuthClient struct {
 	config     *OAuthConfig
 	tokenStore TokenStore
 	tokens     *TokenResponse
+	refreshing *tokenRefresh // in-flight refresh, guarded by mu
 	mu         sync.RWMutex
 }
 
+// tokenRefresh is a token refresh shared by concurrent callers. err is set
+// before done is closed.
+type tokenRefresh struct {
+	done chan struct{}
+	err  error
+}
+
 // NewOAuthClient creates a new OAuth-enabled SmartThings client.
 // It attempts to load existing tokens from the store.
 // If no tokens are available, the client will be created but API calls will fail
@@ -60,7 +69,6 @@ func NewOAuthClient(cfg *OAuthConfig, store TokenStore, opts ...Option) (*OAuthC
 	}
 
 	httpClient := &http.Client{
-		Timeout:   DefaultTimeout,
 		Transport: transport,
 	}
 
@@ -69,6 +77,7 @@ func NewOAuthClient(cfg *OAuthConfig, store TokenStore, opts ...Option) (*OAuthC
 		baseURL:    DefaultBaseURL,
 		token:      "",
 		httpClient: httpClient,
+		timeout:    DefaultTimeout,
 	}
 
 	// Apply options
@@ -76,6 +85,12 @@ func NewOAuthClient(cfg *OAuthConfig, store TokenStore, opts ...Option) (*OAuthC
 		opt(client)
 	}
 
+	baseURL, err := normalizeBaseURL(client.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	client.baseURL = baseURL
+
 	// If options replaced the http client, wrap its transport
 	if client.httpClient != httpClient {
 		transport.base = client.httpClient.Transport
@@ -121,30 +136,99 @@ func (t *tokenRefreshTransport) RoundTrip(req *http.Request) (*http.Response, er
 	return t.base.RoundTrip(req)
 }
 
+// WithTokenRefreshCallback registers fn to be called with a copy of the new
+// tokens each time an OAuthClient refreshes its access token, e.g. to mirror
+// them to a secondary secrets store. It only applies to clients created with
+// NewOAuthClient and does not fire for tokens set via SetTokens or ExchangeCode.
+//
+// The callback runs after the refreshed tokens have been passed to the
+// TokenStore's SaveTokens (whether or not saving succeeded), outside the
+// client's token lock, on the goroutine performing the refresh. Requests
+// waiting for the refresh resume once it returns, so it should return
+// promptly.
+func WithTokenRefreshCallback(fn func(*TokenResponse)) Option {
+	return func(c *Client) {
+		c.tokenRefreshCallback = fn
+	}
+}
+
 // ensureValidTokenInternal checks if the access token is valid and refreshes if needed.
 // This is the internal version that doesn't acquire a read lock first.
 func (c *OAuthClient) ensureValidTokenInternal(ctx context.Context) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	return c.refreshIfNeeded(ctx)
+}
+
+// tokenRefreshTimeout bounds a shared token refresh, which does not end when
+// the context of the request that started it does.
+const tokenRefreshTimeout = 30 * time.Second
+
+// refreshIfNeeded refreshes and persists the tokens if the access token has
+// expired. Concurrent callers that find a refresh in flight wait for it and
+// share its result instead of sending their own token request. The refresh
+// runs on its own goroutine, detached from the cancellation of the caller
+// that started it, so every caller, the first included, waits only as long
+// as its own context allows.
+func (c *OAuthClient) refreshIfNeeded(ctx context.Context) error {
+	// Fast path: most requests find a valid token.
+	c.mu.RLock()
+	valid := c.tokens != nil && c.tokens.IsValid()
+	c.mu.RUnlock()
+	if valid {
+		return nil
+	}
 
+	c.mu.Lock()
 	if c.tokens == nil {
+		c.mu.Unlock()
 		return fmt.Errorf("no tokens available - OAuth authentication required")
 	}
 
-	// Token is still valid
+	// Token is still valid (refreshed while waiting for the lock)
 	if c.tokens.IsValid() {
+		c.mu.Unlock()
 		return nil
 	}
 
-	// Check if refresh token is still valid
-	if !c.tokens.IsRefreshTokenValid() {
-		return fmt.Errorf("refresh token expired - OAuth re-authentication required")
+	call := c.refreshing
+	if call == nil {
+		// Check if refresh token is still valid
+		if !c.tokens.IsRefreshTokenValid() {
+			c.mu.Unlock()
+			return fmt.Errorf("refresh token expired - OAuth re-authentication required")
+		}
+
+		call = &tokenRefresh{done: make(chan struct{})}
+		c.refreshing = call
+		go c.refresh(context.WithoutCancel(ctx), call, c.tokens.RefreshToken)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
+
+// refresh performs the token request for call, stores and persists the new
+// tokens, calls the token refresh callback, and then releases the waiters.
+func (c *OAuthClient) refresh(ctx context.Context, call *tokenRefresh, refreshToken string) {
+	defer close(call.done)
 
-	// Refresh the token
-	newTokens, err := RefreshTokens(ctx, c.config, c.tokens.RefreshToken)
+	ctx, cancel := context.WithTimeout(ctx, tokenRefreshTimeout)
+	defer cancel()
+
+	// Refresh the token without holding the lock, so GetTokens and
+	// requests with a valid token are not blocked by the token request.
+	newTokens, err := refreshTokens(ctx, c.config, refreshToken, c.Client.UserAgent())
+
+	c.mu.Lock()
+	c.refreshing = nil
 	if err != nil {
-		return fmt.Errorf("failed to refresh token: %w", err)
+		call.err = fmt.Errorf("failed to refresh token: %w", err)
+		c.mu.Unlock()
+		return
 	}
 
 	// Update tokens
@@ -153,8 +237,12 @@ func (c *OAuthClient) ensureValidTokenInternal(ctx context.Context) error {
 
 	// Persist the new tokens (ignore errors - we have valid tokens in memory)
 	_ = c.tokenStore.SaveTokens(ctx, newTokens)
+	c.mu.Unlock()
 
-	return nil
+	if c.Client.tokenRefreshCallback != nil {
+		tokensCopy := *newTokens
+		c.Client.tokenRefreshCallback(&tokensCopy)
+	}
 }
 
 // EnsureValidToken checks if the access token is valid and refreshes if needed.
@@ -193,6 +281,19 @@ func (c *OAuthClient) GetTokens() *TokenResponse {
 	return &tokensCopy
 }
 
+// GrantedScopes returns the scopes granted with the current tokens, parsed
+// from the token response's space-separated scope field. Returns nil if no
+// tokens are set or the token response did not include scopes.
+func (c *OAuthClient) GrantedScopes() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tokens == nil {
+		return nil
+	}
+	return strings.Fields(c.tokens.Scope)
+}
+
 // IsAuthenticated returns true if valid tokens are available.
 func (c *OAuthClient) IsAuthenticated() bool {
 	c.mu.RLock()
@@ -218,9 +319,25 @@ func (c *OAuthClient) GetAuthorizationURL(state string) string {
 	return GetAuthorizationURL(c.config, state)
 }
 
+// GetAuthorizationURLWithPKCE returns a PKCE authorization URL and the code
+// verifier to pass to ExchangeCodeWithPKCE.
+func (c *OAuthClient) GetAuthorizationURLWithPKCE(state string) (authURL, verifier string) {
+	return GetAuthorizationURLWithPKCE(c.config, state)
+}
+
+// ExchangeCodeWithPKCE exchanges a PKCE authorization code for tokens.
+func (c *OAuthClient) ExchangeCodeWithPKCE(ctx context.Context, code, verifier string) error {
+	tokens, err := exchangeCodeWithPKCE(ctx, c.config, code, verifier, c.Client.UserAgent())
+	if err != nil {
+		return fmt.Errorf("ExchangeCodeWithPKCE: %w", err)
+	}
+
+	return c.SetTokens(ctx, tokens)
+}
+
 // ExchangeCode exchanges an authorization code for tokens.
 func (c *OAuthClient) ExchangeCode(ctx context.Context, code string) error {
-	tokens, err := ExchangeCode(ctx, c.config, code)
+	tokens, err := exchangeCode(ctx, c.config, code, c.Client.UserAgent())
 	if err != nil {
 		return fmt.Errorf("ExchangeCode: %w", err)
 	}
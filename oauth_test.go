@@ -2,11 +2,19 @@ package smartthings
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -673,6 +681,16 @@ func TestNewOAuthClient(t *testing.T) {
 		}
 	})
 
+	t.Run("rejects invalid base URL", func(t *testing.T) {
+		_, err := NewOAuthClient(&OAuthConfig{
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}, NewMemoryTokenStore(), WithBaseURL("api.smartthings.com"))
+		if !errors.Is(err, ErrInvalidBaseURL) {
+			t.Errorf("expected ErrInvalidBaseURL, got %v", err)
+		}
+	})
+
 	t.Run("creates client successfully", func(t *testing.T) {
 		client, err := NewOAuthClient(&OAuthConfig{
 			ClientID:     "test-id",
@@ -1057,6 +1075,204 @@ func TestOAuthClient_EnsureValidToken(t *testing.T) {
 	})
 }
 
+func TestOAuthClient_ConcurrentRefresh(t *testing.T) {
+	expired := func() *TokenResponse {
+		return &TokenResponse{
+			AccessToken:           "expired-access-token",
+			RefreshToken:          "valid-refresh-token",
+			ExpiresAt:             time.Now().Add(-time.Hour),
+			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+		}
+	}
+
+	t.Run("coalesces concurrent refreshes", func(t *testing.T) {
+		var refreshes atomic.Int32
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			refreshes.Add(1)
+			time.Sleep(50 * time.Millisecond) // keep the refresh in flight while others arrive
+			json.NewEncoder(w).Encode(map[string]any{
+				"access_token":  "new-access-token",
+				"refresh_token": "new-refresh-token",
+				"expires_in":    3600,
+			})
+		}))
+		defer tokenServer.Close()
+
+		var staleAuth atomic.Int32
+		apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer new-access-token" {
+				staleAuth.Add(1)
+			}
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer apiServer.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = tokenServer.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		var callbacks atomic.Int32
+		client, _ := NewOAuthClient(&OAuthConfig{
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}, NewMemoryTokenStore(), WithBaseURL(apiServer.URL),
+			WithTokenRefreshCallback(func(*TokenResponse) { callbacks.Add(1) }))
+		client.SetTokens(context.Background(), expired())
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 50)
+		for range 50 {
+			wg.Go(func() {
+				if _, err := client.ListDevices(context.Background()); err != nil {
+					errs <- err
+				}
+			})
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if refreshes.Load() != 1 {
+			t.Errorf("expected exactly 1 refresh, got %d", refreshes.Load())
+		}
+		if callbacks.Load() != 1 {
+			t.Errorf("expected refresh callback once, got %d", callbacks.Load())
+		}
+		if staleAuth.Load() != 0 {
+			t.Errorf("%d requests used a stale token", staleAuth.Load())
+		}
+	})
+
+	t.Run("shares refresh failure", func(t *testing.T) {
+		var refreshes atomic.Int32
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			refreshes.Add(1)
+			time.Sleep(50 * time.Millisecond)
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+		}))
+		defer tokenServer.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = tokenServer.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		client, _ := NewOAuthClient(&OAuthConfig{
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}, NewMemoryTokenStore())
+		client.SetTokens(context.Background(), expired())
+
+		var wg sync.WaitGroup
+		var failures atomic.Int32
+		for range 10 {
+			wg.Go(func() {
+				if err := client.EnsureValidToken(context.Background()); err != nil && strings.Contains(err.Error(), "invalid_grant") {
+					failures.Add(1)
+				}
+			})
+		}
+		wg.Wait()
+
+		if refreshes.Load() != 1 || failures.Load() != 10 {
+			t.Errorf("refreshes = %d, failures = %d, want 1 and 10", refreshes.Load(), failures.Load())
+		}
+	})
+
+	t.Run("waiter honors its context", func(t *testing.T) {
+		release := make(chan struct{})
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "new-access-token", "expires_in": 3600})
+		}))
+		defer tokenServer.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = tokenServer.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		client, _ := NewOAuthClient(&OAuthConfig{
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}, NewMemoryTokenStore())
+		client.SetTokens(context.Background(), expired())
+
+		leaderDone := make(chan struct{})
+		go func() {
+			defer close(leaderDone)
+			client.EnsureValidToken(context.Background())
+		}()
+		defer func() {
+			close(release)
+			<-leaderDone
+		}()
+		for {
+			client.mu.RLock()
+			inFlight := client.refreshing != nil
+			client.mu.RUnlock()
+			if inFlight {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := client.EnsureValidToken(ctx); err != context.DeadlineExceeded {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("leader cancellation does not fail waiters", func(t *testing.T) {
+		release := make(chan struct{})
+		tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "new-access-token", "expires_in": 3600})
+		}))
+		defer tokenServer.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = tokenServer.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		client, _ := NewOAuthClient(&OAuthConfig{
+			ClientID:     "id",
+			ClientSecret: "secret",
+		}, NewMemoryTokenStore())
+		client.SetTokens(context.Background(), expired())
+
+		leaderCtx, cancelLeader := context.WithCancel(context.Background())
+		leaderErr := make(chan error, 1)
+		go func() { leaderErr <- client.EnsureValidToken(leaderCtx) }()
+		for {
+			client.mu.RLock()
+			inFlight := client.refreshing != nil
+			client.mu.RUnlock()
+			if inFlight {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		waiterErr := make(chan error, 1)
+		go func() { waiterErr <- client.EnsureValidToken(context.Background()) }()
+
+		cancelLeader()
+		if err := <-leaderErr; err != context.Canceled {
+			t.Errorf("leader: expected context.Canceled, got %v", err)
+		}
+		close(release)
+		if err := <-waiterErr; err != nil {
+			t.Errorf("waiter: unexpected error: %v", err)
+		}
+		if tokens := client.GetTokens(); tokens == nil || tokens.AccessToken != "new-access-token" {
+			t.Errorf("tokens = %+v, want the refreshed access token", tokens)
+		}
+	})
+}
+
 func TestOAuthClient_ExchangeCode(t *testing.T) {
 	t.Run("returns error for empty code", func(t *testing.T) {
 		client, _ := NewOAuthClient(&OAuthConfig{
@@ -1151,6 +1367,59 @@ func TestOAuthClient_ExchangeCode(t *testing.T) {
 	})
 }
 
+func TestOAuthClient_UserAgent(t *testing.T) {
+	var tokenUA, apiUA string
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenUA = r.Header.Get("User-Agent")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "new-access-token",
+			"refresh_token": "new-refresh-token",
+			"expires_in":    3600,
+		})
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiUA = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer apiServer.Close()
+
+	originalEndpoint := tokenEndpoint
+	tokenEndpoint = tokenServer.URL
+	defer func() { tokenEndpoint = originalEndpoint }()
+
+	store := NewMemoryTokenStore()
+	store.SaveTokens(context.Background(), &TokenResponse{
+		AccessToken:  "expired-token",
+		RefreshToken: "refresh-token",
+		ExpiresAt:    time.Now().Add(-time.Hour),
+	})
+
+	client, _ := NewOAuthClient(&OAuthConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+	}, store, WithBaseURL(apiServer.URL), WithUserAgent("my-app/1.0"))
+
+	if _, err := client.ListDevices(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokenUA != "my-app/1.0" {
+		t.Errorf("token request User-Agent = %q, want %q", tokenUA, "my-app/1.0")
+	}
+	if apiUA != "my-app/1.0" {
+		t.Errorf("API request User-Agent = %q, want %q", apiUA, "my-app/1.0")
+	}
+
+	tokenUA = ""
+	if err := client.ExchangeCode(context.Background(), "code"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokenUA != "my-app/1.0" {
+		t.Errorf("exchange request User-Agent = %q, want %q", tokenUA, "my-app/1.0")
+	}
+}
+
 func TestTokenRefreshTransport_RoundTrip(t *testing.T) {
 	t.Run("adds authorization header when token exists", func(t *testing.T) {
 		var capturedAuth string
@@ -1399,6 +1668,70 @@ func TestDefaultScopes(t *testing.T) {
 	}
 }
 
+func TestValidateScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		want     []string
+	}{
+		{"exact match", []string{"r:devices:abc"}, []string{"r:devices:abc"}, nil},
+		{"wildcard covers ID", []string{"r:devices:*"}, []string{"r:devices:abc", "r:devices:*"}, nil},
+		{"ID does not cover wildcard", []string{"r:devices:abc"}, []string{"r:devices:*"}, []string{"r:devices:*"}},
+		{"permission must match", []string{"r:devices:*"}, []string{"x:devices:abc"}, []string{"x:devices:abc"}},
+		{"part count must match", []string{"r:devices:*"}, []string{"r:devices"}, []string{"r:devices"}},
+		{"missing in order", DefaultScopes()[:1], []string{"x:devices:*", "r:devices:1", "r:locations:*"}, []string{"x:devices:*", "r:locations:*"}},
+		{"nothing granted", nil, []string{"r:scenes:*"}, []string{"r:scenes:*"}},
+		{"nothing required", []string{"r:scenes:*"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateScopes(tt.granted, tt.required)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ValidateScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOAuthClient_GrantedScopes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access",
+			"refresh_token": "refresh",
+			"expires_in":    3600,
+			"scope":         "r:devices:* x:devices:*",
+		})
+	}))
+	defer server.Close()
+
+	originalEndpoint := tokenEndpoint
+	tokenEndpoint = server.URL
+	defer func() { tokenEndpoint = originalEndpoint }()
+
+	client, _ := NewOAuthClient(&OAuthConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost/callback",
+	}, NewMemoryTokenStore())
+
+	if scopes := client.GrantedScopes(); scopes != nil {
+		t.Errorf("expected nil scopes before exchange, got %v", scopes)
+	}
+
+	if err := client.ExchangeCode(context.Background(), "code"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scopes := client.GrantedScopes()
+	if !slices.Equal(scopes, []string{"r:devices:*", "x:devices:*"}) {
+		t.Errorf("GrantedScopes() = %v", scopes)
+	}
+	if missing := ValidateScopes(scopes, DefaultScopes()); !slices.Equal(missing, []string{"r:locations:*"}) {
+		t.Errorf("missing = %v, want [r:locations:*]", missing)
+	}
+}
+
 // Helper function
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsHelper(s, substr))
@@ -1412,3 +1745,173 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+// orderedTokenStore records SaveTokens calls into a shared event log.
+type orderedTokenStore struct {
+	*MemoryTokenStore
+	events *[]string
+}
+
+func (s *orderedTokenStore) SaveTokens(ctx context.Context, tokens *TokenResponse) error {
+	*s.events = append(*s.events, "save:"+tokens.AccessToken)
+	return s.MemoryTokenStore.SaveTokens(ctx, tokens)
+}
+
+func TestWithTokenRefreshCallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "new-access-token",
+			"refresh_token": "new-refresh-token",
+			"expires_in":    3600,
+			"token_type":    "Bearer",
+		})
+	}))
+	defer server.Close()
+
+	originalEndpoint := tokenEndpoint
+	tokenEndpoint = server.URL
+	defer func() { tokenEndpoint = originalEndpoint }()
+
+	var events []string
+	store := &orderedTokenStore{MemoryTokenStore: NewMemoryTokenStore(), events: &events}
+	client, _ := NewOAuthClient(&OAuthConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+	}, store, WithTokenRefreshCallback(func(tokens *TokenResponse) {
+		events = append(events, "callback:"+tokens.AccessToken)
+	}))
+
+	client.SetTokens(context.Background(), &TokenResponse{
+		AccessToken:           "expired-access-token",
+		RefreshToken:          "valid-refresh-token",
+		ExpiresAt:             time.Now().Add(-time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	})
+
+	if err := client.EnsureValidToken(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A second call finds a valid token and must not fire the callback again.
+	if err := client.EnsureValidToken(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"save:expired-access-token", "save:new-access-token", "callback:new-access-token"}
+	if len(events) != len(want) {
+		t.Fatalf("events = %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("events[%d] = %q, want %q", i, events[i], want[i])
+		}
+	}
+}
+
+func TestPKCE(t *testing.T) {
+	cfg := &OAuthConfig{
+		ClientID:    "public-client",
+		RedirectURL: "http://localhost/callback",
+	}
+
+	t.Run("authorization URL carries S256 challenge of verifier", func(t *testing.T) {
+		authURL, verifier := GetAuthorizationURLWithPKCE(cfg, "state-1")
+		if len(verifier) < 43 || len(verifier) > 128 {
+			t.Errorf("verifier length = %d, want 43-128", len(verifier))
+		}
+
+		u, err := url.Parse(authURL)
+		if err != nil {
+			t.Fatalf("invalid URL: %v", err)
+		}
+		q := u.Query()
+		sum := sha256.Sum256([]byte(verifier))
+		if got, want := q.Get("code_challenge"), base64.RawURLEncoding.EncodeToString(sum[:]); got != want {
+			t.Errorf("code_challenge = %q, want %q", got, want)
+		}
+		if q.Get("code_challenge_method") != "S256" {
+			t.Errorf("code_challenge_method = %q, want S256", q.Get("code_challenge_method"))
+		}
+		if q.Get("state") != "state-1" || q.Get("client_id") != "public-client" {
+			t.Errorf("missing standard params in %s", authURL)
+		}
+
+		if _, other := GetAuthorizationURLWithPKCE(cfg, ""); other == verifier {
+			t.Error("verifiers should be random")
+		}
+	})
+
+	t.Run("exchange sends code_verifier without secret", func(t *testing.T) {
+		var form url.Values
+		var auth string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			form = r.PostForm
+			auth = r.Header.Get("Authorization")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "pkce-token",
+				"expires_in":   3600,
+			})
+		}))
+		defer server.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = server.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		tokens, err := ExchangeCodeWithPKCE(context.Background(), cfg, "code-1", "verifier-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tokens.AccessToken != "pkce-token" {
+			t.Errorf("AccessToken = %q, want %q", tokens.AccessToken, "pkce-token")
+		}
+		if form.Get("code_verifier") != "verifier-1" || form.Get("code") != "code-1" {
+			t.Errorf("form = %v", form)
+		}
+		if _, ok := form["client_secret"]; ok {
+			t.Error("client_secret should not be sent for public clients")
+		}
+		if auth != "" {
+			t.Errorf("Authorization = %q, want none for public clients", auth)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := ExchangeCodeWithPKCE(context.Background(), cfg, "", "v"); err == nil {
+			t.Error("expected error for empty code")
+		}
+		if _, err := ExchangeCodeWithPKCE(context.Background(), cfg, "c", ""); err == nil {
+			t.Error("expected error for empty verifier")
+		}
+	})
+
+	t.Run("OAuthClient methods", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			if r.PostForm.Get("code_verifier") == "" {
+				t.Error("missing code_verifier")
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "pkce-token",
+				"expires_in":   3600,
+			})
+		}))
+		defer server.Close()
+
+		originalEndpoint := tokenEndpoint
+		tokenEndpoint = server.URL
+		defer func() { tokenEndpoint = originalEndpoint }()
+
+		client, _ := NewOAuthClient(&OAuthConfig{ClientID: "id", ClientSecret: "secret"}, NewMemoryTokenStore())
+		authURL, verifier := client.GetAuthorizationURLWithPKCE("s")
+		if !strings.Contains(authURL, "code_challenge=") {
+			t.Errorf("URL %q missing code_challenge", authURL)
+		}
+		if err := client.ExchangeCodeWithPKCE(context.Background(), "code", verifier); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tokens := client.GetTokens(); tokens == nil || tokens.AccessToken != "pkce-token" {
+			t.Errorf("tokens = %+v", tokens)
+		}
+	})
+}
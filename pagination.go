@@ -173,9 +173,18 @@ func (c *Client) DeviceEvents(ctx context.Context, deviceID string, opts *Histor
 			return
 		}
 
-		page := 0
-		if opts != nil && opts.Page > 0 {
-			page = opts.Page
+		reqOpts := &HistoryOptions{Max: 200}
+		if opts != nil {
+			if opts.Cursor != "" {
+				reqOpts = &HistoryOptions{Cursor: opts.Cursor}
+			} else {
+				reqOpts.Before = opts.Before
+				reqOpts.After = opts.After
+				reqOpts.Page = max(opts.Page, 0)
+				if opts.Max > 0 {
+					reqOpts.Max = opts.Max
+				}
+			}
 		}
 
 		for {
@@ -186,19 +195,7 @@ func (c *Client) DeviceEvents(ctx context.Context, deviceID string, opts *Histor
 			default:
 			}
 
-			reqOpts := &HistoryOptions{
-				Max:  200,
-				Page: page,
-			}
-			if opts != nil {
-				reqOpts.Before = opts.Before
-				reqOpts.After = opts.After
-				if opts.Max > 0 {
-					reqOpts.Max = opts.Max
-				}
-			}
-
-			resp, err := c.GetDeviceEvents(ctx, deviceID, reqOpts)
+			resp, cursor, err := c.GetDeviceEventsPage(ctx, deviceID, reqOpts)
 			if err != nil {
 				yield(DeviceEvent{}, err)
 				return
@@ -210,10 +207,37 @@ func (c *Client) DeviceEvents(ctx context.Context, deviceID string, opts *Histor
 				}
 			}
 
-			if resp.Links.Next == "" || len(resp.Items) == 0 {
+			if cursor == "" {
+				return
+			}
+			reqOpts = &HistoryOptions{Cursor: cursor}
+		}
+	}
+}
+
+// DeviceEventsFiltered returns an iterator over the device events that match
+// filter, with automatic pagination. Non-matching events are skipped as each
+// page is read; errors are always yielded.
+//
+// Example:
+//
+//	filter := smartthings.EventFilter{Capabilities: []string{"healthCheck"}, StateChangeOnly: true}
+//	for event, err := range client.DeviceEventsFiltered(ctx, deviceID, filter) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(event.Timestamp, event.Value)
+//	}
+func (c *Client) DeviceEventsFiltered(ctx context.Context, deviceID string, filter EventFilter) iter.Seq2[DeviceEvent, error] {
+	return func(yield func(DeviceEvent, error) bool) {
+		for event, err := range c.DeviceEvents(ctx, deviceID, filter.History) {
+			if err != nil {
+				yield(DeviceEvent{}, err)
+				return
+			}
+			if filter.Matches(event) && !yield(event, nil) {
 				return
 			}
-			page++
 		}
 	}
 }
@@ -266,26 +290,44 @@ func (c *Client) DeviceProfiles(ctx context.Context) iter.Seq2[DeviceProfileFull
 	}
 }
 
-// Capabilities returns an iterator over all capabilities.
+// Capabilities returns an iterator over all capabilities with automatic pagination.
 func (c *Client) Capabilities(ctx context.Context) iter.Seq2[CapabilityReference, error] {
+	return c.CapabilitiesWithOptions(ctx, nil)
+}
+
+// CapabilitiesWithOptions returns a capability iterator with a namespace
+// filter, fetching additional pages as needed. opts.Page sets the first page.
+func (c *Client) CapabilitiesWithOptions(ctx context.Context, opts *ListCapabilitiesOptions) iter.Seq2[CapabilityReference, error] {
 	return func(yield func(CapabilityReference, error) bool) {
-		select {
-		case <-ctx.Done():
-			yield(CapabilityReference{}, ctx.Err())
-			return
-		default:
+		reqOpts := &ListCapabilitiesOptions{}
+		if opts != nil {
+			*reqOpts = *opts
 		}
 
-		caps, err := c.ListCapabilities(ctx)
-		if err != nil {
-			yield(CapabilityReference{}, err)
-			return
-		}
+		for {
+			select {
+			case <-ctx.Done():
+				yield(CapabilityReference{}, ctx.Err())
+				return
+			default:
+			}
 
-		for _, cap := range caps {
-			if !yield(cap, nil) {
+			resp, err := c.listCapabilitiesPage(ctx, reqOpts)
+			if err != nil {
+				yield(CapabilityReference{}, err)
 				return
 			}
+
+			for _, cap := range resp.Items {
+				if !yield(cap, nil) {
+					return
+				}
+			}
+
+			if resp.Links.Next == "" || len(resp.Items) == 0 {
+				return // no more pages
+			}
+			reqOpts.Page++
 		}
 	}
 }
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -278,6 +281,99 @@ func TestClient_ListAllDevices(t *testing.T) {
 	})
 }
 
+func TestClient_ListAllDevices_Parallel(t *testing.T) {
+	// pagedServer serves totalPages pages of two devices each, reporting
+	// TotalPages when withTotal is set, and fails the page in failPage (if >= 0).
+	pagedServer := func(totalPages int, withTotal bool, failPage int, inFlight, maxInFlight *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				old := maxInFlight.Load()
+				if n <= old || maxInFlight.CompareAndSwap(old, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == failPage {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			resp := PagedDevices{
+				Items: []Device{
+					{DeviceID: "device-" + strconv.Itoa(page*2)},
+					{DeviceID: "device-" + strconv.Itoa(page*2+1)},
+				},
+			}
+			if withTotal {
+				resp.PageInfo = PageInfo{TotalPages: totalPages, CurrentPage: page}
+			}
+			if page < totalPages-1 {
+				resp.Links.Next = "/devices?page=" + strconv.Itoa(page+1)
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+	}
+
+	t.Run("fetches pages concurrently in order", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		server := pagedServer(8, true, -1, &inFlight, &maxInFlight)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithParallelPagination(3))
+		devices, err := client.ListAllDevices(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(devices) != 16 {
+			t.Fatalf("got %d devices, want 16", len(devices))
+		}
+		for i, d := range devices {
+			if want := "device-" + strconv.Itoa(i); d.DeviceID != want {
+				t.Errorf("devices[%d] = %q, want %q", i, d.DeviceID, want)
+			}
+		}
+		if got := maxInFlight.Load(); got < 2 || got > 3 {
+			t.Errorf("max concurrent requests = %d, want 2-3", got)
+		}
+	})
+
+	t.Run("falls back to sequential without total pages", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		server := pagedServer(4, false, -1, &inFlight, &maxInFlight)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithParallelPagination(3))
+		devices, err := client.ListAllDevices(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(devices) != 8 {
+			t.Errorf("got %d devices, want 8", len(devices))
+		}
+		if got := maxInFlight.Load(); got != 1 {
+			t.Errorf("max concurrent requests = %d, want 1", got)
+		}
+	})
+
+	t.Run("page error aborts", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		server := pagedServer(6, true, 3, &inFlight, &maxInFlight)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithParallelPagination(4))
+		devices, err := client.ListAllDevices(context.Background())
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if devices != nil {
+			t.Errorf("devices = %v, want nil", devices)
+		}
+	})
+}
+
 func TestClient_DeleteDevice(t *testing.T) {
 	t.Run("successful deletion", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1229,6 +1325,45 @@ func TestClient_CapabilitiesIterator(t *testing.T) {
 		}
 	})
 
+	t.Run("follows pagination", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ns := r.URL.Query().Get("namespace"); ns != "custom" {
+				t.Errorf("namespace query = %q, want %q", ns, "custom")
+			}
+			if max := r.URL.Query().Get("max"); max != "2" {
+				t.Errorf("max query = %q, want %q", max, "2")
+			}
+			var resp capabilityListResponse
+			switch r.URL.Query().Get("page") {
+			case "":
+				resp.Items = []CapabilityReference{{ID: "custom.one"}, {ID: "custom.two"}}
+				resp.Links.Next = "/capabilities?page=1"
+			case "1":
+				resp.Items = []CapabilityReference{{ID: "custom.three"}}
+			default:
+				t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		var ids []string
+		opts := &ListCapabilitiesOptions{Namespace: CapabilityNamespaceCustom, Max: 2}
+		for cap, err := range client.CapabilitiesWithOptions(context.Background(), opts) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids = append(ids, cap.ID)
+		}
+		if want := []string{"custom.one", "custom.two", "custom.three"}; !slices.Equal(ids, want) {
+			t.Errorf("got %v, want %v", ids, want)
+		}
+		if opts.Page != 0 {
+			t.Errorf("opts.Page modified to %d", opts.Page)
+		}
+	})
+
 	t.Run("handles context cancellation", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -1537,6 +1672,113 @@ func TestClient_DeviceEventsIterator(t *testing.T) {
 			t.Errorf("got %d events, want 1", len(events))
 		}
 	})
+
+	t.Run("resumes from cursor", func(t *testing.T) {
+		var pages []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pages = append(pages, r.URL.Query().Get("page"))
+			resp := PagedEvents{Items: []DeviceEvent{{DeviceID: "device-1"}}}
+			if r.URL.Query().Get("page") == "3" {
+				resp.Links.Next = "next"
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		var count int
+		for _, err := range client.DeviceEvents(context.Background(), "device-1", &HistoryOptions{Cursor: "max=5&page=3"}) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+		}
+		if count != 2 || len(pages) != 2 || pages[0] != "3" || pages[1] != "4" {
+			t.Errorf("count = %d, pages = %v, want pages 3 and 4", count, pages)
+		}
+	})
+}
+
+func TestClient_DeviceEventsFiltered(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp PagedEvents
+		if r.URL.Query().Get("page") == "" {
+			resp = PagedEvents{
+				Items: []DeviceEvent{
+					{Capability: "switch", Attribute: "switch", Value: "on", StateChange: true},
+					{Capability: "healthCheck", Attribute: "DeviceWatch-DeviceStatus", Value: "offline", StateChange: true},
+					{Capability: "healthCheck", Attribute: "DeviceWatch-DeviceStatus", Value: "offline"},
+				},
+				Links: Links{Next: "/events?page=1"},
+			}
+		} else {
+			resp = PagedEvents{
+				Items: []DeviceEvent{
+					{Capability: "healthCheck", Attribute: "healthStatus", Value: "online", StateChange: true},
+					{Capability: "healthCheck", Attribute: "DeviceWatch-DeviceStatus", Value: "online", StateChange: true},
+				},
+			}
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	collect := func(t *testing.T, filter EventFilter) []DeviceEvent {
+		t.Helper()
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		var events []DeviceEvent
+		for ev, err := range client.DeviceEventsFiltered(context.Background(), "device-1", filter) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			events = append(events, ev)
+		}
+		return events
+	}
+
+	t.Run("empty filter matches everything", func(t *testing.T) {
+		if got := collect(t, EventFilter{}); len(got) != 5 {
+			t.Errorf("got %d events, want 5", len(got))
+		}
+	})
+
+	t.Run("capability", func(t *testing.T) {
+		if got := collect(t, EventFilter{Capabilities: []string{"healthCheck"}}); len(got) != 4 {
+			t.Errorf("got %d events, want 4", len(got))
+		}
+	})
+
+	t.Run("health transitions across pages", func(t *testing.T) {
+		got := collect(t, EventFilter{
+			Capabilities:    []string{"healthCheck"},
+			Attributes:      []string{"DeviceWatch-DeviceStatus"},
+			StateChangeOnly: true,
+		})
+		if len(got) != 2 || got[0].Value != "offline" || got[1].Value != "online" {
+			t.Errorf("got %+v, want offline then online", got)
+		}
+	})
+
+	t.Run("early break", func(t *testing.T) {
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		count := 0
+		for range client.DeviceEventsFiltered(context.Background(), "device-1", EventFilter{}) {
+			count++
+			break
+		}
+		if count != 1 {
+			t.Errorf("got %d events, want 1", count)
+		}
+	})
+
+	t.Run("errors are yielded", func(t *testing.T) {
+		client, _ := NewClient("token")
+		for _, err := range client.DeviceEventsFiltered(context.Background(), "", EventFilter{}) {
+			if err != ErrEmptyDeviceID {
+				t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+			}
+		}
+	})
 }
 
 func TestClient_EnrolledChannelsIterator(t *testing.T) {
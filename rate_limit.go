@@ -2,23 +2,38 @@ package smartthings
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 )
 
 // RateLimitError provides detailed information about a rate limit response.
 // It includes the recommended wait time from the Retry-After header if available.
+//
+// Use errors.As to extract it from an error:
+//
+//	var rle *smartthings.RateLimitError
+//	if errors.As(err, &rle) {
+//	    time.Sleep(time.Until(rle.ResetAt))
+//	}
 type RateLimitError struct {
 	// RetryAfter is the recommended wait duration from the Retry-After header.
 	// Zero if the header was not present.
 	RetryAfter time.Duration
 
+	// ResetAt is when requests may be retried. It is taken from the
+	// X-RateLimit-Reset header, or derived from RetryAfter if that header
+	// was not present. Zero if neither header was present.
+	ResetAt time.Time
+
 	// Info contains the rate limit headers from the response.
 	Info *RateLimitInfo
 }
 
 // Error implements the error interface.
-func (e *RateLimitError) Error() string {
+func (e RateLimitError) Error() string {
 	if e.RetryAfter > 0 {
 		return "smartthings: rate limited (retry after " + e.RetryAfter.String() + ")"
 	}
@@ -30,6 +45,36 @@ func (e *RateLimitError) Is(target error) bool {
 	return target == ErrRateLimited
 }
 
+// As allows errors.As() to extract a RateLimitError value as well as a pointer.
+func (e *RateLimitError) As(target any) bool {
+	if t, ok := target.(*RateLimitError); ok {
+		*t = *e
+		return true
+	}
+	return false
+}
+
+// newRateLimitError builds a RateLimitError from a 429 response's headers.
+func newRateLimitError(headers http.Header, info *RateLimitInfo) *RateLimitError {
+	retryAfter := parseRetryAfter(headers.Get("Retry-After"))
+
+	var resetAt time.Time
+	if reset := headers.Get("X-RateLimit-Reset"); reset != "" {
+		if v, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			resetAt = time.Unix(v, 0)
+		}
+	}
+	if resetAt.IsZero() && retryAfter > 0 {
+		resetAt = time.Now().Add(retryAfter)
+	}
+
+	return &RateLimitError{
+		RetryAfter: retryAfter,
+		ResetAt:    resetAt,
+		Info:       info,
+	}
+}
+
 // parseRetryAfter parses the Retry-After header value.
 // It handles both delta-seconds (e.g., "120") and HTTP-date formats.
 func parseRetryAfter(value string) time.Duration {
@@ -94,6 +139,39 @@ func (c *Client) WaitForRateLimit(ctx context.Context) error {
 	}
 }
 
+// WaitForRateLimitWithCap is like WaitForRateLimit, but fails fast instead of
+// sleeping when the rate limit window resets more than maxWait from now.
+// The returned error wraps ErrRateLimitWaitExceeded and reports the required
+// wait. This suits interactive tools that should not hang; batch jobs can keep
+// using WaitForRateLimit.
+//
+// Example:
+//
+//	if err := client.WaitForRateLimitWithCap(ctx, 5*time.Second); errors.Is(err, ErrRateLimitWaitExceeded) {
+//	    return fmt.Errorf("SmartThings is busy, try again later: %w", err)
+//	}
+func (c *Client) WaitForRateLimitWithCap(ctx context.Context, maxWait time.Duration) error {
+	info := c.RateLimitInfo()
+	if info == nil {
+		return nil
+	}
+
+	waitDuration := time.Until(info.Reset)
+	if waitDuration <= 0 {
+		return nil
+	}
+	if waitDuration > maxWait {
+		return fmt.Errorf("%w: reset in %s exceeds %s", ErrRateLimitWaitExceeded, waitDuration.Round(time.Second), maxWait)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(waitDuration):
+		return nil
+	}
+}
+
 // WaitForRateLimitErr waits based on a RateLimitError's RetryAfter duration.
 // If the error is not a RateLimitError, it returns immediately.
 //
@@ -107,8 +185,8 @@ func (c *Client) WaitForRateLimit(ctx context.Context) error {
 //	    // Retry the command
 //	}
 func (c *Client) WaitForRateLimitErr(ctx context.Context, err error) error {
-	rle, ok := err.(*RateLimitError)
-	if !ok {
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
 		return nil
 	}
 
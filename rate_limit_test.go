@@ -3,8 +3,10 @@ package smartthings
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -122,6 +124,55 @@ func TestClient_WaitForRateLimit(t *testing.T) {
 	})
 }
 
+func TestClient_WaitForRateLimitWithCap(t *testing.T) {
+	withReset := func(reset time.Time) *Client {
+		client, _ := NewClient("token")
+		client.rateLimitMu.Lock()
+		client.lastRateLimit = &RateLimitInfo{Reset: reset}
+		client.rateLimitMu.Unlock()
+		return client
+	}
+
+	t.Run("returns immediately when no rate limit info", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.WaitForRateLimitWithCap(context.Background(), time.Second); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("waits when reset is within cap", func(t *testing.T) {
+		client := withReset(time.Now().Add(50 * time.Millisecond))
+		start := time.Now()
+		if err := client.WaitForRateLimitWithCap(context.Background(), time.Second); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if time.Since(start) < 40*time.Millisecond {
+			t.Error("should have waited for reset")
+		}
+	})
+
+	t.Run("fails fast when reset exceeds cap", func(t *testing.T) {
+		client := withReset(time.Now().Add(time.Hour))
+		start := time.Now()
+		err := client.WaitForRateLimitWithCap(context.Background(), time.Second)
+		if !errors.Is(err, ErrRateLimitWaitExceeded) {
+			t.Errorf("expected ErrRateLimitWaitExceeded, got %v", err)
+		}
+		if time.Since(start) > 100*time.Millisecond {
+			t.Error("should return immediately")
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		client := withReset(time.Now().Add(time.Second))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if err := client.WaitForRateLimitWithCap(ctx, time.Minute); err != context.DeadlineExceeded {
+			t.Errorf("expected DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
 func TestClient_WaitForRateLimitErr(t *testing.T) {
 	t.Run("returns immediately for non-RateLimitError", func(t *testing.T) {
 		client, _ := NewClient("token")
@@ -333,8 +384,60 @@ func TestClient_RateLimitErrorFromAPI(t *testing.T) {
 		t.Errorf("expected RetryAfter=60s, got %v", rle.RetryAfter)
 	}
 
+	// Check ResetAt is derived from RetryAfter when X-RateLimit-Reset is absent
+	if until := time.Until(rle.ResetAt); until < 55*time.Second || until > 60*time.Second {
+		t.Errorf("expected ResetAt ~60s from now, got %v", until)
+	}
+
 	// Check that errors.Is still works
 	if !errors.Is(err, ErrRateLimited) {
 		t.Error("expected errors.Is to match ErrRateLimited")
 	}
 }
+
+func TestClient_RateLimitErrorResetAt(t *testing.T) {
+	resetTime := time.Now().Add(2 * time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(resetTime, 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	_, err := client.ListDevices(context.Background())
+
+	t.Run("pointer target", func(t *testing.T) {
+		var rle *RateLimitError
+		if !errors.As(err, &rle) {
+			t.Fatalf("expected RateLimitError, got: %T", err)
+		}
+		if rle.ResetAt.Unix() != resetTime {
+			t.Errorf("ResetAt = %v, want %v", rle.ResetAt.Unix(), resetTime)
+		}
+		if rle.RetryAfter != 30*time.Second {
+			t.Errorf("RetryAfter = %v, want 30s", rle.RetryAfter)
+		}
+	})
+
+	t.Run("value target", func(t *testing.T) {
+		var rle RateLimitError
+		if !errors.As(err, &rle) {
+			t.Fatalf("expected RateLimitError, got: %T", err)
+		}
+		if rle.ResetAt.Unix() != resetTime {
+			t.Errorf("ResetAt = %v, want %v", rle.ResetAt.Unix(), resetTime)
+		}
+	})
+
+	t.Run("wrapped error", func(t *testing.T) {
+		wrapped := fmt.Errorf("listing devices: %w", err)
+		if !IsRateLimited(wrapped) {
+			t.Error("expected IsRateLimited to match wrapped RateLimitError")
+		}
+		var rle *RateLimitError
+		if !errors.As(wrapped, &rle) {
+			t.Error("expected errors.As to match wrapped RateLimitError")
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package smartthings
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// redactedHeaders lists request headers whose values are never recorded.
+var redactedHeaders = []string{"Authorization"}
+
+// RecordedInteraction is a single HTTP request/response pair written by
+// WithRecorder, one JSON object per line.
+type RecordedInteraction struct {
+	Time            time.Time     `json:"time"`
+	Method          string        `json:"method"`
+	URL             string        `json:"url"`
+	RequestHeaders  http.Header   `json:"request_headers,omitempty"`
+	RequestBody     string        `json:"request_body,omitempty"`
+	StatusCode      int           `json:"status_code,omitempty"`
+	ResponseHeaders http.Header   `json:"response_headers,omitempty"`
+	ResponseBody    string        `json:"response_body,omitempty"`
+	Duration        time.Duration `json:"duration"`
+	Error           string        `json:"error,omitempty"`
+}
+
+// recorder serializes RecordedInteractions to a writer.
+type recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// WithRecorder writes every API request and its response to w as JSON lines
+// (see RecordedInteraction), including full request and response bodies.
+// The Authorization header is redacted. Each retry attempt is recorded as a
+// separate interaction; recording never changes retry behavior. Writes are
+// serialized, so concurrent requests are safe. Write errors are ignored.
+//
+// Unlike WithLogger, which logs request metadata, the recorder captures the
+// complete exchange and is intended for debugging API behavior.
+//
+// Example:
+//
+//	f, _ := os.Create("smartthings.jsonl")
+//	defer f.Close()
+//	client, _ := st.NewClient("token", st.WithRecorder(f))
+func WithRecorder(w io.Writer) Option {
+	return func(c *Client) {
+		if w == nil {
+			c.recorder = nil
+			return
+		}
+		c.recorder = &recorder{enc: json.NewEncoder(w)}
+	}
+}
+
+// record writes an interaction if a recorder is configured.
+// resp is nil if the request failed before a response was received.
+func (c *Client) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, start time.Time, err error) {
+	if c.recorder == nil {
+		return
+	}
+
+	headers := req.Header.Clone()
+	for _, h := range redactedHeaders {
+		if headers.Get(h) != "" {
+			headers.Set(h, RedactedValue)
+		}
+	}
+
+	entry := RecordedInteraction{
+		Time:           start,
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		RequestHeaders: headers,
+		RequestBody:    string(reqBody),
+		ResponseBody:   string(respBody),
+		Duration:       time.Since(start),
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		entry.ResponseHeaders = resp.Header.Clone()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	c.recorder.mu.Lock()
+	defer c.recorder.mu.Unlock()
+	_ = c.recorder.enc.Encode(entry)
+}
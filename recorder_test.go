@@ -0,0 +1,160 @@
+package smartthings
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) interactions(t *testing.T) []RecordedInteraction {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var result []RecordedInteraction
+	scanner := bufio.NewScanner(&b.buf)
+	for scanner.Scan() {
+		var entry RecordedInteraction
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func TestWithRecorder(t *testing.T) {
+	t.Run("records request and response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-1")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"sceneId":"scene-1"}`))
+		}))
+		defer server.Close()
+
+		var buf syncBuffer
+		client, _ := NewClient("secret-token", WithBaseURL(server.URL), WithRecorder(&buf))
+		if err := client.ExecuteCommand(context.Background(), "device-1", NewCommand("switch", "on")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		entries := buf.interactions(t)
+		if len(entries) != 1 {
+			t.Fatalf("got %d interactions, want 1", len(entries))
+		}
+		e := entries[0]
+		if e.Method != http.MethodPost || !strings.HasSuffix(e.URL, "/devices/device-1/commands") {
+			t.Errorf("request = %s %s", e.Method, e.URL)
+		}
+		if got := e.RequestHeaders.Get("Authorization"); got != "REDACTED" {
+			t.Errorf("Authorization = %q, want REDACTED", got)
+		}
+		if !strings.Contains(e.RequestBody, `"command":"on"`) {
+			t.Errorf("RequestBody = %q, want command body", e.RequestBody)
+		}
+		if e.StatusCode != http.StatusOK {
+			t.Errorf("StatusCode = %d, want 200", e.StatusCode)
+		}
+		if e.ResponseBody != `{"sceneId":"scene-1"}` {
+			t.Errorf("ResponseBody = %q", e.ResponseBody)
+		}
+		if e.ResponseHeaders.Get("X-Request-Id") != "req-1" {
+			t.Errorf("ResponseHeaders = %v", e.ResponseHeaders)
+		}
+		if strings.Contains(buf.buf.String(), "secret-token") {
+			t.Error("recording contains the token")
+		}
+	})
+
+	t.Run("records each retry attempt", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":{"message":"boom"}}`))
+				return
+			}
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		var buf syncBuffer
+		client, _ := NewClient("token",
+			WithBaseURL(server.URL),
+			WithRecorder(&buf),
+			WithRetry(&RetryConfig{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 2}),
+		)
+		if _, err := client.ListLocations(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("got %d server calls, want 2", calls.Load())
+		}
+
+		entries := buf.interactions(t)
+		if len(entries) != 2 {
+			t.Fatalf("got %d interactions, want 2", len(entries))
+		}
+		if entries[0].StatusCode != http.StatusInternalServerError || entries[1].StatusCode != http.StatusOK {
+			t.Errorf("status codes = %d, %d; want 500, 200", entries[0].StatusCode, entries[1].StatusCode)
+		}
+	})
+
+	t.Run("records transport errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		var buf syncBuffer
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRecorder(&buf))
+		if _, err := client.ListLocations(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+
+		entries := buf.interactions(t)
+		if len(entries) != 1 || entries[0].Error == "" || entries[0].StatusCode != 0 {
+			t.Errorf("entries = %+v, want one entry with an error", entries)
+		}
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		defer server.Close()
+
+		var buf syncBuffer
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRecorder(&buf))
+
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Go(func() {
+				client.ListLocations(context.Background())
+			})
+		}
+		wg.Wait()
+
+		if entries := buf.interactions(t); len(entries) != 20 {
+			t.Errorf("got %d interactions, want 20", len(entries))
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package smartthings
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// RedactedValue replaces attribute values removed by RedactStatus.
+const RedactedValue = "REDACTED"
+
+// redactedCapabilities lists capabilities whose attribute values identify a
+// device, its owner, or their location.
+var redactedCapabilities = []string{
+	"geolocation",                    // latitude, longitude, ...
+	"imageCapture",                   // image URLs
+	"videoCapture",                   // clip and stream URLs
+	"videoStream",                    // stream URLs
+	"ocf",                            // device ID, firmware and hardware identifiers
+	"samsungce.deviceIdentification", // serial numbers, model codes
+}
+
+// redactedAttributes lists attributes redacted in any capability.
+var redactedAttributes = []string{"serialNumber", "macAddress", "ipAddress", "ssid"}
+
+// RedactStatus returns a copy of status with values that could identify a
+// device or its owner replaced by RedactedValue: every attribute of the
+// geolocation, imageCapture, videoCapture, videoStream, ocf, and
+// samsungce.deviceIdentification capabilities, and serialNumber, macAddress,
+// ipAddress, and ssid attributes of any capability. Units and timestamps are
+// kept. status is not modified.
+//
+// Both single-component status (from GetDeviceStatus) and multi-component
+// status (from GetDeviceStatusAllComponents) are supported. Redaction is
+// best effort; review the output before sharing it.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	fmt.Println(smartthings.DumpStatus(smartthings.RedactStatus(status)))
+func RedactStatus(status Status) Status {
+	if status == nil {
+		return nil
+	}
+	return Status(redactMap(status, nil))
+}
+
+// redactMap deep-copies data, redacting attributes found at path
+// capability/attribute or component/capability/attribute.
+func redactMap(data map[string]any, path []string) map[string]any {
+	out := make(map[string]any, len(data))
+	for key, v := range data {
+		p := append(path[:len(path):len(path)], key)
+		var m map[string]any
+		switch v := v.(type) {
+		case Status:
+			// A component of GetDeviceStatusAllComponents; keep its type.
+			out[key] = Status(redactMap(v, p))
+			continue
+		case map[string]any:
+			m = v
+		default:
+			out[key] = copyValue(v)
+			continue
+		}
+		if _, isAttr := m["value"]; isAttr && (len(p) == 2 || len(p) == 3) {
+			attr := copyValue(m).(map[string]any)
+			if slices.Contains(redactedCapabilities, p[len(p)-2]) || slices.Contains(redactedAttributes, p[len(p)-1]) {
+				attr["value"] = RedactedValue
+			}
+			out[key] = attr
+			continue
+		}
+		out[key] = redactMap(m, p)
+	}
+	return out
+}
+
+// copyValue deep-copies the maps and slices of a decoded JSON value,
+// including Status maps nested in multi-component status.
+func copyValue(v any) any {
+	switch v := v.(type) {
+	case Status:
+		return Status(copyValue(map[string]any(v)).(map[string]any))
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, val := range v {
+			out[key] = copyValue(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = copyValue(val)
+		}
+		return out
+	}
+	return v
+}
+
+// DumpStatus formats status as indented JSON with keys sorted at every
+// level, so dumps of the same status are identical and diff cleanly. Use
+// RedactStatus first when sharing a dump, e.g. in a bug report.
+func DumpStatus(status Status) string {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(status); err != nil {
+		// Only possible for values JSON cannot represent, which decoded
+		// API responses never contain.
+		return fmt.Sprintf("%v", map[string]any(status))
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
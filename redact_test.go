@@ -0,0 +1,136 @@
+package smartthings
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRedactStatus(t *testing.T) {
+	attr := func(v any) map[string]any { return map[string]any{"value": v, "timestamp": "2025-01-01T00:00:00Z"} }
+
+	t.Run("single component", func(t *testing.T) {
+		status := Status{
+			"switch":      map[string]any{"switch": attr("on")},
+			"geolocation": map[string]any{"latitude": attr(51.5), "longitude": attr(-0.12)},
+			"imageCapture": map[string]any{
+				"image": attr("https://example.com/img?id=1&key=2"),
+			},
+			"samsungce.softwareUpdate": map[string]any{"serialNumber": attr("SN123"), "otnDUID": attr("X")},
+			"healthCheck":              map[string]any{"checkInterval": map[string]any{"value": 60.0, "data": map[string]any{"hubHardwareId": "0035"}}},
+		}
+
+		redacted := RedactStatus(status)
+
+		for _, path := range [][]string{
+			{"geolocation", "latitude", "value"},
+			{"geolocation", "longitude", "value"},
+			{"imageCapture", "image", "value"},
+			{"samsungce.softwareUpdate", "serialNumber", "value"},
+		} {
+			if got, _ := GetString(redacted, path...); got != RedactedValue {
+				t.Errorf("%v = %q, want %q", path, got, RedactedValue)
+			}
+		}
+		if got, _ := GetString(redacted, "switch", "switch", "value"); got != "on" {
+			t.Errorf("switch = %q, want on", got)
+		}
+		if got, _ := GetString(redacted, "samsungce.softwareUpdate", "otnDUID", "value"); got != "X" {
+			t.Errorf("otnDUID = %q, want X", got)
+		}
+		if got, _ := GetString(redacted, "geolocation", "latitude", "timestamp"); got == "" {
+			t.Error("expected timestamp to be kept")
+		}
+
+		// The original is not modified, including nested maps.
+		if got, _ := GetFloat(status, "geolocation", "latitude", "value"); got != 51.5 {
+			t.Errorf("original latitude changed to %v", got)
+		}
+		data, _ := GetMap(redacted, "healthCheck", "checkInterval", "data")
+		data["hubHardwareId"] = "changed"
+		if got, _ := GetString(status, "healthCheck", "checkInterval", "data", "hubHardwareId"); got != "0035" {
+			t.Error("redacted copy shares nested maps with the original")
+		}
+	})
+
+	t.Run("multiple components", func(t *testing.T) {
+		status := Status{
+			"main": map[string]any{"ocf": map[string]any{"di": attr("device-uuid")}},
+			"sub":  map[string]any{"switch": map[string]any{"switch": attr("off")}},
+		}
+		redacted := RedactStatus(status)
+		if got, _ := GetString(redacted, "main", "ocf", "di", "value"); got != RedactedValue {
+			t.Errorf("ocf.di = %q, want %q", got, RedactedValue)
+		}
+		if got, _ := GetString(redacted, "sub", "switch", "switch", "value"); got != "off" {
+			t.Errorf("sub switch = %q, want off", got)
+		}
+	})
+
+	t.Run("typed Status components", func(t *testing.T) {
+		// The shape returned by GetDeviceStatusAllComponents.
+		status := Status{
+			"main": Status{"geolocation": map[string]any{"latitude": attr(51.5)}},
+			"sub":  Status{"switch": map[string]any{"switch": attr("off")}},
+		}
+		redacted := RedactStatus(status)
+		main, ok := redacted["main"].(Status)
+		if !ok {
+			t.Fatalf("main = %T, want Status", redacted["main"])
+		}
+		if got, _ := GetString(main, "geolocation", "latitude", "value"); got != RedactedValue {
+			t.Errorf("geolocation.latitude = %v, want %q", main["geolocation"], RedactedValue)
+		}
+		if got, _ := GetString(redacted["sub"].(Status), "switch", "switch", "value"); got != "off" {
+			t.Errorf("sub switch = %q, want off", got)
+		}
+		if v, _ := GetFloat(status["main"].(Status), "geolocation", "latitude", "value"); v != 51.5 {
+			t.Errorf("input modified: latitude = %v", v)
+		}
+	})
+
+	t.Run("nil status", func(t *testing.T) {
+		if RedactStatus(nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+}
+
+func TestDumpStatus(t *testing.T) {
+	status := Status{
+		"switchLevel": map[string]any{"level": map[string]any{"value": 50.0, "unit": "%"}},
+		"imageCapture": map[string]any{
+			"image": map[string]any{"value": "https://example.com/img?a=1&b=2"},
+		},
+	}
+
+	dump := DumpStatus(status)
+	want := `{
+  "imageCapture": {
+    "image": {
+      "value": "https://example.com/img?a=1&b=2"
+    }
+  },
+  "switchLevel": {
+    "level": {
+      "unit": "%",
+      "value": 50
+    }
+  }
+}`
+	if dump != want {
+		t.Errorf("DumpStatus() =\n%s\nwant\n%s", dump, want)
+	}
+	for range 5 {
+		if DumpStatus(status) != dump {
+			t.Fatal("DumpStatus output is not stable")
+		}
+	}
+
+	var decoded Status
+	if err := json.Unmarshal([]byte(DumpStatus(RedactStatus(status))), &decoded); err != nil {
+		t.Fatalf("dump is not valid JSON: %v", err)
+	}
+	if got, _ := GetString(decoded, "imageCapture", "image", "value"); got != RedactedValue {
+		t.Errorf("image = %q, want %q", got, RedactedValue)
+	}
+}
@@ -0,0 +1,45 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestIDHeader is the request header carrying the request ID read by
+// WithRequestIDFromContext.
+const RequestIDHeader = "X-Request-ID"
+
+// WithRequestIDFromContext propagates a request ID from the caller's context
+// to the SmartThings API, to correlate calls with the caller's own traces.
+// Each request reads ctx.Value(key) and, if it is a non-empty string or a
+// fmt.Stringer, sends it in the X-Request-ID header and adds it to the
+// request_id attribute of LogRequest and LogResponse records. Retries send
+// the same ID.
+//
+// Example:
+//
+//	type requestIDKey struct{}
+//
+//	client, _ := st.NewClient(token, st.WithRequestIDFromContext(requestIDKey{}), st.WithSlog(logger))
+//	ctx := context.WithValue(ctx, requestIDKey{}, "req-42")
+//	devices, err := client.ListDevices(ctx) // sent with X-Request-ID: req-42
+func WithRequestIDFromContext(key any) Option {
+	return func(c *Client) {
+		c.requestIDKey = key
+	}
+}
+
+// requestID returns the request ID carried by ctx under the key set with
+// WithRequestIDFromContext, or "" if there is none.
+func (c *Client) requestID(ctx context.Context) string {
+	if c.requestIDKey == nil {
+		return ""
+	}
+	switch id := ctx.Value(c.requestIDKey).(type) {
+	case string:
+		return id
+	case fmt.Stringer:
+		return id.String()
+	}
+	return ""
+}
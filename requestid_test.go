@@ -0,0 +1,108 @@
+package smartthings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testRequestIDKey struct{}
+
+type testTraceID string
+
+func (id testTraceID) String() string { return "trace-" + string(id) }
+
+func TestWithRequestIDFromContext(t *testing.T) {
+	t.Run("sends the header on every attempt", func(t *testing.T) {
+		var ids []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ids = append(ids, r.Header.Get(RequestIDHeader))
+			if len(ids) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`{"items": []}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRequestIDFromContext(testRequestIDKey{}),
+			WithRetry(&RetryConfig{MaxRetries: 1, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1}))
+		ctx := context.WithValue(context.Background(), testRequestIDKey{}, "req-42")
+		if _, err := client.ListLocations(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 2 || ids[0] != "req-42" || ids[1] != "req-42" {
+			t.Errorf("%s headers = %q, want req-42 on both attempts", RequestIDHeader, ids)
+		}
+	})
+
+	t.Run("header values", func(t *testing.T) {
+		var got string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.Header.Get(RequestIDHeader)
+			w.Write([]byte(`{"items": []}`))
+		}))
+		defer server.Close()
+
+		tests := []struct {
+			name  string
+			opts  []Option
+			value any
+			want  string
+		}{
+			{"stringer", []Option{WithRequestIDFromContext(testRequestIDKey{})}, testTraceID("7"), "trace-7"},
+			{"missing value", []Option{WithRequestIDFromContext(testRequestIDKey{})}, nil, ""},
+			{"unsupported type", []Option{WithRequestIDFromContext(testRequestIDKey{})}, 42, ""},
+			{"option not set", nil, "req-42", ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				client, _ := NewClient("token", append(tt.opts, WithBaseURL(server.URL))...)
+				ctx := context.Background()
+				if tt.value != nil {
+					ctx = context.WithValue(ctx, testRequestIDKey{}, tt.value)
+				}
+				if _, err := client.ListLocations(ctx); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("%s = %q, want %q", RequestIDHeader, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("logged with requests and responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"items": []}`))
+		}))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithSlog(logger), WithRequestIDFromContext(testRequestIDKey{}))
+		ctx := context.WithValue(context.Background(), testRequestIDKey{}, "req-42")
+		if _, err := client.ListLocations(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for line := range strings.Lines(buf.String()) {
+			var rec map[string]any
+			if err := json.Unmarshal([]byte(line), &rec); err != nil {
+				t.Fatalf("invalid log line %q: %v", line, err)
+			}
+			if msg := rec["msg"]; (msg == "api_request" || msg == "api_response") && rec["request_id"] != "req-42" {
+				t.Errorf("%s request_id = %v, want req-42", msg, rec["request_id"])
+			}
+		}
+		if !strings.Contains(buf.String(), `"msg":"api_response"`) {
+			t.Errorf("no api_response record in %q", buf.String())
+		}
+	})
+}
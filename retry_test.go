@@ -309,3 +309,133 @@ func TestClient_NoRetryWhenDisabled(t *testing.T) {
 		t.Errorf("got %d attempts, want 1 (retry disabled)", attempts)
 	}
 }
+
+func TestClient_RetryableStatus(t *testing.T) {
+	var attempts int32
+	var codes []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(DeviceListResponse{})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token",
+		WithBaseURL(server.URL),
+		WithRetry(&RetryConfig{
+			MaxRetries:      3,
+			InitialBackoff:  time.Second,
+			MaxBackoff:      time.Second,
+			Multiplier:      2.0,
+			RetryableStatus: func(code int) bool { return code == http.StatusServiceUnavailable },
+			BackoffFor: func(attempt int, code int) time.Duration {
+				codes = append(codes, code)
+				return time.Duration(attempt) * time.Millisecond
+			},
+		}),
+	)
+
+	if _, err := client.ListDevices(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("got %d attempts, want 3", attempts)
+	}
+	if len(codes) != 2 || codes[0] != http.StatusServiceUnavailable {
+		t.Errorf("BackoffFor codes = %v, want two 503s", codes)
+	}
+
+	t.Run("status not listed is not retried", func(t *testing.T) {
+		atomic.StoreInt32(&attempts, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":{"message":"boom"}}`))
+		}))
+		defer server.Close()
+
+		client.baseURL = server.URL
+		if _, err := client.ListDevices(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+		if atomic.LoadInt32(&attempts) != 1 {
+			t.Errorf("got %d attempts, want 1", attempts)
+		}
+	})
+}
+
+func TestClient_RetryDelay(t *testing.T) {
+	newClient := func(cfg *RetryConfig) *Client {
+		client, _ := NewClient("token", WithRetry(cfg))
+		return client
+	}
+	serverErr := &APIError{StatusCode: http.StatusBadGateway, Message: "bad gateway"}
+
+	t.Run("computed backoff", func(t *testing.T) {
+		client := newClient(DefaultRetryConfig())
+		if got := client.retryDelay(1, 200*time.Millisecond, serverErr); got != 200*time.Millisecond {
+			t.Errorf("delay = %v, want 200ms", got)
+		}
+	})
+
+	t.Run("Retry-After takes precedence", func(t *testing.T) {
+		cfg := DefaultRetryConfig()
+		cfg.Jitter = true
+		cfg.BackoffFor = func(int, int) time.Duration { return time.Millisecond }
+		client := newClient(cfg)
+
+		err := &RateLimitError{RetryAfter: 7 * time.Second}
+		if got := client.retryDelay(1, 100*time.Millisecond, err); got != 7*time.Second {
+			t.Errorf("delay = %v, want 7s", got)
+		}
+	})
+
+	t.Run("BackoffFor is capped by MaxBackoff", func(t *testing.T) {
+		cfg := DefaultRetryConfig()
+		cfg.BackoffFor = func(attempt int, code int) time.Duration {
+			if code != http.StatusTooManyRequests {
+				t.Errorf("code = %d, want 429", code)
+			}
+			return time.Minute
+		}
+		client := newClient(cfg)
+
+		if got := client.retryDelay(1, 0, &RateLimitError{}); got != cfg.MaxBackoff {
+			t.Errorf("delay = %v, want %v", got, cfg.MaxBackoff)
+		}
+	})
+
+	t.Run("full jitter", func(t *testing.T) {
+		cfg := DefaultRetryConfig()
+		cfg.Jitter = true
+		client := newClient(cfg)
+
+		for range 100 {
+			if got := client.retryDelay(1, 50*time.Millisecond, serverErr); got < 0 || got > 50*time.Millisecond {
+				t.Fatalf("delay = %v, want within [0, 50ms]", got)
+			}
+		}
+	})
+}
+
+func TestErrorStatusCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{&RateLimitError{}, http.StatusTooManyRequests},
+		{&APIError{StatusCode: http.StatusBadGateway}, http.StatusBadGateway},
+		{ErrUnauthorized, http.StatusUnauthorized},
+		{ErrNotFound, http.StatusNotFound},
+		{ErrDeviceOffline, http.StatusServiceUnavailable},
+		{context.DeadlineExceeded, 0},
+	}
+	for _, tt := range tests {
+		if got := errorStatusCode(tt.err); got != tt.want {
+			t.Errorf("errorStatusCode(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
@@ -25,28 +25,61 @@ type RoomUpdate struct {
 	BackgroundImage string `json:"backgroundImage,omitempty"`
 }
 
-// roomListResponse is the API response for listing rooms.
-type roomListResponse struct {
-	Items []Room `json:"items"`
+// PagedRooms is the response from ListRoomsWithOptions.
+type PagedRooms struct {
+	Items    []Room   `json:"items"`
+	Links    Links    `json:"_links,omitempty"`
+	PageInfo PageInfo `json:"_page,omitempty"`
 }
 
-// ListRooms returns all rooms in a location.
+// ListRooms returns all rooms in a location, fetching every page.
 func (c *Client) ListRooms(ctx context.Context, locationID string) ([]Room, error) {
 	if locationID == "" {
 		return nil, ErrEmptyLocationID
 	}
 
-	data, err := c.get(ctx, "/locations/"+locationID+"/rooms")
+	var allRooms []Room
+	page := 0
+
+	for {
+		resp, err := c.ListRoomsWithOptions(ctx, locationID, &ListOptions{Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		allRooms = append(allRooms, resp.Items...)
+
+		if resp.Links.Next == "" || len(resp.Items) == 0 {
+			break
+		}
+		page++
+	}
+
+	return allRooms, nil
+}
+
+// ListRoomsWithOptions returns a single page of rooms in a location.
+func (c *Client) ListRoomsWithOptions(ctx context.Context, locationID string, opts *ListOptions) (*PagedRooms, error) {
+	if locationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+
+	path := "/locations/" + locationID + "/rooms"
+	if encoded := opts.encode(nil); encoded != "" {
+		path += "?" + encoded
+	}
+
+	data, err := c.get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 
-	var resp roomListResponse
+	var resp PagedRooms
 	if err := json.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse room list: %w (body: %s)", err, truncatePreview(data))
 	}
 
-	return resp.Items, nil
+	return &resp, nil
 }
 
 // GetRoom returns a single room by ID.
@@ -17,7 +17,7 @@ func TestClient_ListRooms(t *testing.T) {
 			if r.Method != http.MethodGet {
 				t.Errorf("method = %q, want GET", r.Method)
 			}
-			resp := roomListResponse{
+			resp := PagedRooms{
 				Items: []Room{
 					{RoomID: "room-1", Name: "Living Room", LocationID: "loc-123"},
 					{RoomID: "room-2", Name: "Kitchen", LocationID: "loc-123"},
@@ -50,7 +50,7 @@ func TestClient_ListRooms(t *testing.T) {
 
 	t.Run("empty list", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			json.NewEncoder(w).Encode(roomListResponse{Items: []Room{}})
+			json.NewEncoder(w).Encode(PagedRooms{Items: []Room{}})
 		}))
 		defer server.Close()
 
@@ -272,3 +272,79 @@ func TestClient_DeleteRoom(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_ListRoomsWithOptions(t *testing.T) {
+	t.Run("sends pagination params", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/locations/loc-123/rooms" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/locations/loc-123/rooms")
+			}
+			q := r.URL.Query()
+			if q.Get("max") != "5" {
+				t.Errorf("max = %q, want %q", q.Get("max"), "5")
+			}
+			if q.Get("page") != "1" {
+				t.Errorf("page = %q, want %q", q.Get("page"), "1")
+			}
+			json.NewEncoder(w).Encode(PagedRooms{Items: []Room{{RoomID: "room-1"}}})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		resp, err := client.ListRoomsWithOptions(context.Background(), "loc-123", &ListOptions{Max: 5, Page: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Items) != 1 {
+			t.Errorf("got %d rooms, want 1", len(resp.Items))
+		}
+	})
+
+	t.Run("empty location ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, err := client.ListRoomsWithOptions(context.Background(), "", nil)
+		if err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.ListRoomsWithOptions(context.Background(), "loc-123", nil); err == nil {
+			t.Error("expected error for invalid JSON")
+		}
+	})
+}
+
+func TestClient_ListRooms_Pagination(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			json.NewEncoder(w).Encode(PagedRooms{
+				Items: []Room{{RoomID: "room-1"}},
+				Links: Links{Next: "next"},
+			})
+			return
+		}
+		if r.URL.Query().Get("page") != "1" {
+			t.Errorf("page = %q, want %q", r.URL.Query().Get("page"), "1")
+		}
+		json.NewEncoder(w).Encode(PagedRooms{Items: []Room{{RoomID: "room-2"}}})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	rooms, err := client.ListRooms(context.Background(), "loc-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rooms) != 2 || calls != 2 {
+		t.Errorf("got %d rooms in %d calls, want 2 in 2", len(rooms), calls)
+	}
+}
@@ -3,6 +3,7 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -15,10 +16,23 @@ type Rule struct {
 	OwnerID           string       `json:"ownerId,omitempty"`
 	OwnerType         string       `json:"ownerType,omitempty"`
 	ExecutionLocation string       `json:"executionLocation,omitempty"`
+	Status            string       `json:"status,omitempty"` // RuleStatusEnabled or RuleStatusDisabled
 	DateCreated       string       `json:"dateCreated,omitempty"`
 	DateUpdated       string       `json:"dateUpdated,omitempty"`
 }
 
+// Rule status values.
+const (
+	RuleStatusEnabled  = "Enabled"
+	RuleStatusDisabled = "Disabled"
+)
+
+// IsEnabled reports whether the rule runs when triggered. Rules without a
+// status are enabled.
+func (r *Rule) IsEnabled() bool {
+	return r.Status == "" || r.Status == RuleStatusEnabled
+}
+
 // RuleAction represents an action within a rule.
 type RuleAction struct {
 	If       *RuleCondition `json:"if,omitempty"`
@@ -104,8 +118,10 @@ type RuleCreate struct {
 
 // RuleUpdate is the request body for updating a rule.
 type RuleUpdate struct {
-	Name    string       `json:"name,omitempty"`
-	Actions []RuleAction `json:"actions,omitempty"`
+	Name       string       `json:"name,omitempty"`
+	Actions    []RuleAction `json:"actions,omitempty"`
+	TimeZoneID string       `json:"timeZoneId,omitempty"`
+	Status     string       `json:"status,omitempty"` // RuleStatusEnabled or RuleStatusDisabled
 }
 
 // ruleListResponse is the API response for listing rules.
@@ -152,26 +168,111 @@ func (c *Client) GetRule(ctx context.Context, ruleID string) (*Rule, error) {
 	return &rule, nil
 }
 
+// WithRuleValidation makes CreateRule run ValidateRule before submitting,
+// returning the local validation error instead of the API's 422 response.
+func WithRuleValidation() Option {
+	return func(c *Client) {
+		c.validateRules = true
+	}
+}
+
+// ValidateRule checks the structural requirements of a rule: a non-empty
+// name, at least one action, and exactly one action type (if, sleep, command,
+// every, or location) per action, including nested actions.
+//
+// All problems are reported at once. The returned error is an
+// errors.Join of the individual issues; it matches ErrEmptyRuleName when the
+// name is missing and ErrInvalidRule for any other issue.
+func ValidateRule(rule *RuleCreate) error {
+	if rule == nil {
+		return ErrEmptyRuleName
+	}
+
+	var errs []error
+	if rule.Name == "" {
+		errs = append(errs, ErrEmptyRuleName)
+	}
+	if len(rule.Actions) == 0 {
+		errs = append(errs, fmt.Errorf("%w: at least one action is required", ErrInvalidRule))
+	}
+	errs = validateRuleActions(errs, "actions", rule.Actions)
+
+	return errors.Join(errs...)
+}
+
+// validateRuleActions appends an error for each malformed action under path.
+func validateRuleActions(errs []error, path string, actions []RuleAction) []error {
+	for i, action := range actions {
+		p := fmt.Sprintf("%s[%d]", path, i)
+
+		kinds := 0
+		for _, set := range []bool{action.If != nil, action.Sleep != nil, action.Command != nil, action.Every != nil, action.Location != nil} {
+			if set {
+				kinds++
+			}
+		}
+		if kinds == 0 {
+			errs = append(errs, fmt.Errorf("%w: %s has no action type (if, sleep, command, every, or location)", ErrInvalidRule, p))
+			continue
+		}
+		if kinds > 1 {
+			errs = append(errs, fmt.Errorf("%w: %s sets %d action types, want exactly one", ErrInvalidRule, p, kinds))
+		}
+
+		if action.If != nil {
+			errs = validateRuleActions(errs, p+".if.then", action.If.Then)
+			errs = validateRuleActions(errs, p+".if.else", action.If.Else)
+		}
+		if action.Every != nil {
+			errs = validateRuleActions(errs, p+".every.actions", action.Every.Actions)
+		}
+	}
+	return errs
+}
+
 // CreateRule creates a new rule.
+// If the client was created with WithRuleValidation, the rule is checked with
+// ValidateRule first and nothing is sent when validation fails.
+// With a context from ContextWithIdempotencyKey, an existing rule with the
+// same name in the location is returned instead of creating a duplicate.
 func (c *Client) CreateRule(ctx context.Context, locationID string, rule *RuleCreate) (*Rule, error) {
 	if locationID == "" {
 		return nil, ErrEmptyLocationID
 	}
+	if c.validateRules {
+		if err := ValidateRule(rule); err != nil {
+			return nil, err
+		}
+	}
 	if rule == nil || rule.Name == "" {
 		return nil, ErrEmptyRuleName
 	}
 
-	data, err := c.post(ctx, "/rules?locationId="+locationID, rule)
-	if err != nil {
-		return nil, err
-	}
-
-	var created Rule
-	if err := json.Unmarshal(data, &created); err != nil {
-		return nil, fmt.Errorf("failed to parse created rule: %w (body: %s)", err, truncatePreview(data))
+	find := func(ctx context.Context) (*Rule, error) {
+		rules, err := c.ListRules(ctx, locationID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rules {
+			if rules[i].Name == rule.Name {
+				return &rules[i], nil
+			}
+		}
+		return nil, nil
 	}
-
-	return &created, nil
+	return createIdempotent(ctx, find, func(ctx context.Context) (*Rule, error) {
+		data, err := c.post(ctx, "/rules?locationId="+locationID, rule)
+		if err != nil {
+			return nil, err
+		}
+
+		var created Rule
+		if err := json.Unmarshal(data, &created); err != nil {
+			return nil, fmt.Errorf("failed to parse created rule: %w (body: %s)", err, truncatePreview(data))
+		}
+
+		return &created, nil
+	})
 }
 
 // UpdateRule updates an existing rule.
@@ -193,6 +294,39 @@ func (c *Client) UpdateRule(ctx context.Context, ruleID string, rule *RuleUpdate
 	return &updated, nil
 }
 
+// EnableRule enables a rule so it runs when triggered. Rules that are
+// already enabled are left unchanged.
+func (c *Client) EnableRule(ctx context.Context, ruleID string) error {
+	return c.setRuleStatus(ctx, ruleID, RuleStatusEnabled)
+}
+
+// DisableRule disables a rule so it no longer runs when triggered, without
+// deleting it. Rules that are already disabled are left unchanged.
+func (c *Client) DisableRule(ctx context.Context, ruleID string) error {
+	return c.setRuleStatus(ctx, ruleID, RuleStatusDisabled)
+}
+
+// setRuleStatus fetches a rule and updates it with status. The Rules API has
+// no separate status endpoint, so the rule's name, actions, and time zone are
+// sent back unchanged.
+func (c *Client) setRuleStatus(ctx context.Context, ruleID, status string) error {
+	rule, err := c.GetRule(ctx, ruleID)
+	if err != nil {
+		return err
+	}
+	if rule.IsEnabled() == (status == RuleStatusEnabled) {
+		return nil
+	}
+
+	_, err = c.UpdateRule(ctx, ruleID, &RuleUpdate{
+		Name:       rule.Name,
+		Actions:    rule.Actions,
+		TimeZoneID: rule.TimeZoneID,
+		Status:     status,
+	})
+	return err
+}
+
 // DeleteRule deletes a rule.
 func (c *Client) DeleteRule(ctx context.Context, ruleID string) error {
 	if ruleID == "" {
@@ -203,6 +337,30 @@ func (c *Client) DeleteRule(ctx context.Context, ruleID string) error {
 	return err
 }
 
+// DeleteAllRules deletes every rule in a location.
+// All rules are attempted even if some deletions fail. Failures are combined
+// with errors.Join, so the returned error unwraps to each individual failure
+// (each annotated with its rule ID).
+func (c *Client) DeleteAllRules(ctx context.Context, locationID string) error {
+	if locationID == "" {
+		return ErrEmptyLocationID
+	}
+
+	rules, err := c.ListRules(ctx, locationID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		if err := c.DeleteRule(ctx, rule.ID); err != nil {
+			errs = append(errs, fmt.Errorf("delete rule %s: %w", rule.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // ExecuteRule manually executes a rule.
 func (c *Client) ExecuteRule(ctx context.Context, ruleID string) error {
 	if ruleID == "" {
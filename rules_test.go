@@ -3,8 +3,10 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -293,3 +295,255 @@ func TestClient_ExecuteRule(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_DeleteAllRules(t *testing.T) {
+	t.Run("deletes every rule and aggregates failures", func(t *testing.T) {
+		var deleted []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/rules":
+				if r.URL.Query().Get("locationId") != "loc-123" {
+					t.Errorf("locationId = %q, want %q", r.URL.Query().Get("locationId"), "loc-123")
+				}
+				json.NewEncoder(w).Encode(ruleListResponse{Items: []Rule{{ID: "rule-1"}, {ID: "rule-2"}, {ID: "rule-3"}}})
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, r.URL.Path)
+				switch r.URL.Path {
+				case "/rules/rule-1":
+					w.WriteHeader(http.StatusNotFound)
+				case "/rules/rule-2":
+					w.WriteHeader(http.StatusForbidden)
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.DeleteAllRules(context.Background(), "loc-123")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if len(deleted) != 3 {
+			t.Errorf("got %d delete requests, want 3", len(deleted))
+		}
+
+		multi, ok := err.(interface{ Unwrap() []error })
+		if !ok {
+			t.Fatalf("error %T does not implement Unwrap() []error", err)
+		}
+		if n := len(multi.Unwrap()); n != 2 {
+			t.Errorf("got %d wrapped errors, want 2", n)
+		}
+		if !IsNotFound(err) {
+			t.Errorf("expected wrapped not found error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "rule-2") {
+			t.Errorf("error %q does not mention rule-2", err)
+		}
+	})
+
+	t.Run("all succeed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(ruleListResponse{Items: []Rule{{ID: "rule-1"}}})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.DeleteAllRules(context.Background(), "loc-123"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.DeleteAllRules(context.Background(), "loc-123"); !IsUnauthorized(err) {
+			t.Errorf("expected unauthorized error, got %v", err)
+		}
+	})
+
+	t.Run("empty location ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.DeleteAllRules(context.Background(), ""); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+}
+
+func TestValidateRule(t *testing.T) {
+	validAction := RuleAction{Location: &RuleLocation{Mode: "mode-1"}}
+
+	tests := []struct {
+		name      string
+		rule      *RuleCreate
+		wantErrs  int
+		wantEmpty bool
+	}{
+		{"valid", &RuleCreate{Name: "Night", Actions: []RuleAction{validAction}}, 0, false},
+		{"nil rule", nil, 1, true},
+		{"missing name and actions", &RuleCreate{}, 2, true},
+		{"action without type", &RuleCreate{Name: "r", Actions: []RuleAction{{}}}, 1, false},
+		{"action with two types", &RuleCreate{Name: "r", Actions: []RuleAction{{Sleep: &RuleSleep{Duration: 5}, Location: &RuleLocation{Mode: "m"}}}}, 1, false},
+		{
+			"nested invalid actions",
+			&RuleCreate{Name: "r", Actions: []RuleAction{{
+				If: &RuleCondition{Then: []RuleAction{{}}, Else: []RuleAction{validAction, {}}},
+			}, {
+				Every: &RuleEvery{Actions: []RuleAction{{}}},
+			}}},
+			3,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRule(tt.rule)
+			if tt.wantErrs == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := errors.Is(err, ErrEmptyRuleName); got != tt.wantEmpty {
+				t.Errorf("errors.Is(err, ErrEmptyRuleName) = %v, want %v", got, tt.wantEmpty)
+			}
+
+			n := 1
+			if multi, ok := err.(interface{ Unwrap() []error }); ok {
+				n = len(multi.Unwrap())
+			}
+			if n != tt.wantErrs {
+				t.Errorf("got %d issues, want %d: %v", n, tt.wantErrs, err)
+			}
+		})
+	}
+
+	t.Run("issues include action path", func(t *testing.T) {
+		err := ValidateRule(&RuleCreate{Name: "r", Actions: []RuleAction{validAction, {}}})
+		if !errors.Is(err, ErrInvalidRule) {
+			t.Fatalf("expected ErrInvalidRule, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "actions[1]") {
+			t.Errorf("error %q does not mention actions[1]", err)
+		}
+	})
+}
+
+func TestWithRuleValidation(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	invalid := &RuleCreate{Name: "r", Actions: []RuleAction{{}}}
+
+	t.Run("enabled", func(t *testing.T) {
+		requests = 0
+		client, _ := NewClient("token", WithBaseURL(server.URL), WithRuleValidation())
+		_, err := client.CreateRule(context.Background(), "loc-123", invalid)
+		if !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("expected ErrInvalidRule, got %v", err)
+		}
+		if requests != 0 {
+			t.Errorf("got %d requests, want 0", requests)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		requests = 0
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		_, err := client.CreateRule(context.Background(), "loc-123", invalid)
+		if err == nil || errors.Is(err, ErrInvalidRule) {
+			t.Errorf("expected API error, got %v", err)
+		}
+		if requests != 1 {
+			t.Errorf("got %d requests, want 1", requests)
+		}
+	})
+}
+
+func TestRule_IsEnabled(t *testing.T) {
+	for status, want := range map[string]bool{"": true, RuleStatusEnabled: true, RuleStatusDisabled: false} {
+		if got := (&Rule{Status: status}).IsEnabled(); got != want {
+			t.Errorf("IsEnabled() with status %q = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestClient_EnableDisableRule(t *testing.T) {
+	const rule = `{"id": "rule-1", "name": "Evening", "timeZoneId": "Europe/London", "status": "%s",
+		"actions": [{"command": {"devices": [{"deviceId": "light-1", "capability": "switch", "command": "on"}]}}]}`
+
+	tests := []struct {
+		name       string
+		status     string
+		toggle     func(*Client, context.Context, string) error
+		wantUpdate string // Expected status sent, or "" for no update
+	}{
+		{"disable enabled rule", RuleStatusEnabled, (*Client).DisableRule, RuleStatusDisabled},
+		{"enable disabled rule", RuleStatusDisabled, (*Client).EnableRule, RuleStatusEnabled},
+		{"enable rule without status", "", (*Client).EnableRule, ""},
+		{"disable disabled rule", RuleStatusDisabled, (*Client).DisableRule, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates []RuleUpdate
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rules/rule-1" {
+					t.Errorf("unexpected path %q", r.URL.Path)
+				}
+				if r.Method == http.MethodPut {
+					var update RuleUpdate
+					json.NewDecoder(r.Body).Decode(&update)
+					updates = append(updates, update)
+				}
+				w.Write([]byte(strings.Replace(rule, "%s", tt.status, 1)))
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := tt.toggle(client, context.Background(), "rule-1"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantUpdate == "" {
+				if len(updates) != 0 {
+					t.Errorf("expected no update, got %+v", updates)
+				}
+				return
+			}
+			if len(updates) != 1 {
+				t.Fatalf("expected 1 update, got %d", len(updates))
+			}
+			u := updates[0]
+			if u.Status != tt.wantUpdate || u.Name != "Evening" || u.TimeZoneID != "Europe/London" || len(u.Actions) != 1 {
+				t.Errorf("update = %+v", u)
+			}
+		})
+	}
+
+	t.Run("empty rule ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.EnableRule(context.Background(), ""); err != ErrEmptyRuleID {
+			t.Errorf("expected ErrEmptyRuleID, got %v", err)
+		}
+	})
+}
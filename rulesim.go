@@ -0,0 +1,267 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+)
+
+// Rule Dry-Run
+//
+// The SmartThings Rules API has no dry-run mode, so TestRule simulates a rule
+// client-side: it evaluates the rule's conditions against current device
+// statuses and reports which actions would run, without executing anything.
+
+// RuleTestResult is the outcome of simulating a rule with TestRule.
+type RuleTestResult struct {
+	RuleID     string                // The simulated rule
+	Conditions []RuleConditionResult // Every "if" condition that was evaluated, in order
+	Actions    []RuleActionResult    // Actions that would run, in order
+}
+
+// RuleConditionResult reports how a single "if" condition evaluated.
+type RuleConditionResult struct {
+	Path    string // Location in the rule, e.g. "actions[0].if"
+	Matched bool   // Whether the condition held
+	Error   string // Why the condition could not be evaluated (Matched is then false)
+}
+
+// RuleActionResult describes an action that would run.
+type RuleActionResult struct {
+	Path   string     // Location in the rule, e.g. "actions[0].if.then[1]"
+	Type   string     // "command", "sleep", "location", or "every"
+	Action RuleAction // The action itself
+}
+
+// TestRule simulates a rule without executing it. Conditions using equals,
+// greaterThan, lessThan, and, and or are evaluated against the current status
+// of the referenced devices; device operands match if any listed device
+// matches, or every device when their aggregation is "All". Operands other
+// than device attributes and string/integer/decimal/boolean literals (e.g.
+// location mode or time) cannot be simulated and are reported with an Error.
+// Actions nested in "every" are simulated as if the schedule fired now.
+//
+// Example:
+//
+//	result, err := client.TestRule(ctx, ruleID)
+//	for _, a := range result.Actions {
+//	    fmt.Printf("%s would run (%s)\n", a.Path, a.Type)
+//	}
+func (c *Client) TestRule(ctx context.Context, ruleID string) (*RuleTestResult, error) {
+	rule, err := c.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &ruleSimulator{
+		client:   c,
+		statuses: make(map[string]Status),
+		result:   &RuleTestResult{RuleID: ruleID},
+	}
+	if err := sim.actions(ctx, "actions", rule.Actions); err != nil {
+		return nil, err
+	}
+	return sim.result, nil
+}
+
+// errRuleUnsupported marks a condition the simulator cannot evaluate.
+type errRuleUnsupported string
+
+func (e errRuleUnsupported) Error() string { return string(e) }
+
+// ruleSimulator walks a rule, caching device statuses per device and component.
+type ruleSimulator struct {
+	client   *Client
+	statuses map[string]Status
+	result   *RuleTestResult
+}
+
+func (s *ruleSimulator) actions(ctx context.Context, path string, actions []RuleAction) error {
+	for i, action := range actions {
+		p := fmt.Sprintf("%s[%d]", path, i)
+
+		switch {
+		case action.If != nil:
+			matched, err := s.condition(ctx, *action.If)
+			res := RuleConditionResult{Path: p + ".if", Matched: matched}
+			if unsupported, ok := err.(errRuleUnsupported); ok {
+				res.Error = string(unsupported)
+			} else if err != nil {
+				return err
+			}
+			s.result.Conditions = append(s.result.Conditions, res)
+
+			branch, next := p+".if.else", action.If.Else
+			if matched {
+				branch, next = p+".if.then", action.If.Then
+			}
+			if err := s.actions(ctx, branch, next); err != nil {
+				return err
+			}
+		case action.Every != nil:
+			s.result.Actions = append(s.result.Actions, RuleActionResult{Path: p, Type: "every", Action: action})
+			if err := s.actions(ctx, p+".every.actions", action.Every.Actions); err != nil {
+				return err
+			}
+		case action.Command != nil:
+			s.result.Actions = append(s.result.Actions, RuleActionResult{Path: p, Type: "command", Action: action})
+		case action.Sleep != nil:
+			s.result.Actions = append(s.result.Actions, RuleActionResult{Path: p, Type: "sleep", Action: action})
+		case action.Location != nil:
+			s.result.Actions = append(s.result.Actions, RuleActionResult{Path: p, Type: "location", Action: action})
+		}
+	}
+	return nil
+}
+
+// condition evaluates every operator set on cond; all of them must hold.
+func (s *ruleSimulator) condition(ctx context.Context, cond RuleCondition) (bool, error) {
+	evaluated := false
+	result := true
+	check := func(ok bool, err error) error {
+		evaluated = true
+		result = result && ok
+		return err
+	}
+
+	if cond.Equals != nil {
+		if err := check(s.compare(ctx, cond.Equals, func(l, r any) bool { return valuesEqual(l, r) })); err != nil {
+			return false, err
+		}
+	}
+	if cond.GreaterThan != nil {
+		if err := check(s.compare(ctx, cond.GreaterThan, func(l, r any) bool { return numericCompare(l, r) > 0 })); err != nil {
+			return false, err
+		}
+	}
+	if cond.LessThan != nil {
+		if err := check(s.compare(ctx, cond.LessThan, func(l, r any) bool { return numericCompare(l, r) < 0 })); err != nil {
+			return false, err
+		}
+	}
+	if len(cond.And) > 0 {
+		for _, sub := range cond.And {
+			if err := check(s.condition(ctx, sub)); err != nil {
+				return false, err
+			}
+		}
+	}
+	if len(cond.Or) > 0 {
+		anyMatched := false
+		for _, sub := range cond.Or {
+			ok, err := s.condition(ctx, sub)
+			if err != nil {
+				return false, err
+			}
+			anyMatched = anyMatched || ok
+		}
+		check(anyMatched, nil)
+	}
+
+	if !evaluated {
+		return false, errRuleUnsupported("condition has no supported operator (equals, greaterThan, lessThan, and, or)")
+	}
+	return result, nil
+}
+
+// numericCompare returns -1, 0, or 1 comparing l to r, or 0 if either is not a number.
+func numericCompare(l, r any) int {
+	fl, okL := toFloat(l)
+	fr, okR := toFloat(r)
+	switch {
+	case !okL || !okR || fl == fr:
+		return 0
+	case fl < fr:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compare resolves the left and right operands of a comparison and applies op.
+func (s *ruleSimulator) compare(ctx context.Context, operation map[string]any, op func(l, r any) bool) (bool, error) {
+	left, _ := operation["left"].(map[string]any)
+	right, _ := operation["right"].(map[string]any)
+	if left == nil || right == nil {
+		return false, errRuleUnsupported("comparison requires left and right operands")
+	}
+
+	lv, lAll, err := s.operand(ctx, left)
+	if err != nil {
+		return false, err
+	}
+	rv, _, err := s.operand(ctx, right)
+	if err != nil {
+		return false, err
+	}
+	if len(rv) != 1 {
+		return false, errRuleUnsupported("right operand must be a single value")
+	}
+
+	matches := 0
+	for _, v := range lv {
+		if op(v, rv[0]) {
+			matches++
+		}
+	}
+	if lAll {
+		return len(lv) > 0 && matches == len(lv), nil
+	}
+	return matches > 0, nil
+}
+
+// operand resolves an operand to its values. all reports whether a device
+// operand requires every device to match.
+func (s *ruleSimulator) operand(ctx context.Context, operand map[string]any) (values []any, all bool, err error) {
+	for _, literal := range []string{"string", "integer", "decimal", "boolean"} {
+		if v, ok := operand[literal]; ok {
+			return []any{v}, false, nil
+		}
+	}
+
+	device, ok := GetMap(operand, "device")
+	if !ok {
+		return nil, false, errRuleUnsupported("unsupported operand (only device attributes and literals can be simulated)")
+	}
+	ids, _ := GetArray(device, "devices")
+	capability, _ := GetString(device, "capability")
+	attribute, _ := GetString(device, "attribute")
+	if len(ids) == 0 || capability == "" || attribute == "" {
+		return nil, false, errRuleUnsupported("device operand requires devices, capability, and attribute")
+	}
+	component, _ := GetString(device, "component")
+	if component == "" {
+		component = "main"
+	}
+	aggregation, _ := GetString(device, "aggregation")
+
+	for _, id := range ToStringSlice(ids) {
+		status, err := s.status(ctx, id, component)
+		if err != nil {
+			return nil, false, err
+		}
+		v, _ := attributeValue(status, capability, attribute)
+		values = append(values, v)
+	}
+	return values, aggregation == "All", nil
+}
+
+// status returns the cached status of a device component, fetching it once.
+func (s *ruleSimulator) status(ctx context.Context, deviceID, component string) (Status, error) {
+	key := deviceID + "/" + component
+	if status, ok := s.statuses[key]; ok {
+		return status, nil
+	}
+
+	var status Status
+	var err error
+	if component == "main" {
+		status, err = s.client.GetDeviceStatus(ctx, deviceID)
+	} else {
+		status, err = s.client.GetComponentStatus(ctx, deviceID, component)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get status of device %s: %w", deviceID, err)
+	}
+	s.statuses[key] = status
+	return status, nil
+}
@@ -0,0 +1,173 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func deviceOperand(capability, attribute string, ids ...string) map[string]any {
+	devices := make([]any, len(ids))
+	for i, id := range ids {
+		devices[i] = id
+	}
+	return map[string]any{"device": map[string]any{
+		"devices":    devices,
+		"component":  "main",
+		"capability": capability,
+		"attribute":  attribute,
+	}}
+}
+
+func TestClient_TestRule(t *testing.T) {
+	rule := Rule{
+		ID:   "rule-1",
+		Name: "Evening",
+		Actions: []RuleAction{
+			{If: &RuleCondition{
+				Equals: map[string]any{
+					"left":  deviceOperand("switch", "switch", "sw-1"),
+					"right": map[string]any{"string": "on"},
+				},
+				Then: []RuleAction{{Command: &RuleCommand{Devices: []RuleDeviceCommand{{DeviceID: "light-1", Capability: "switch", Command: "on"}}}}},
+				Else: []RuleAction{{Sleep: &RuleSleep{Duration: 5}}},
+			}},
+			{If: &RuleCondition{
+				And: []RuleCondition{
+					{GreaterThan: map[string]any{
+						"left":  deviceOperand("temperatureMeasurement", "temperature", "temp-1"),
+						"right": map[string]any{"integer": 25},
+					}},
+					{LessThan: map[string]any{
+						"left":  deviceOperand("temperatureMeasurement", "temperature", "temp-1"),
+						"right": map[string]any{"integer": 20},
+					}},
+				},
+				Then: []RuleAction{{Location: &RuleLocation{Mode: "away"}}},
+			}},
+			{If: &RuleCondition{
+				Equals: map[string]any{
+					"left":  map[string]any{"location": map[string]any{"attribute": "Mode"}},
+					"right": map[string]any{"string": "home"},
+				},
+			}},
+		},
+	}
+
+	statusRequests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rules/rule-1":
+			json.NewEncoder(w).Encode(rule)
+		case "/devices/sw-1/components/main/status":
+			statusRequests["sw-1"]++
+			w.Write([]byte(`{"switch":{"switch":{"value":"on"}}}`))
+		case "/devices/temp-1/components/main/status":
+			statusRequests["temp-1"]++
+			w.Write([]byte(`{"temperatureMeasurement":{"temperature":{"value":22.5,"unit":"C"}}}`))
+		default:
+			if r.Method != http.MethodGet {
+				t.Errorf("simulation must not send %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	result, err := client.TestRule(context.Background(), "rule-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantConditions := []RuleConditionResult{
+		{Path: "actions[0].if", Matched: true},
+		{Path: "actions[1].if", Matched: false},
+		{Path: "actions[2].if", Matched: false},
+	}
+	if len(result.Conditions) != len(wantConditions) {
+		t.Fatalf("got %d conditions, want %d: %+v", len(result.Conditions), len(wantConditions), result.Conditions)
+	}
+	for i, want := range wantConditions {
+		got := result.Conditions[i]
+		if got.Path != want.Path || got.Matched != want.Matched {
+			t.Errorf("Conditions[%d] = %+v, want %+v", i, got, want)
+		}
+	}
+	if result.Conditions[2].Error == "" {
+		t.Error("expected location mode condition to report an Error")
+	}
+
+	if len(result.Actions) != 1 {
+		t.Fatalf("got %d actions, want 1: %+v", len(result.Actions), result.Actions)
+	}
+	if a := result.Actions[0]; a.Path != "actions[0].if.then[0]" || a.Type != "command" {
+		t.Errorf("Actions[0] = %s (%s), want actions[0].if.then[0] (command)", a.Path, a.Type)
+	}
+
+	if statusRequests["temp-1"] != 1 {
+		t.Errorf("temp-1 status fetched %d times, want 1 (cached)", statusRequests["temp-1"])
+	}
+}
+
+func TestRuleSimulator_Aggregation(t *testing.T) {
+	statuses := map[string]Status{
+		"a/main": {"switch": map[string]any{"switch": map[string]any{"value": "on"}}},
+		"b/main": {"switch": map[string]any{"switch": map[string]any{"value": "off"}}},
+	}
+
+	tests := []struct {
+		name        string
+		aggregation string
+		want        bool
+	}{
+		{"any device", "", true},
+		{"all devices", "All", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := deviceOperand("switch", "switch", "a", "b")
+			if tt.aggregation != "" {
+				left["device"].(map[string]any)["aggregation"] = tt.aggregation
+			}
+			sim := &ruleSimulator{statuses: statuses, result: &RuleTestResult{}}
+			got, err := sim.condition(context.Background(), RuleCondition{
+				Equals: map[string]any{"left": left, "right": map[string]any{"string": "on"}},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("condition = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_TestRule_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rules/rule-1" {
+			json.NewEncoder(w).Encode(Rule{ID: "rule-1", Actions: []RuleAction{{If: &RuleCondition{
+				Equals: map[string]any{
+					"left":  deviceOperand("switch", "switch", "gone"),
+					"right": map[string]any{"string": "on"},
+				},
+			}}}})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+
+	if _, err := client.TestRule(context.Background(), ""); err != ErrEmptyRuleID {
+		t.Errorf("expected ErrEmptyRuleID, got %v", err)
+	}
+	if _, err := client.TestRule(context.Background(), "rule-1"); !IsNotFound(err) {
+		t.Errorf("expected not found error for missing device, got %v", err)
+	}
+}
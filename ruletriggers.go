@@ -0,0 +1,207 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Rule trigger types reported in RuleTrigger.Type. Conditions on other
+// operands report the operand's key (e.g. "time" or "date").
+const (
+	RuleTriggerDevice   = "device"   // A device attribute in a condition
+	RuleTriggerLocation = "location" // A location attribute, such as mode, in a condition
+	RuleTriggerEvery    = "every"    // A schedule
+)
+
+// RuleTrigger is something that causes a rule to be evaluated: an operand of
+// an "if" condition other than a literal, or an "every" schedule.
+type RuleTrigger struct {
+	Path       string     // Location in the rule, e.g. "actions[0].if.and[1].equals"
+	Type       string     // RuleTriggerDevice, RuleTriggerLocation, RuleTriggerEvery, or the operand key
+	Operator   string     // "equals", "greaterThan", or "lessThan"; empty for schedules
+	DeviceIDs  []string   // Devices of a device operand
+	Component  string     // Component of a device operand, if set
+	Capability string     // Capability of a device operand
+	Attribute  string     // Attribute of a device or location operand
+	Every      *RuleEvery // The schedule, for RuleTriggerEvery
+}
+
+// Triggers returns the triggers of the rule in the order they appear,
+// including those nested in then, else, and every actions and in and/or
+// conditions.
+//
+// Example:
+//
+//	for _, t := range rule.Triggers() {
+//	    if t.Type == smartthings.RuleTriggerDevice {
+//	        fmt.Printf("%s: %s.%s on %v\n", t.Path, t.Capability, t.Attribute, t.DeviceIDs)
+//	    }
+//	}
+func (r *Rule) Triggers() []RuleTrigger {
+	var triggers []RuleTrigger
+	walkRuleActions("actions", r.Actions, func(path string, action RuleAction) {
+		if action.Every != nil {
+			triggers = append(triggers, RuleTrigger{Path: path + ".every", Type: RuleTriggerEvery, Every: action.Every})
+		}
+		if action.If != nil {
+			triggers = ruleConditionTriggers(triggers, path+".if", *action.If)
+		}
+	})
+	return triggers
+}
+
+// DeviceIDs returns the IDs of every device the rule references, in
+// conditions or in commands, in order of first appearance and without
+// duplicates.
+//
+// Example:
+//
+//	// Which rules reference a device?
+//	for _, rule := range rules {
+//	    if slices.Contains(rule.DeviceIDs(), deviceID) {
+//	        fmt.Println(rule.Name)
+//	    }
+//	}
+func (r *Rule) DeviceIDs() []string {
+	var ids []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	walkRuleActions("actions", r.Actions, func(path string, action RuleAction) {
+		if action.If != nil {
+			for _, t := range ruleConditionTriggers(nil, path+".if", *action.If) {
+				for _, id := range t.DeviceIDs {
+					add(id)
+				}
+			}
+		}
+		if action.Command != nil {
+			for _, d := range action.Command.Devices {
+				add(d.DeviceID)
+			}
+		}
+	})
+	return ids
+}
+
+// ListRulesForDevice returns the rules in a location that reference a
+// device, in a condition or in a command, as reported by Rule.DeviceIDs.
+// Use it to warn before deleting a device that rules depend on.
+//
+// Example:
+//
+//	rules, err := client.ListRulesForDevice(ctx, locationID, deviceID)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, rule := range rules {
+//	    fmt.Printf("rule %q uses this device\n", rule.Name)
+//	}
+func (c *Client) ListRulesForDevice(ctx context.Context, locationID, deviceID string) ([]Rule, error) {
+	if deviceID == "" {
+		return nil, ErrEmptyDeviceID
+	}
+
+	rules, err := c.ListRules(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []Rule
+	for _, rule := range rules {
+		if slices.Contains(rule.DeviceIDs(), deviceID) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched, nil
+}
+
+// walkRuleActions calls fn for each action under path, depth first,
+// including actions nested in then, else, and every.
+func walkRuleActions(path string, actions []RuleAction, fn func(path string, action RuleAction)) {
+	for i, action := range actions {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		fn(p, action)
+		if action.If != nil {
+			walkRuleActions(p+".if.then", action.If.Then, fn)
+			walkRuleActions(p+".if.else", action.If.Else, fn)
+		}
+		if action.Every != nil {
+			walkRuleActions(p+".every.actions", action.Every.Actions, fn)
+		}
+	}
+}
+
+// ruleConditionTriggers appends the triggers of cond and its and/or
+// conditions. Nested then and else actions are left to walkRuleActions.
+func ruleConditionTriggers(triggers []RuleTrigger, path string, cond RuleCondition) []RuleTrigger {
+	for _, op := range []struct {
+		name      string
+		operation map[string]any
+	}{
+		{"equals", cond.Equals},
+		{"greaterThan", cond.GreaterThan},
+		{"lessThan", cond.LessThan},
+	} {
+		for _, side := range []string{"left", "right"} {
+			operand, ok := GetMap(op.operation, side)
+			if !ok {
+				continue
+			}
+			if t, ok := ruleOperandTrigger(operand); ok {
+				t.Path = path + "." + op.name
+				t.Operator = op.name
+				triggers = append(triggers, t)
+			}
+		}
+	}
+	for i, sub := range cond.And {
+		triggers = ruleConditionTriggers(triggers, fmt.Sprintf("%s.and[%d]", path, i), sub)
+	}
+	for i, sub := range cond.Or {
+		triggers = ruleConditionTriggers(triggers, fmt.Sprintf("%s.or[%d]", path, i), sub)
+	}
+	return triggers
+}
+
+// ruleOperandTrigger describes a condition operand. Returns false for
+// literals, which never trigger a rule.
+func ruleOperandTrigger(operand map[string]any) (RuleTrigger, bool) {
+	for _, literal := range []string{"string", "integer", "decimal", "boolean"} {
+		if _, ok := operand[literal]; ok {
+			return RuleTrigger{}, false
+		}
+	}
+
+	if device, ok := GetMap(operand, "device"); ok {
+		t := RuleTrigger{Type: RuleTriggerDevice}
+		switch ids := device["devices"].(type) {
+		case []any:
+			t.DeviceIDs = ToStringSlice(ids)
+		case []string:
+			t.DeviceIDs = append([]string(nil), ids...)
+		}
+		t.Component, _ = GetString(device, "component")
+		t.Capability, _ = GetString(device, "capability")
+		t.Attribute, _ = GetString(device, "attribute")
+		return t, true
+	}
+	if location, ok := GetMap(operand, "location"); ok {
+		t := RuleTrigger{Type: RuleTriggerLocation}
+		t.Attribute, _ = GetString(location, "attribute")
+		return t, true
+	}
+	// Sorted so an operand with several keys is described the same way on
+	// every run.
+	if keys := slices.Sorted(maps.Keys(operand)); len(keys) > 0 {
+		return RuleTrigger{Type: keys[0]}, true
+	}
+	return RuleTrigger{}, false
+}
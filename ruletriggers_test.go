@@ -0,0 +1,190 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+const ruleTriggersJSON = `{
+	"id": "rule-1",
+	"name": "Evening",
+	"actions": [
+		{"if": {
+			"and": [
+				{"equals": {
+					"left": {"device": {"devices": ["motion-1", "motion-2"], "component": "main", "capability": "motionSensor", "attribute": "motion"}},
+					"right": {"string": "active"}
+				}},
+				{"equals": {
+					"left": {"location": {"attribute": "Mode"}},
+					"right": {"string": "Home"}
+				}}
+			],
+			"then": [
+				{"command": {"devices": [{"deviceId": "light-1", "capability": "switch", "command": "on"}]}},
+				{"if": {
+					"greaterThan": {
+						"left": {"device": {"devices": ["temp-1"], "capability": "temperatureMeasurement", "attribute": "temperature"}},
+						"right": {"integer": 25}
+					},
+					"then": [{"command": {"devices": [{"deviceId": "fan-1", "capability": "switch", "command": "on"}]}}]
+				}}
+			],
+			"else": [
+				{"command": {"devices": [{"deviceId": "light-1", "capability": "switch", "command": "off"}]}}
+			]
+		}},
+		{"every": {
+			"specific": {"reference": "Sunset"},
+			"actions": [{"command": {"devices": [{"deviceId": "porch-1", "capability": "switch", "command": "on"}]}}]
+		}}
+	]
+}`
+
+func TestRule_Triggers(t *testing.T) {
+	var rule Rule
+	if err := json.Unmarshal([]byte(ruleTriggersJSON), &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	triggers := rule.Triggers()
+	if len(triggers) != 4 {
+		t.Fatalf("got %d triggers, want 4: %+v", len(triggers), triggers)
+	}
+
+	motion := triggers[0]
+	if motion.Path != "actions[0].if.and[0].equals" || motion.Type != RuleTriggerDevice || motion.Operator != "equals" {
+		t.Errorf("motion trigger = %+v", motion)
+	}
+	if !slices.Equal(motion.DeviceIDs, []string{"motion-1", "motion-2"}) || motion.Component != "main" ||
+		motion.Capability != "motionSensor" || motion.Attribute != "motion" {
+		t.Errorf("motion trigger = %+v", motion)
+	}
+
+	mode := triggers[1]
+	if mode.Path != "actions[0].if.and[1].equals" || mode.Type != RuleTriggerLocation || mode.Attribute != "Mode" || mode.DeviceIDs != nil {
+		t.Errorf("mode trigger = %+v", mode)
+	}
+
+	temp := triggers[2]
+	if temp.Path != "actions[0].if.then[1].if.greaterThan" || temp.Operator != "greaterThan" || !slices.Equal(temp.DeviceIDs, []string{"temp-1"}) {
+		t.Errorf("temperature trigger = %+v", temp)
+	}
+
+	every := triggers[3]
+	if every.Path != "actions[1].every" || every.Type != RuleTriggerEvery || every.Every == nil || every.Every.Specific.Reference != "Sunset" {
+		t.Errorf("every trigger = %+v", every)
+	}
+}
+
+func TestRule_Triggers_OperandTypes(t *testing.T) {
+	rule := Rule{Actions: []RuleAction{{If: &RuleCondition{
+		Or: []RuleCondition{
+			{LessThan: map[string]any{
+				"left":  map[string]any{"time": map[string]any{"reference": "Now"}},
+				"right": map[string]any{"time": map[string]any{"reference": "Sunrise"}},
+			}},
+			{Equals: map[string]any{
+				"left":  map[string]any{"device": map[string]any{"devices": []string{"dev-1"}, "capability": "switch", "attribute": "switch"}},
+				"right": map[string]any{"device": map[string]any{"devices": []string{"dev-2"}, "capability": "switch", "attribute": "switch"}},
+			}},
+		},
+	}}}}
+
+	var got []string
+	for _, tr := range rule.Triggers() {
+		got = append(got, tr.Path+" "+tr.Type)
+	}
+	want := []string{
+		"actions[0].if.or[0].lessThan time",
+		"actions[0].if.or[0].lessThan time",
+		"actions[0].if.or[1].equals device",
+		"actions[0].if.or[1].equals device",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("triggers = %q, want %q", got, want)
+	}
+	if ids := rule.DeviceIDs(); !slices.Equal(ids, []string{"dev-1", "dev-2"}) {
+		t.Errorf("DeviceIDs() = %v", ids)
+	}
+}
+
+func TestRule_Triggers_MultiKeyOperand(t *testing.T) {
+	rule := Rule{Actions: []RuleAction{{If: &RuleCondition{
+		GreaterThan: map[string]any{
+			"left":  map[string]any{"time": map[string]any{"reference": "Now"}, "date": map[string]any{"reference": "Today"}, "array": []any{}},
+			"right": map[string]any{"integer": 5},
+		},
+	}}}}
+
+	for range 20 {
+		triggers := rule.Triggers()
+		if len(triggers) != 1 || triggers[0].Type != "array" {
+			t.Fatalf("triggers = %+v, want one of type %q", triggers, "array")
+		}
+	}
+}
+
+func TestRule_DeviceIDs(t *testing.T) {
+	var rule Rule
+	if err := json.Unmarshal([]byte(ruleTriggersJSON), &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"motion-1", "motion-2", "light-1", "temp-1", "fan-1", "porch-1"}
+	if got := rule.DeviceIDs(); !slices.Equal(got, want) {
+		t.Errorf("DeviceIDs() = %v, want %v", got, want)
+	}
+
+	if ids := (&Rule{}).DeviceIDs(); len(ids) != 0 {
+		t.Errorf("empty rule DeviceIDs() = %v", ids)
+	}
+}
+
+func TestClient_ListRulesForDevice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rules" || r.URL.Query().Get("locationId") != "loc-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"items": [` + ruleTriggersJSON + `,
+			{"id": "rule-2", "name": "Other", "actions": [{"command": {"devices": [{"deviceId": "other-1", "capability": "switch", "command": "on"}]}}]},
+			{"id": "rule-3", "name": "Fan Only", "actions": [{"command": {"devices": [{"deviceId": "fan-1", "capability": "switch", "command": "off"}]}}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	tests := []struct {
+		deviceID string
+		want     []string
+	}{
+		{"motion-1", []string{"rule-1"}}, // condition only
+		{"fan-1", []string{"rule-1", "rule-3"}},
+		{"unused", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.deviceID, func(t *testing.T) {
+			rules, err := client.ListRulesForDevice(context.Background(), "loc-1", tt.deviceID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var ids []string
+			for _, rule := range rules {
+				ids = append(ids, rule.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("rules = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	t.Run("empty device ID", func(t *testing.T) {
+		if _, err := client.ListRulesForDevice(context.Background(), "loc-1", ""); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
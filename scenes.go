@@ -3,7 +3,11 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
 )
 
 // Scene represents a SmartThings scene.
@@ -21,17 +25,62 @@ type Scene struct {
 	APIOnly          bool   `json:"apiOnly,omitempty"`
 }
 
-// sceneListResponse is the API response for listing scenes.
-type sceneListResponse struct {
-	Items []Scene `json:"items"`
+// SceneExecutionResult is the response from executing a scene.
+// The API currently reports only an overall Status (e.g. "success"); Devices
+// is populated when the response includes per-device results.
+type SceneExecutionResult struct {
+	Status  string              `json:"status,omitempty"`
+	Devices []SceneDeviceResult `json:"devices,omitempty"`
 }
 
-// ListScenes returns all scenes for a location.
+// SceneDeviceResult is the outcome of a scene action on a single device.
+type SceneDeviceResult struct {
+	DeviceID string `json:"deviceId"`
+	Status   string `json:"status,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// PagedScenes is the response from ListScenesWithOptions.
+type PagedScenes struct {
+	Items    []Scene  `json:"items"`
+	Links    Links    `json:"_links,omitempty"`
+	PageInfo PageInfo `json:"_page,omitempty"`
+}
+
+// ListScenes returns all scenes for a location, fetching every page.
 // If locationID is empty, returns scenes for all locations.
 func (c *Client) ListScenes(ctx context.Context, locationID string) ([]Scene, error) {
-	path := "/scenes"
+	var allScenes []Scene
+	page := 0
+
+	for {
+		resp, err := c.ListScenesWithOptions(ctx, locationID, &ListOptions{Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		allScenes = append(allScenes, resp.Items...)
+
+		if resp.Links.Next == "" || len(resp.Items) == 0 {
+			break
+		}
+		page++
+	}
+
+	return allScenes, nil
+}
+
+// ListScenesWithOptions returns a single page of scenes for a location.
+// If locationID is empty, returns scenes for all locations.
+func (c *Client) ListScenesWithOptions(ctx context.Context, locationID string, opts *ListOptions) (*PagedScenes, error) {
+	params := url.Values{}
 	if locationID != "" {
-		path += "?locationId=" + locationID
+		params.Set("locationId", locationID)
+	}
+
+	path := "/scenes"
+	if encoded := opts.encode(params); encoded != "" {
+		path += "?" + encoded
 	}
 
 	data, err := c.get(ctx, path)
@@ -39,12 +88,12 @@ func (c *Client) ListScenes(ctx context.Context, locationID string) ([]Scene, er
 		return nil, err
 	}
 
-	var resp sceneListResponse
+	var resp PagedScenes
 	if err := json.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse scene list: %w (body: %s)", err, truncatePreview(data))
 	}
 
-	return resp.Items, nil
+	return &resp, nil
 }
 
 // GetScene returns a single scene by ID.
@@ -75,3 +124,141 @@ func (c *Client) ExecuteScene(ctx context.Context, sceneID string) error {
 	_, err := c.post(ctx, "/scenes/"+sceneID+"/execute", nil)
 	return err
 }
+
+// ExecuteSceneWithResult executes a scene and returns the execution result
+// reported by the API. An empty response body yields an empty result.
+func (c *Client) ExecuteSceneWithResult(ctx context.Context, sceneID string) (*SceneExecutionResult, error) {
+	if sceneID == "" {
+		return nil, ErrEmptySceneID
+	}
+
+	data, err := c.post(ctx, "/scenes/"+sceneID+"/execute", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SceneExecutionResult
+	if len(data) == 0 {
+		return &result, nil
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse scene execution result: %w (body: %s)", err, truncatePreview(data))
+	}
+
+	return &result, nil
+}
+
+// SceneCreate describes a scene assembled by BuildSceneFromDevices.
+//
+// The public SmartThings API can list and execute scenes but not create them,
+// so a SceneCreate cannot be saved as a scene. Instead, replay it with
+// ExecuteCommandsBatch(ctx, scene.Devices, nil), or embed it in a rule with
+// RuleActions.
+type SceneCreate struct {
+	SceneName  string         `json:"sceneName,omitempty"`
+	LocationID string         `json:"locationId"`
+	Devices    []BatchCommand `json:"devices"` // Commands that restore each device's captured state
+}
+
+// RuleActions converts the scene into a single rule command action, suitable
+// for RuleCreate.Actions or an if/then branch.
+func (s *SceneCreate) RuleActions() []RuleAction {
+	var commands []RuleDeviceCommand
+	for _, device := range s.Devices {
+		for _, cmd := range device.Commands {
+			commands = append(commands, RuleDeviceCommand{
+				DeviceID:   device.DeviceID,
+				Component:  cmd.Component,
+				Capability: cmd.Capability,
+				Command:    cmd.Command,
+				Arguments:  cmd.Arguments,
+			})
+		}
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+	return []RuleAction{{Command: &RuleCommand{Devices: commands}}}
+}
+
+// BuildSceneFromDevices snapshots the current state of the given devices into
+// a SceneCreate. For every component with a switch, it captures the switch
+// state and, if the switch is on, its switchLevel level and colorControl hue
+// and saturation. Components and devices without a switch are skipped.
+// Statuses are fetched concurrently; if any device's status cannot be
+// fetched, the errors are joined and returned.
+//
+// Example:
+//
+//	scene, err := client.BuildSceneFromDevices(ctx, locationID, []string{lampID, stripID})
+//	// Later, restore the snapshot:
+//	results := client.ExecuteCommandsBatch(ctx, scene.Devices, nil)
+func (c *Client) BuildSceneFromDevices(ctx context.Context, locationID string, deviceIDs []string) (*SceneCreate, error) {
+	if locationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+	if len(deviceIDs) == 0 {
+		return nil, ErrEmptyDeviceID
+	}
+
+	scene := &SceneCreate{LocationID: locationID}
+	var errs []error
+	for _, r := range c.GetDeviceStatusBatch(ctx, deviceIDs, nil) {
+		if r.Error != nil {
+			errs = append(errs, fmt.Errorf("device %s: %w", r.DeviceID, r.Error))
+			continue
+		}
+
+		var commands []Command
+		for _, component := range componentOrder(r.Components) {
+			commands = append(commands, sceneCommands(component, r.Components[component])...)
+		}
+		if len(commands) > 0 {
+			scene.Devices = append(scene.Devices, BatchCommand{DeviceID: r.DeviceID, Commands: commands})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return scene, nil
+}
+
+// componentOrder returns component IDs sorted, with "main" first.
+func componentOrder(components map[string]Status) []string {
+	ids := slices.Sorted(maps.Keys(components))
+	if i := slices.Index(ids, "main"); i > 0 {
+		ids = slices.Insert(slices.Delete(ids, i, i+1), 0, "main")
+	}
+	return ids
+}
+
+// sceneCommands returns the commands that restore a component's switch,
+// level, and color state.
+func sceneCommands(component string, status Status) []Command {
+	// Path: switch.switch.value
+	state, ok := GetString(status, "switch", "switch", "value")
+	if !ok {
+		return nil
+	}
+	if state != "on" {
+		return []Command{NewComponentCommand(component, "switch", "off")}
+	}
+
+	commands := []Command{NewComponentCommand(component, "switch", "on")}
+
+	// Path: switchLevel.level.value
+	if level, ok := GetInt(status, "switchLevel", "level", "value"); ok {
+		commands = append(commands, NewComponentCommand(component, "switchLevel", "setLevel", level))
+	}
+
+	// Path: colorControl.hue.value, colorControl.saturation.value
+	hue, hasHue := GetFloat(status, "colorControl", "hue", "value")
+	saturation, hasSaturation := GetFloat(status, "colorControl", "saturation", "value")
+	if hasHue && hasSaturation {
+		color := map[string]any{"hue": hue, "saturation": saturation}
+		commands = append(commands, NewComponentCommand(component, "colorControl", "setColor", color))
+	}
+
+	return commands
+}
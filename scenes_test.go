@@ -3,6 +3,7 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -20,7 +21,7 @@ func TestClient_ListScenes(t *testing.T) {
 			if r.Method != http.MethodGet {
 				t.Errorf("method = %q, want GET", r.Method)
 			}
-			resp := sceneListResponse{
+			resp := PagedScenes{
 				Items: []Scene{
 					{SceneID: "scene-1", SceneName: "Good Morning", LocationID: "loc-123"},
 					{SceneID: "scene-2", SceneName: "Good Night", LocationID: "loc-123"},
@@ -48,7 +49,7 @@ func TestClient_ListScenes(t *testing.T) {
 			if r.URL.Query().Get("locationId") != "" {
 				t.Errorf("locationId query should be empty, got %q", r.URL.Query().Get("locationId"))
 			}
-			resp := sceneListResponse{
+			resp := PagedScenes{
 				Items: []Scene{
 					{SceneID: "scene-1", SceneName: "Scene 1"},
 				},
@@ -69,7 +70,7 @@ func TestClient_ListScenes(t *testing.T) {
 
 	t.Run("empty list", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			json.NewEncoder(w).Encode(sceneListResponse{Items: []Scene{}})
+			json.NewEncoder(w).Encode(PagedScenes{Items: []Scene{}})
 		}))
 		defer server.Close()
 
@@ -190,3 +191,270 @@ func TestClient_ExecuteScene(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_ListScenesWithOptions(t *testing.T) {
+	t.Run("sends pagination params", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("locationId") != "loc-123" {
+				t.Errorf("locationId = %q, want %q", q.Get("locationId"), "loc-123")
+			}
+			if q.Get("max") != "10" {
+				t.Errorf("max = %q, want %q", q.Get("max"), "10")
+			}
+			if q.Get("page") != "2" {
+				t.Errorf("page = %q, want %q", q.Get("page"), "2")
+			}
+			json.NewEncoder(w).Encode(PagedScenes{
+				Items: []Scene{{SceneID: "scene-1"}},
+				Links: Links{Next: "https://api.smartthings.com/scenes?page=3"},
+			})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		resp, err := client.ListScenesWithOptions(context.Background(), "loc-123", &ListOptions{Max: 10, Page: 2})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Items) != 1 {
+			t.Errorf("got %d scenes, want 1", len(resp.Items))
+		}
+		if resp.Links.Next == "" {
+			t.Error("expected next link")
+		}
+	})
+
+	t.Run("nil options", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.RawQuery != "" {
+				t.Errorf("query = %q, want empty", r.URL.RawQuery)
+			}
+			json.NewEncoder(w).Encode(PagedScenes{})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.ListScenesWithOptions(context.Background(), "", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.ListScenesWithOptions(context.Background(), "", nil); err == nil {
+			t.Error("expected error for invalid JSON")
+		}
+	})
+}
+
+func TestClient_ListScenes_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			json.NewEncoder(w).Encode(PagedScenes{
+				Items: []Scene{{SceneID: "scene-1"}, {SceneID: "scene-2"}},
+				Links: Links{Next: "next"},
+			})
+		case "1":
+			json.NewEncoder(w).Encode(PagedScenes{Items: []Scene{{SceneID: "scene-3"}}})
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	scenes, err := client.ListScenes(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(scenes) != 3 {
+		t.Fatalf("got %d scenes, want 3", len(scenes))
+	}
+	if scenes[2].SceneID != "scene-3" {
+		t.Errorf("scenes[2].SceneID = %q, want %q", scenes[2].SceneID, "scene-3")
+	}
+}
+
+func TestClient_ExecuteSceneWithResult(t *testing.T) {
+	t.Run("status only", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/scenes/scene-123/execute" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/scenes/scene-123/execute")
+			}
+			if r.Method != http.MethodPost {
+				t.Errorf("method = %q, want POST", r.Method)
+			}
+			w.Write([]byte(`{"status":"success"}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		result, err := client.ExecuteSceneWithResult(context.Background(), "scene-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Status != "success" {
+			t.Errorf("Status = %q, want %q", result.Status, "success")
+		}
+		if len(result.Devices) != 0 {
+			t.Errorf("got %d device results, want 0", len(result.Devices))
+		}
+	})
+
+	t.Run("per-device results", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"status":"partial","devices":[{"deviceId":"d1","status":"success"},{"deviceId":"d2","status":"failed","error":"offline"}]}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		result, err := client.ExecuteSceneWithResult(context.Background(), "scene-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Devices) != 2 {
+			t.Fatalf("got %d device results, want 2", len(result.Devices))
+		}
+		if result.Devices[1].DeviceID != "d2" || result.Devices[1].Error != "offline" {
+			t.Errorf("Devices[1] = %+v, want d2 with error offline", result.Devices[1])
+		}
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		result, err := client.ExecuteSceneWithResult(context.Background(), "scene-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result == nil || result.Status != "" {
+			t.Errorf("result = %+v, want empty result", result)
+		}
+	})
+
+	t.Run("empty scene ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		_, err := client.ExecuteSceneWithResult(context.Background(), "")
+		if err != ErrEmptySceneID {
+			t.Errorf("expected ErrEmptySceneID, got %v", err)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("not json"))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.ExecuteSceneWithResult(context.Background(), "scene-123"); err == nil {
+			t.Error("expected error for invalid JSON")
+		}
+	})
+}
+
+func TestClient_BuildSceneFromDevices(t *testing.T) {
+	statuses := map[string]string{
+		"/devices/lamp/status": `{"components":{"main":{
+			"switch":{"switch":{"value":"on"}},
+			"switchLevel":{"level":{"value":60}},
+			"colorControl":{"hue":{"value":30},"saturation":{"value":80}}
+		}}}`,
+		"/devices/strip/status": `{"components":{
+			"segment2":{"switch":{"switch":{"value":"on"}}},
+			"main":{"switch":{"switch":{"value":"off"}},"switchLevel":{"level":{"value":10}}}
+		}}`,
+		"/devices/sensor/status": `{"components":{"main":{"temperatureMeasurement":{"temperature":{"value":21}}}}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := statuses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+
+	t.Run("captures switch, level, and color", func(t *testing.T) {
+		scene, err := client.BuildSceneFromDevices(context.Background(), "loc1", []string{"lamp", "strip", "sensor"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if scene.LocationID != "loc1" {
+			t.Errorf("LocationID = %q, want loc1", scene.LocationID)
+		}
+		if len(scene.Devices) != 2 {
+			t.Fatalf("expected 2 devices (sensor skipped), got %+v", scene.Devices)
+		}
+
+		lamp := scene.Devices[0]
+		if lamp.DeviceID != "lamp" || len(lamp.Commands) != 3 {
+			t.Fatalf("unexpected lamp commands: %+v", lamp)
+		}
+		if lamp.Commands[0].Command != "on" || lamp.Commands[1].Command != "setLevel" || lamp.Commands[2].Command != "setColor" {
+			t.Errorf("unexpected lamp command order: %+v", lamp.Commands)
+		}
+		if lamp.Commands[1].Arguments[0] != 60 {
+			t.Errorf("setLevel argument = %v, want 60", lamp.Commands[1].Arguments[0])
+		}
+		color := lamp.Commands[2].Arguments[0].(map[string]any)
+		if color["hue"] != 30.0 || color["saturation"] != 80.0 {
+			t.Errorf("unexpected color: %v", color)
+		}
+
+		strip := scene.Devices[1]
+		if len(strip.Commands) != 2 {
+			t.Fatalf("unexpected strip commands: %+v", strip.Commands)
+		}
+		// main comes first and, being off, only records the switch state
+		if strip.Commands[0].Component != "main" || strip.Commands[0].Command != "off" {
+			t.Errorf("strip.Commands[0] = %+v, want main off", strip.Commands[0])
+		}
+		if strip.Commands[1].Component != "segment2" || strip.Commands[1].Command != "on" {
+			t.Errorf("strip.Commands[1] = %+v, want segment2 on", strip.Commands[1])
+		}
+
+		actions := scene.RuleActions()
+		if len(actions) != 1 || actions[0].Command == nil || len(actions[0].Command.Devices) != 5 {
+			t.Fatalf("unexpected rule actions: %+v", actions)
+		}
+		if err := ValidateRule(&RuleCreate{Name: "Restore", Actions: actions}); err != nil {
+			t.Errorf("rule actions should validate: %v", err)
+		}
+	})
+
+	t.Run("status failure", func(t *testing.T) {
+		_, err := client.BuildSceneFromDevices(context.Background(), "loc1", []string{"lamp", "missing"})
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := client.BuildSceneFromDevices(context.Background(), "", []string{"lamp"}); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+		if _, err := client.BuildSceneFromDevices(context.Background(), "loc1", nil); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("empty scene has no rule actions", func(t *testing.T) {
+		if actions := (&SceneCreate{}).RuleActions(); actions != nil {
+			t.Errorf("expected nil, got %+v", actions)
+		}
+	})
+}
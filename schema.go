@@ -1,10 +1,15 @@
 package smartthings
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"time"
 )
 
 // SchemaApp represents a SmartThings Schema (C2C) connector.
@@ -288,3 +293,170 @@ func (c *Client) DeleteInstalledSchemaApp(ctx context.Context, isaID string) err
 	_, err := c.delete(ctx, "/schema/installedapps/"+isaID)
 	return err
 }
+
+// SchemaCallback is where a Schema connector reports device state for one
+// installed connector. SmartThings sends both values to the connector's
+// webhook in the grantCallbackAccess interaction: the state callback URL in
+// callbackUrls.stateCallback, and the access token from exchanging the code
+// in callbackAuthentication at callbackUrls.oauthToken.
+type SchemaCallback struct {
+	StateCallbackURL string
+	AccessToken      string
+}
+
+// SchemaDeviceState is the state of one connector device in a state callback.
+type SchemaDeviceState struct {
+	ExternalDeviceID string                 `json:"externalDeviceId"`
+	DeviceCookie     map[string]any         `json:"deviceCookie,omitempty"`
+	States           []SchemaAttributeState `json:"states"`
+}
+
+// SchemaAttributeState is the value of one attribute in a SchemaDeviceState.
+type SchemaAttributeState struct {
+	Component  string         `json:"component"`  // Usually "main"
+	Capability string         `json:"capability"` // Schema capability ID, e.g. "st.switch"
+	Attribute  string         `json:"attribute"`
+	Value      any            `json:"value"`
+	Unit       string         `json:"unit,omitempty"`
+	Data       map[string]any `json:"data,omitempty"`
+	Timestamp  int64          `json:"timestamp,omitempty"` // Milliseconds since the epoch
+}
+
+// schemaStateCallback is the request body of a stateCallback interaction.
+type schemaStateCallback struct {
+	Headers struct {
+		Schema          string `json:"schema"`
+		Version         string `json:"version"`
+		InteractionType string `json:"interactionType"`
+		RequestID       string `json:"requestId"`
+	} `json:"headers"`
+	Authentication struct {
+		TokenType string `json:"tokenType"`
+		Token     string `json:"token"`
+	} `json:"authentication"`
+	DeviceState []SchemaDeviceState `json:"deviceState"`
+}
+
+// PushSchemaDeviceState reports device state changes for a Schema (C2C)
+// connector with a stateCallback interaction. SmartThings has no REST
+// endpoint for pushing state by app ID: connectors post state to the
+// callback URL of each installed connector instead, authenticated with that
+// installation's callback access token, so this takes a SchemaCallback. Only
+// use URLs received in a grantCallbackAccess request your webhook has
+// verified. The request is sent through the client's HTTP transport, so
+// WithHTTPClient, WithDryRun, WithRecorder, WithMetrics, and WithSlog apply,
+// but without the client's API token; the recorded body has the callback
+// access token redacted.
+//
+// Nothing is sent if states is empty. Callback access tokens expire; when
+// the request is rejected as unauthorized, refresh the token at the
+// oauthToken callback URL and retry.
+//
+// Example:
+//
+//	err := client.PushSchemaDeviceState(ctx, callback, []smartthings.SchemaDeviceState{{
+//	    ExternalDeviceID: "lamp-1",
+//	    States: []smartthings.SchemaAttributeState{
+//	        {Component: "main", Capability: "st.switch", Attribute: "switch", Value: "on"},
+//	    },
+//	}})
+func (c *Client) PushSchemaDeviceState(ctx context.Context, callback SchemaCallback, states []SchemaDeviceState) error {
+	if callback.StateCallbackURL == "" || callback.AccessToken == "" {
+		return ErrInvalidSchemaCallback
+	}
+	for i, state := range states {
+		if state.ExternalDeviceID == "" {
+			return fmt.Errorf("%w: device state %d", ErrEmptyExternalDeviceID, i)
+		}
+	}
+	if len(states) == 0 {
+		return nil
+	}
+
+	var body schemaStateCallback
+	body.Headers.Schema = "st-schema"
+	body.Headers.Version = "1.0"
+	body.Headers.InteractionType = "stateCallback"
+	body.Headers.RequestID = newSchemaRequestID()
+	body.Authentication.TokenType = "Bearer"
+	body.Authentication.Token = callback.AccessToken
+	body.DeviceState = states
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("PushSchemaDeviceState: marshal request: %w", err)
+	}
+
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.StateCallbackURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("PushSchemaDeviceState: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.UserAgent())
+	if id := c.requestID(ctx); id != "" {
+		req.Header.Set(RequestIDHeader, id)
+	}
+
+	path := req.URL.Path
+	if c.skipDryRun(ctx, http.MethodPost, path) {
+		return nil
+	}
+
+	var recorded []byte
+	if c.recorder != nil {
+		body.Authentication.Token = RedactedValue
+		recorded, _ = json.Marshal(body)
+	}
+
+	if c.logHooks {
+		c.LogRequest(ctx, http.MethodPost, path)
+	}
+	start := time.Now()
+	resp, err := c.callbackHTTPClient().Do(req)
+	if err != nil {
+		c.record(req, recorded, nil, nil, start, err)
+		c.observeRequest(http.MethodPost, path, 0, start)
+		c.logResponse(ctx, http.MethodPost, path, 0, start, err)
+		return fmt.Errorf("PushSchemaDeviceState: execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	c.record(req, recorded, resp, respBody, start, err)
+	c.observeRequest(http.MethodPost, path, resp.StatusCode, start)
+	c.logResponse(ctx, http.MethodPost, path, resp.StatusCode, start, err)
+	if err != nil {
+		return fmt.Errorf("PushSchemaDeviceState: read response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return c.handleError(resp.StatusCode, respBody, resp.Header)
+	}
+	return nil
+}
+
+// callbackHTTPClient returns the configured HTTP client for requests to
+// URLs other than the API, with OAuthClient's token-injecting transport
+// removed so the API token is never sent.
+func (c *Client) callbackHTTPClient() *http.Client {
+	rt, ok := c.httpClient.Transport.(*tokenRefreshTransport)
+	if !ok {
+		return c.httpClient
+	}
+	client := *c.httpClient
+	client.Transport = rt.base
+	return &client
+}
+
+// newSchemaRequestID returns a random version 4 UUID for an interaction's
+// requestId header.
+func newSchemaRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
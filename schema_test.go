@@ -1,10 +1,15 @@
 package smartthings
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_ListSchemaApps(t *testing.T) {
@@ -492,3 +497,155 @@ func TestClient_DeleteInstalledSchemaApp(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_PushSchemaDeviceState(t *testing.T) {
+	states := []SchemaDeviceState{{
+		ExternalDeviceID: "lamp-1",
+		States: []SchemaAttributeState{
+			{Component: "main", Capability: "st.switch", Attribute: "switch", Value: "on"},
+			{Component: "main", Capability: "st.switchLevel", Attribute: "level", Value: 80, Unit: "%"},
+		},
+	}}
+
+	t.Run("posts a stateCallback interaction", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/callback" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			if auth := r.Header.Get("Authorization"); auth != "" {
+				t.Errorf("API token sent to callback URL: %q", auth)
+			}
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("invalid body: %v", err)
+			}
+			if v, _ := GetString(body, "headers", "interactionType"); v != "stateCallback" {
+				t.Errorf("interactionType = %q", v)
+			}
+			if v, _ := GetString(body, "headers", "schema"); v != "st-schema" {
+				t.Errorf("schema = %q", v)
+			}
+			if v, _ := GetString(body, "headers", "requestId"); len(v) != 36 {
+				t.Errorf("requestId = %q, want a UUID", v)
+			}
+			if v, _ := GetString(body, "authentication", "token"); v != "callback-token" {
+				t.Errorf("token = %q", v)
+			}
+			devices, _ := body["deviceState"].([]any)
+			if len(devices) != 1 {
+				t.Fatalf("deviceState = %v", body["deviceState"])
+			}
+			device := devices[0].(map[string]any)
+			if device["externalDeviceId"] != "lamp-1" || len(device["states"].([]any)) != 2 {
+				t.Errorf("device state = %v", device)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("api-token")
+		callback := SchemaCallback{StateCallbackURL: server.URL + "/callback", AccessToken: "callback-token"}
+		if err := client.PushSchemaDeviceState(context.Background(), callback, states); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("api-token")
+		callback := SchemaCallback{StateCallbackURL: server.URL, AccessToken: "expired"}
+		if err := client.PushSchemaDeviceState(context.Background(), callback, states); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+
+	t.Run("dry run sends nothing", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s in dry-run mode", r.Method, r.URL.Path)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("api-token", WithDryRun())
+		callback := SchemaCallback{StateCallbackURL: server.URL + "/callback", AccessToken: "callback-token"}
+		if err := client.PushSchemaDeviceState(context.Background(), callback, states); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("uses the configured HTTP client", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Proxy-Auth") != "secret" {
+				t.Error("request did not go through the injected transport")
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		transport := &headerTransport{}
+		httpClient := &http.Client{Transport: transport}
+		var buf bytes.Buffer
+		client, _ := NewClient("api-token", WithHTTPClient(httpClient), WithRecorder(&buf))
+		callback := SchemaCallback{StateCallbackURL: server.URL + "/callback", AccessToken: "callback-token"}
+		if err := client.PushSchemaDeviceState(context.Background(), callback, states); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if transport.calls.Load() != 1 {
+			t.Errorf("transport calls = %d, want 1", transport.calls.Load())
+		}
+
+		var entry RecordedInteraction
+		if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid recording %q: %v", buf.String(), err)
+		}
+		if entry.StatusCode != http.StatusAccepted {
+			t.Errorf("recorded status = %d, want %d", entry.StatusCode, http.StatusAccepted)
+		}
+		if strings.Contains(entry.RequestBody, "callback-token") || !strings.Contains(entry.RequestBody, RedactedValue) {
+			t.Errorf("recorded body does not redact the callback token: %s", entry.RequestBody)
+		}
+	})
+
+	t.Run("OAuth client does not send its token", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth := r.Header.Get("Authorization"); auth != "" {
+				t.Errorf("API token sent to callback URL: %q", auth)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		store := NewMemoryTokenStore()
+		store.SaveTokens(context.Background(), &TokenResponse{
+			AccessToken:  "oauth-token",
+			RefreshToken: "refresh",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		})
+		client, err := NewOAuthClient(&OAuthConfig{ClientID: "id", ClientSecret: "secret"}, store)
+		if err != nil {
+			t.Fatalf("NewOAuthClient failed: %v", err)
+		}
+		callback := SchemaCallback{StateCallbackURL: server.URL + "/callback", AccessToken: "callback-token"}
+		if err := client.PushSchemaDeviceState(context.Background(), callback, states); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("api-token")
+		ctx := context.Background()
+		if err := client.PushSchemaDeviceState(ctx, SchemaCallback{StateCallbackURL: "https://c2c.example"}, states); err != ErrInvalidSchemaCallback {
+			t.Errorf("expected ErrInvalidSchemaCallback, got %v", err)
+		}
+		callback := SchemaCallback{StateCallbackURL: "http://127.0.0.1:0", AccessToken: "token"}
+		if err := client.PushSchemaDeviceState(ctx, callback, []SchemaDeviceState{{}}); !errors.Is(err, ErrEmptyExternalDeviceID) {
+			t.Errorf("expected ErrEmptyExternalDeviceID, got %v", err)
+		}
+		if err := client.PushSchemaDeviceState(ctx, callback, nil); err != nil {
+			t.Errorf("expected nothing sent for no states, got %v", err)
+		}
+	})
+}
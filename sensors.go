@@ -0,0 +1,84 @@
+package smartthings
+
+// Sensor Helpers
+//
+// These helpers cover the SmartThings motionSensor, contactSensor, and
+// presenceSensor capabilities, which report their "triggered" state as true
+// (motion "active", contact "open", and presence "present"), and the
+// illuminanceMeasurement and relativeHumidityMeasurement capabilities.
+
+// luxPerFootCandle converts foot-candles to lux.
+const luxPerFootCandle = 10.7639
+
+// ExtractMotionSensor reports whether a motion sensor detects motion.
+// ok is false if the status has no motion value or reports something other
+// than "active" or "inactive".
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if active, ok := smartthings.ExtractMotionSensor(status); ok && active {
+//	    fmt.Println("Motion detected")
+//	}
+func ExtractMotionSensor(status Status) (active bool, ok bool) {
+	// Path: motionSensor.motion.value
+	return sensorState(status, "motionSensor", "motion", "active", "inactive")
+}
+
+// ExtractContactSensor reports whether a contact sensor is open.
+// ok is false if the status has no contact value or reports something other
+// than "open" or "closed".
+func ExtractContactSensor(status Status) (open bool, ok bool) {
+	// Path: contactSensor.contact.value
+	return sensorState(status, "contactSensor", "contact", "open", "closed")
+}
+
+// ExtractPresenceSensor reports whether a presence sensor is present.
+// ok is false if the status has no presence value or reports something other
+// than "present" or "not present".
+func ExtractPresenceSensor(status Status) (present bool, ok bool) {
+	// Path: presenceSensor.presence.value
+	return sensorState(status, "presenceSensor", "presence", "present", "not present")
+}
+
+// ExtractIlluminance extracts the illuminance in lux. Values reported in
+// foot-candles (unit "fc") are converted. ok is false if the status has no
+// illuminance value or reports it in another unit.
+//
+// Example:
+//
+//	if lux, ok := smartthings.ExtractIlluminance(status); ok && lux < 50 {
+//	    fmt.Println("It's dark")
+//	}
+func ExtractIlluminance(status Status) (lux float64, ok bool) {
+	// Path: illuminanceMeasurement.illuminance.value
+	lux, ok = GetFloat(status, "illuminanceMeasurement", "illuminance", "value")
+	if !ok {
+		return 0, false
+	}
+	switch unit, _ := GetString(status, "illuminanceMeasurement", "illuminance", "unit"); unit {
+	case "", "lux":
+		return lux, true
+	case "fc":
+		return lux * luxPerFootCandle, true
+	}
+	return 0, false
+}
+
+// ExtractHumidity extracts the relative humidity percentage. ok is false if
+// the status has no humidity value.
+func ExtractHumidity(status Status) (percent float64, ok bool) {
+	// Path: relativeHumidityMeasurement.humidity.value
+	return GetFloat(status, "relativeHumidityMeasurement", "humidity", "value")
+}
+
+// sensorState maps a two-state attribute to true for on and false for off.
+func sensorState(status Status, capability, attribute, on, off string) (bool, bool) {
+	switch value, _ := GetString(status, capability, attribute, "value"); value {
+	case on:
+		return true, true
+	case off:
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,83 @@
+package smartthings
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSensorExtractors(t *testing.T) {
+	sensorStatus := func(capability, attribute string, value any) Status {
+		return Status{capability: map[string]any{attribute: map[string]any{"value": value}}}
+	}
+
+	tests := []struct {
+		name    string
+		extract func(Status) (bool, bool)
+		status  Status
+		want    bool
+		wantOK  bool
+	}{
+		{"motion active", ExtractMotionSensor, sensorStatus("motionSensor", "motion", "active"), true, true},
+		{"motion inactive", ExtractMotionSensor, sensorStatus("motionSensor", "motion", "inactive"), false, true},
+		{"contact open", ExtractContactSensor, sensorStatus("contactSensor", "contact", "open"), true, true},
+		{"contact closed", ExtractContactSensor, sensorStatus("contactSensor", "contact", "closed"), false, true},
+		{"present", ExtractPresenceSensor, sensorStatus("presenceSensor", "presence", "present"), true, true},
+		{"not present", ExtractPresenceSensor, sensorStatus("presenceSensor", "presence", "not present"), false, true},
+		{"unknown value", ExtractContactSensor, sensorStatus("contactSensor", "contact", "unknown"), false, false},
+		{"non-string value", ExtractMotionSensor, sensorStatus("motionSensor", "motion", 1), false, false},
+		{"other capability", ExtractMotionSensor, sensorStatus("contactSensor", "contact", "open"), false, false},
+		{"empty status", ExtractPresenceSensor, Status{}, false, false},
+		{"nil status", ExtractContactSensor, nil, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.extract(tt.status)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestExtractIlluminance(t *testing.T) {
+	illuminance := func(value any, unit string) Status {
+		attr := map[string]any{"value": value}
+		if unit != "" {
+			attr["unit"] = unit
+		}
+		return Status{"illuminanceMeasurement": map[string]any{"illuminance": attr}}
+	}
+
+	tests := []struct {
+		name   string
+		status Status
+		want   float64
+		wantOK bool
+	}{
+		{"lux", illuminance(120.0, "lux"), 120, true},
+		{"integer value", illuminance(300, "lux"), 300, true},
+		{"no unit", illuminance(45.5, ""), 45.5, true},
+		{"foot-candles", illuminance(10.0, "fc"), 107.639, true},
+		{"unknown unit", illuminance(10.0, "W/m2"), 0, false},
+		{"non-numeric value", illuminance("bright", "lux"), 0, false},
+		{"missing", Status{}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ExtractIlluminance(tt.status)
+			if math.Abs(got-tt.want) > 1e-9 || ok != tt.wantOK {
+				t.Errorf("ExtractIlluminance() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestExtractHumidity(t *testing.T) {
+	status := Status{"relativeHumidityMeasurement": map[string]any{"humidity": map[string]any{"value": 42.5, "unit": "%"}}}
+	if got, ok := ExtractHumidity(status); got != 42.5 || !ok {
+		t.Errorf("ExtractHumidity() = (%v, %v), want (42.5, true)", got, ok)
+	}
+	if got, ok := ExtractHumidity(Status{}); got != 0 || ok {
+		t.Errorf("ExtractHumidity(empty) = (%v, %v), want (0, false)", got, ok)
+	}
+}
@@ -2,10 +2,13 @@ package smartthings
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // ServiceCapability represents an available service capability.
@@ -320,3 +323,77 @@ func buildServicePath(installedAppID, locationID, suffix string) string {
 	}
 	return path + suffix
 }
+
+// DefaultServiceWatchInterval is the interval WatchServiceCapability uses
+// when given a non-positive interval.
+const DefaultServiceWatchInterval = time.Minute
+
+// WatchServiceCapability returns an iterator that polls GetServiceCapability
+// every interval and yields the data when it differs from the last data
+// yielded, as compared by a hash of its content. The first poll happens
+// immediately, so the current data is always yielded first.
+//
+// Errors are yielded without ending the iteration; stop iterating to end it.
+// An empty capability is yielded once as an error and ends the iteration. The
+// iteration ends without an error when the context is canceled.
+//
+// Example:
+//
+//	for data, err := range client.WatchServiceCapability(ctx, smartthings.ServiceCapabilityWeather, locationID, 10*time.Minute) {
+//	    if err != nil {
+//	        log.Printf("poll failed: %v", err)
+//	        continue
+//	    }
+//	    fmt.Printf("%.1f°, %s\n", data.Weather.Temperature, data.Weather.ConditionState)
+//	}
+func (c *Client) WatchServiceCapability(ctx context.Context, capability ServiceCapability, locationID string, interval time.Duration) iter.Seq2[*ServiceCapabilityData, error] {
+	if interval <= 0 {
+		interval = DefaultServiceWatchInterval
+	}
+
+	return func(yield func(*ServiceCapabilityData, error) bool) {
+		if capability == "" {
+			yield(nil, ErrEmptyServiceCapability)
+			return
+		}
+
+		var last [sha256.Size]byte
+		var seen bool
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			data, err := c.GetServiceCapability(ctx, capability, locationID)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+			} else if sum, ok := serviceDataHash(data); !ok || !seen || sum != last {
+				last, seen = sum, ok
+				if !yield(data, nil) {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// serviceDataHash hashes the JSON encoding of data. Returns false if data
+// cannot be encoded, in which case it is treated as changed.
+func serviceDataHash(data *ServiceCapabilityData) ([sha256.Size]byte, bool) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256.Sum256(b), true
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestClient_GetLocationServiceInfo(t *testing.T) {
@@ -391,3 +394,92 @@ func TestClient_UpdateServiceSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_WatchServiceCapability(t *testing.T) {
+	responses := []string{
+		`{"locationId": "loc1", "weather": {"temperature": 20}}`,
+		`{"locationId": "loc1", "weather": {"temperature": 20}}`,
+		`{"locationId": "loc1", "weather": {"temperature": 21}}`,
+		``, // error
+		`{"locationId": "loc1", "weather": {"temperature": 21}}`,
+		`{"locationId": "loc1", "weather": {"temperature": 22}}`,
+	}
+	var mu sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/coordinate/locations/loc1/capabilities/weather" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		mu.Lock()
+		resp := responses[min(polls, len(responses)-1)]
+		polls++
+		mu.Unlock()
+		if resp == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var temps []float64
+	var errs int
+	for data, err := range client.WatchServiceCapability(ctx, ServiceCapabilityWeather, "loc1", time.Millisecond) {
+		if err != nil {
+			errs++
+			continue
+		}
+		temps = append(temps, data.Weather.Temperature)
+		if len(temps) == 3 {
+			break
+		}
+	}
+	if want := []float64{20, 21, 22}; !slices.Equal(temps, want) {
+		t.Errorf("temperatures = %v, want %v", temps, want)
+	}
+	if errs != 1 {
+		t.Errorf("errors = %d, want 1", errs)
+	}
+	if polls != len(responses) {
+		t.Errorf("polls = %d, want %d", polls, len(responses))
+	}
+}
+
+func TestClient_WatchServiceCapability_Stops(t *testing.T) {
+	t.Run("empty capability", func(t *testing.T) {
+		client, _ := NewClient("token")
+		var got []error
+		for _, err := range client.WatchServiceCapability(context.Background(), "", "loc1", time.Millisecond) {
+			got = append(got, err)
+		}
+		if len(got) != 1 || got[0] != ErrEmptyServiceCapability {
+			t.Errorf("errors = %v, want [%v]", got, ErrEmptyServiceCapability)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"locationId": "loc1", "weather": {"temperature": 20}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		count := 0
+		for _, err := range client.WatchServiceCapability(ctx, ServiceCapabilityWeather, "loc1", time.Millisecond) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+			cancel()
+		}
+		if count != 1 {
+			t.Errorf("yielded %d times, want 1", count)
+		}
+	})
+}
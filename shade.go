@@ -0,0 +1,84 @@
+package smartthings
+
+import "context"
+
+// Window Shade Helpers
+//
+// These helpers cover the SmartThings windowShade and windowShadeLevel
+// capabilities used by motorized shades, blinds, and curtains.
+
+// ExtractShadeStatus extracts window shade state from a device status.
+// If the device reports a level but no windowShade state, the state is derived
+// from the level ("closed" at 0, "open" at 100, otherwise "partially open").
+// Returns nil if the status has no windowShade or windowShadeLevel capability.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if shade := smartthings.ExtractShadeStatus(status); shade != nil && shade.Level != nil {
+//	    fmt.Printf("%s (%d%%)\n", shade.State, *shade.Level)
+//	}
+func ExtractShadeStatus(status Status) *ShadeStatus {
+	_, hasShade := GetMap(status, "windowShade")
+	_, hasLevel := GetMap(status, "windowShadeLevel")
+	if !hasShade && !hasLevel {
+		return nil
+	}
+
+	result := &ShadeStatus{}
+
+	// Path: windowShadeLevel.shadeLevel.value
+	if level, ok := GetInt(status, "windowShadeLevel", "shadeLevel", "value"); ok {
+		level = max(0, min(level, 100))
+		result.Level = &level
+	}
+
+	// Path: windowShade.windowShade.value
+	if state, ok := GetString(status, "windowShade", "windowShade", "value"); ok {
+		result.State = state
+	} else if result.Level != nil {
+		switch *result.Level {
+		case 0:
+			result.State = "closed"
+		case 100:
+			result.State = "open"
+		default:
+			result.State = "partially open"
+		}
+	}
+
+	return result
+}
+
+// OpenShade fully opens a window shade.
+func (c *Client) OpenShade(ctx context.Context, deviceID string) error {
+	return c.windowShadeCommand(ctx, deviceID, "open")
+}
+
+// CloseShade fully closes a window shade.
+func (c *Client) CloseShade(ctx context.Context, deviceID string) error {
+	return c.windowShadeCommand(ctx, deviceID, "close")
+}
+
+// PauseShade stops a window shade that is opening or closing.
+func (c *Client) PauseShade(ctx context.Context, deviceID string) error {
+	return c.windowShadeCommand(ctx, deviceID, "pause")
+}
+
+// SetShadeLevel sets how far a window shade is open (0 = closed, 100 = fully open).
+// The level is clamped to 0-100.
+func (c *Client) SetShadeLevel(ctx context.Context, deviceID string, level int) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	level = max(0, min(level, 100))
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("windowShadeLevel", "setShadeLevel", level))
+}
+
+// windowShadeCommand sends an argument-less windowShade command.
+func (c *Client) windowShadeCommand(ctx context.Context, deviceID, command string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("windowShade", command))
+}
@@ -0,0 +1,117 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractShadeStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    Status
+		wantState string
+		wantLevel int // -1 means nil
+	}{
+		{
+			"state and level",
+			Status{
+				"windowShade":      map[string]any{"windowShade": map[string]any{"value": "partially open"}},
+				"windowShadeLevel": map[string]any{"shadeLevel": map[string]any{"value": 40.0}},
+			},
+			"partially open", 40,
+		},
+		{
+			"state only",
+			Status{"windowShade": map[string]any{"windowShade": map[string]any{"value": "closing"}}},
+			"closing", -1,
+		},
+		{"level 0 derives closed", Status{"windowShadeLevel": map[string]any{"shadeLevel": map[string]any{"value": 0.0}}}, "closed", 0},
+		{"level 100 derives open", Status{"windowShadeLevel": map[string]any{"shadeLevel": map[string]any{"value": 100.0}}}, "open", 100},
+		{"level clamped", Status{"windowShadeLevel": map[string]any{"shadeLevel": map[string]any{"value": 120.0}}}, "open", 100},
+		{"intermediate level", Status{"windowShadeLevel": map[string]any{"shadeLevel": map[string]any{"value": 55.0}}}, "partially open", 55},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractShadeStatus(tt.status)
+			if result == nil {
+				t.Fatal("expected non-nil result")
+			}
+			if result.State != tt.wantState {
+				t.Errorf("State = %q, want %q", result.State, tt.wantState)
+			}
+			if tt.wantLevel < 0 {
+				if result.Level != nil {
+					t.Errorf("Level = %d, want nil", *result.Level)
+				}
+			} else if result.Level == nil || *result.Level != tt.wantLevel {
+				t.Errorf("Level = %v, want %d", result.Level, tt.wantLevel)
+			}
+		})
+	}
+
+	t.Run("not a shade", func(t *testing.T) {
+		if result := ExtractShadeStatus(Status{"switch": map[string]any{}}); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
+
+func TestClient_ShadeCommands(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(c *Client) error
+		wantCapability string
+		wantCommand    string
+		wantArgs       []any
+	}{
+		{"open", func(c *Client) error { return c.OpenShade(context.Background(), "shade-1") }, "windowShade", "open", nil},
+		{"close", func(c *Client) error { return c.CloseShade(context.Background(), "shade-1") }, "windowShade", "close", nil},
+		{"pause", func(c *Client) error { return c.PauseShade(context.Background(), "shade-1") }, "windowShade", "pause", nil},
+		{"level", func(c *Client) error { return c.SetShadeLevel(context.Background(), "shade-1", 30) }, "windowShadeLevel", "setShadeLevel", []any{30.0}},
+		{"level clamped high", func(c *Client) error { return c.SetShadeLevel(context.Background(), "shade-1", 150) }, "windowShadeLevel", "setShadeLevel", []any{100.0}},
+		{"level clamped low", func(c *Client) error { return c.SetShadeLevel(context.Background(), "shade-1", -5) }, "windowShadeLevel", "setShadeLevel", []any{0.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != tt.wantCapability || cmd.Command != tt.wantCommand {
+					t.Errorf("command = %s.%s, want %s.%s", cmd.Capability, cmd.Command, tt.wantCapability, tt.wantCommand)
+				}
+				if len(cmd.Arguments) != len(tt.wantArgs) {
+					t.Fatalf("arguments = %v, want %v", cmd.Arguments, tt.wantArgs)
+				}
+				for i := range tt.wantArgs {
+					if cmd.Arguments[i] != tt.wantArgs[i] {
+						t.Errorf("arguments = %v, want %v", cmd.Arguments, tt.wantArgs)
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := tt.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		ctx := context.Background()
+		if err := client.OpenShade(ctx, ""); err != ErrEmptyDeviceID {
+			t.Errorf("OpenShade: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetShadeLevel(ctx, "", 50); err != ErrEmptyDeviceID {
+			t.Errorf("SetShadeLevel: expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
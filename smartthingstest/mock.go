@@ -0,0 +1,2501 @@
+package smartthingstest
+
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+// MockClient is a SmartThingsClient whose behavior is supplied per method.
+//
+// Each interface method Xxx is backed by an XxxFunc field. When the field is
+// set, the method calls it; otherwise the method returns zero values (and a
+// nil error). A zero MockClient is ready to use.
+//
+// Example:
+//
+//	mock := &smartthingstest.MockClient{
+//	    GetDeviceStatusFunc: func(ctx context.Context, deviceID string) (smartthings.Status, error) {
+//	        return smartthings.Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}}, nil
+//	    },
+//	}
+//	var client smartthings.SmartThingsClient = mock
+type MockClient struct {
+	// Device Operations
+	ListDevicesFunc                  func(ctx context.Context) ([]smartthings.Device, error)
+	ListDevicesWithOptionsFunc       func(ctx context.Context, opts *smartthings.ListDevicesOptions) (*smartthings.PagedDevices, error)
+	ListAllDevicesFunc               func(ctx context.Context) ([]smartthings.Device, error)
+	ListDevicesByLocationFunc        func(ctx context.Context, locationIDs []string) (map[string][]smartthings.Device, error)
+	GetDeviceFunc                    func(ctx context.Context, deviceID string) (*smartthings.Device, error)
+	GetDeviceCachedFunc              func(ctx context.Context, deviceID string) (*smartthings.Device, bool, error)
+	GetDeviceStatusFunc              func(ctx context.Context, deviceID string) (smartthings.Status, error)
+	GetDeviceFullStatusFunc          func(ctx context.Context, deviceID string) (map[string]smartthings.Status, error)
+	GetDeviceStatusAllComponentsFunc func(ctx context.Context, deviceID string) (smartthings.Status, error)
+	GetComponentStatusFunc           func(ctx context.Context, deviceID string, componentID string) (smartthings.Status, error)
+	GetCapabilityStatusFunc          func(ctx context.Context, deviceID string, componentID string, capabilityID string) (smartthings.Status, error)
+	ExecuteCommandFunc               func(ctx context.Context, deviceID string, cmd smartthings.Command) error
+	ExecuteCommandsFunc              func(ctx context.Context, deviceID string, cmds []smartthings.Command) error
+	ExecuteCommandSequenceFunc       func(ctx context.Context, deviceID string, steps []smartthings.CommandStep) error
+	ValidateCommandFunc              func(ctx context.Context, cmd smartthings.Command) error
+	ExecuteComponentCommandFunc      func(ctx context.Context, deviceID string, component string, capability string, command string, args ...any) error
+	ExecuteCommandOnComponentFunc    func(ctx context.Context, deviceID string, componentID string, cmd smartthings.Command) error
+	ExecuteCommandAndRefreshFunc     func(ctx context.Context, deviceID string, cmd smartthings.Command) (smartthings.Status, error)
+	DeleteDeviceFunc                 func(ctx context.Context, deviceID string) error
+	UpdateDeviceFunc                 func(ctx context.Context, deviceID string, update *smartthings.DeviceUpdate) (*smartthings.Device, error)
+	MoveDeviceFunc                   func(ctx context.Context, deviceID string, targetLocationID string, targetRoomID string) (*smartthings.Device, error)
+	GetDeviceHealthFunc              func(ctx context.Context, deviceID string) (*smartthings.DeviceHealth, error)
+	DeviceHasCapabilityFunc          func(ctx context.Context, deviceID string, capability string) (bool, error)
+	FindDeviceByLabelFunc            func(ctx context.Context, label string) (*smartthings.Device, error)
+	SearchDevicesFunc                func(ctx context.Context, query string) ([]smartthings.Device, error)
+	WaitForDeviceStateFunc           func(ctx context.Context, deviceID string, capability string, attribute string, want any, opts *smartthings.WaitOptions) error
+	DevicesFunc                      func(ctx context.Context) iter.Seq2[smartthings.Device, error]
+	DevicesWithOptionsFunc           func(ctx context.Context, opts *smartthings.ListDevicesOptions) iter.Seq2[smartthings.Device, error]
+
+	// Batch Operations
+	ExecuteCommandBatchFunc           func(ctx context.Context, deviceIDs []string, cmd smartthings.Command, cfg *smartthings.BatchConfig) []smartthings.BatchResult
+	ExecuteCommandsBatchFunc          func(ctx context.Context, batch []smartthings.BatchCommand, cfg *smartthings.BatchConfig) []smartthings.BatchResult
+	ExecuteScenesBatchFunc            func(ctx context.Context, sceneIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchResult
+	GetDeviceStatusBatchFunc          func(ctx context.Context, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchStatusResult
+	GetDeviceHealthBatchFunc          func(ctx context.Context, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchHealthResult
+	AssignDevicesToRoomFunc           func(ctx context.Context, locationID string, roomID string, deviceIDs []string) []smartthings.BatchResult
+	AssignDevicesToRoomWithConfigFunc func(ctx context.Context, locationID string, roomID string, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchResult
+	GetLocationInventoryFunc          func(ctx context.Context, locationID string) ([]smartthings.DeviceWithStatus, error)
+
+	// Export Operations
+	ExportDevicesFunc   func(ctx context.Context, w io.Writer) error
+	ExportLocationsFunc func(ctx context.Context, w io.Writer) error
+	ExportRulesFunc     func(ctx context.Context, w io.Writer) error
+	ImportRulesFunc     func(ctx context.Context, locationID string, r io.Reader) ([]smartthings.Rule, error)
+
+	// Location Operations
+	ListLocationsFunc  func(ctx context.Context) ([]smartthings.Location, error)
+	GetLocationFunc    func(ctx context.Context, locationID string) (*smartthings.Location, error)
+	CreateLocationFunc func(ctx context.Context, location *smartthings.LocationCreate) (*smartthings.Location, error)
+	UpdateLocationFunc func(ctx context.Context, locationID string, update *smartthings.LocationUpdate) (*smartthings.Location, error)
+	DeleteLocationFunc func(ctx context.Context, locationID string) error
+	LocationsFunc      func(ctx context.Context) iter.Seq2[smartthings.Location, error]
+
+	// Room Operations
+	ListRoomsFunc            func(ctx context.Context, locationID string) ([]smartthings.Room, error)
+	ListRoomsWithOptionsFunc func(ctx context.Context, locationID string, opts *smartthings.ListOptions) (*smartthings.PagedRooms, error)
+	GetRoomFunc              func(ctx context.Context, locationID string, roomID string) (*smartthings.Room, error)
+	CreateRoomFunc           func(ctx context.Context, locationID string, room *smartthings.RoomCreate) (*smartthings.Room, error)
+	UpdateRoomFunc           func(ctx context.Context, locationID string, roomID string, update *smartthings.RoomUpdate) (*smartthings.Room, error)
+	DeleteRoomFunc           func(ctx context.Context, locationID string, roomID string) error
+	RoomsFunc                func(ctx context.Context, locationID string) iter.Seq2[smartthings.Room, error]
+
+	// Scene Operations
+	ListScenesFunc             func(ctx context.Context, locationID string) ([]smartthings.Scene, error)
+	ListScenesWithOptionsFunc  func(ctx context.Context, locationID string, opts *smartthings.ListOptions) (*smartthings.PagedScenes, error)
+	GetSceneFunc               func(ctx context.Context, sceneID string) (*smartthings.Scene, error)
+	ExecuteSceneFunc           func(ctx context.Context, sceneID string) error
+	ExecuteSceneWithResultFunc func(ctx context.Context, sceneID string) (*smartthings.SceneExecutionResult, error)
+	BuildSceneFromDevicesFunc  func(ctx context.Context, locationID string, deviceIDs []string) (*smartthings.SceneCreate, error)
+	ScenesFunc                 func(ctx context.Context, locationID string) iter.Seq2[smartthings.Scene, error]
+
+	// Capability Operations
+	ListCapabilitiesFunc            func(ctx context.Context) ([]smartthings.CapabilityReference, error)
+	ListCapabilitiesWithOptionsFunc func(ctx context.Context, opts *smartthings.ListCapabilitiesOptions) ([]smartthings.CapabilityReference, error)
+	GetCapabilityFunc               func(ctx context.Context, capabilityID string, version int) (*smartthings.Capability, error)
+	CapabilitiesFunc                func(ctx context.Context) iter.Seq2[smartthings.CapabilityReference, error]
+	CapabilitiesWithOptionsFunc     func(ctx context.Context, opts *smartthings.ListCapabilitiesOptions) iter.Seq2[smartthings.CapabilityReference, error]
+	GetCapabilityLocalizationFunc   func(ctx context.Context, capabilityID string, version int, locale string) (*smartthings.CapabilityLocalization, error)
+	ListCapabilityLocalizationsFunc func(ctx context.Context, capabilityID string, version int) ([]smartthings.LocaleReference, error)
+	ListSubscriptionsFunc           func(ctx context.Context, installedAppID string) ([]smartthings.Subscription, error)
+	CreateSubscriptionFunc          func(ctx context.Context, installedAppID string, sub *smartthings.SubscriptionCreate) (*smartthings.Subscription, error)
+	SubscribeLocationDevicesFunc    func(ctx context.Context, installedAppID string, locationID string) ([]smartthings.Subscription, error)
+	DeleteSubscriptionFunc          func(ctx context.Context, installedAppID string, subscriptionID string) error
+	DeleteAllSubscriptionsFunc      func(ctx context.Context, installedAppID string) error
+	SubscriptionsFunc               func(ctx context.Context, installedAppID string) iter.Seq2[smartthings.Subscription, error]
+
+	// Rule Operations
+	ListRulesFunc          func(ctx context.Context, locationID string) ([]smartthings.Rule, error)
+	ListRulesForDeviceFunc func(ctx context.Context, locationID string, deviceID string) ([]smartthings.Rule, error)
+	GetRuleFunc            func(ctx context.Context, ruleID string) (*smartthings.Rule, error)
+	CreateRuleFunc         func(ctx context.Context, locationID string, rule *smartthings.RuleCreate) (*smartthings.Rule, error)
+	UpdateRuleFunc         func(ctx context.Context, ruleID string, rule *smartthings.RuleUpdate) (*smartthings.Rule, error)
+	DeleteRuleFunc         func(ctx context.Context, ruleID string) error
+	DeleteAllRulesFunc     func(ctx context.Context, locationID string) error
+	ExecuteRuleFunc        func(ctx context.Context, ruleID string) error
+	EnableRuleFunc         func(ctx context.Context, ruleID string) error
+	DisableRuleFunc        func(ctx context.Context, ruleID string) error
+	TestRuleFunc           func(ctx context.Context, ruleID string) (*smartthings.RuleTestResult, error)
+	RulesFunc              func(ctx context.Context, locationID string) iter.Seq2[smartthings.Rule, error]
+
+	// Schedule Operations
+	ListSchedulesFunc  func(ctx context.Context, installedAppID string) ([]smartthings.Schedule, error)
+	GetScheduleFunc    func(ctx context.Context, installedAppID string, scheduleName string) (*smartthings.Schedule, error)
+	CreateScheduleFunc func(ctx context.Context, installedAppID string, schedule *smartthings.ScheduleCreate) (*smartthings.Schedule, error)
+	DeleteScheduleFunc func(ctx context.Context, installedAppID string, scheduleName string) error
+	SchedulesFunc      func(ctx context.Context, installedAppID string) iter.Seq2[smartthings.Schedule, error]
+
+	// InstalledApp Operations
+	ListInstalledAppsFunc            func(ctx context.Context, locationID string) ([]smartthings.InstalledApp, error)
+	GetInstalledAppFunc              func(ctx context.Context, installedAppID string) (*smartthings.InstalledApp, error)
+	DeleteInstalledAppFunc           func(ctx context.Context, installedAppID string) error
+	ListInstalledAppConfigsFunc      func(ctx context.Context, installedAppID string) ([]smartthings.InstalledAppConfigItem, error)
+	GetInstalledAppConfigFunc        func(ctx context.Context, installedAppID string, configID string) (*smartthings.InstalledAppConfiguration, error)
+	UpdateInstalledAppConfigFunc     func(ctx context.Context, installedAppID string, configID string, config *smartthings.InstalledAppConfiguration) (*smartthings.InstalledAppConfiguration, error)
+	GetCurrentInstalledAppConfigFunc func(ctx context.Context, installedAppID string) (*smartthings.InstalledAppConfiguration, error)
+	InstalledAppsFunc                func(ctx context.Context, locationID string) iter.Seq2[smartthings.InstalledApp, error]
+
+	// Mode Operations
+	ListModesFunc          func(ctx context.Context, locationID string) ([]smartthings.Mode, error)
+	GetModeFunc            func(ctx context.Context, locationID string, modeID string) (*smartthings.Mode, error)
+	GetCurrentModeFunc     func(ctx context.Context, locationID string) (*smartthings.Mode, error)
+	SetCurrentModeFunc     func(ctx context.Context, locationID string, modeID string) (*smartthings.Mode, error)
+	SetModeForPresenceFunc func(ctx context.Context, locationID string, anyonePresent bool, homeMode string, awayMode string) error
+	ModesFunc              func(ctx context.Context, locationID string) iter.Seq2[smartthings.Mode, error]
+
+	// History/Events Operations
+	GetDeviceEventsFunc      func(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedEvents, error)
+	GetDeviceEventsPageFunc  func(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedEvents, string, error)
+	GetDeviceStatesFunc      func(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedStates, error)
+	GetEnergyUsageFunc       func(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.EnergyUsageSummary, error)
+	DeviceEventsFunc         func(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) iter.Seq2[smartthings.DeviceEvent, error]
+	DeviceEventsFilteredFunc func(ctx context.Context, deviceID string, filter smartthings.EventFilter) iter.Seq2[smartthings.DeviceEvent, error]
+	DeviceEventStreamFunc    func(ctx context.Context, deviceIDs []string) (iter.Seq2[smartthings.DeviceEvent, error], error)
+
+	// App Operations
+	ListAppsFunc         func(ctx context.Context) ([]smartthings.App, error)
+	GetAppFunc           func(ctx context.Context, appID string) (*smartthings.App, error)
+	CreateAppFunc        func(ctx context.Context, app *smartthings.AppCreate) (*smartthings.App, error)
+	UpdateAppFunc        func(ctx context.Context, appID string, update *smartthings.AppUpdate) (*smartthings.App, error)
+	DeleteAppFunc        func(ctx context.Context, appID string) error
+	GetAppOAuthFunc      func(ctx context.Context, appID string) (*smartthings.AppOAuth, error)
+	UpdateAppOAuthFunc   func(ctx context.Context, appID string, oauth *smartthings.AppOAuth) (*smartthings.AppOAuth, error)
+	GenerateAppOAuthFunc func(ctx context.Context, appID string) (*smartthings.AppOAuthGenerated, error)
+	AppsFunc             func(ctx context.Context) iter.Seq2[smartthings.App, error]
+
+	// Device Profile Operations
+	ListDeviceProfilesFunc  func(ctx context.Context) ([]smartthings.DeviceProfileFull, error)
+	GetDeviceProfileFunc    func(ctx context.Context, profileID string) (*smartthings.DeviceProfileFull, error)
+	CreateDeviceProfileFunc func(ctx context.Context, profile *smartthings.DeviceProfileCreate) (*smartthings.DeviceProfileFull, error)
+	UpdateDeviceProfileFunc func(ctx context.Context, profileID string, update *smartthings.DeviceProfileUpdate) (*smartthings.DeviceProfileFull, error)
+	DeleteDeviceProfileFunc func(ctx context.Context, profileID string) error
+	DeviceProfilesFunc      func(ctx context.Context) iter.Seq2[smartthings.DeviceProfileFull, error]
+
+	// Device Preference Operations
+	ListDevicePreferencesFunc        func(ctx context.Context, namespace string) ([]smartthings.DevicePreference, error)
+	GetDevicePreferenceFunc          func(ctx context.Context, preferenceID string) (*smartthings.DevicePreference, error)
+	CreateDevicePreferenceFunc       func(ctx context.Context, pref *smartthings.DevicePreferenceCreate) (*smartthings.DevicePreference, error)
+	UpdateDevicePreferenceFunc       func(ctx context.Context, preferenceID string, pref *smartthings.DevicePreference) (*smartthings.DevicePreference, error)
+	CreatePreferenceTranslationsFunc func(ctx context.Context, preferenceID string, localization *smartthings.PreferenceLocalization) (*smartthings.PreferenceLocalization, error)
+	GetPreferenceTranslationsFunc    func(ctx context.Context, preferenceID string, locale string) (*smartthings.PreferenceLocalization, error)
+	ListPreferenceTranslationsFunc   func(ctx context.Context, preferenceID string) ([]smartthings.LocaleReference, error)
+	UpdatePreferenceTranslationsFunc func(ctx context.Context, preferenceID string, localization *smartthings.PreferenceLocalization) (*smartthings.PreferenceLocalization, error)
+	DevicePreferencesFunc            func(ctx context.Context, namespace string) iter.Seq2[smartthings.DevicePreference, error]
+
+	// Presentation Operations
+	GeneratePresentationFunc     func(ctx context.Context, profileID string) (*smartthings.PresentationDeviceConfig, error)
+	CreatePresentationConfigFunc func(ctx context.Context, config *smartthings.PresentationDeviceConfigCreate) (*smartthings.PresentationDeviceConfig, error)
+	GetPresentationConfigFunc    func(ctx context.Context, presentationID string, manufacturerName string) (*smartthings.PresentationDeviceConfig, error)
+	GetDevicePresentationFunc    func(ctx context.Context, presentationID string, manufacturerName string) (*smartthings.PresentationDevicePresentation, error)
+	BuildDeviceUIModelFunc       func(ctx context.Context, deviceID string) (*smartthings.DeviceUIModel, error)
+
+	// Hub Operations
+	GetHubFunc                func(ctx context.Context, hubID string) (*smartthings.Hub, error)
+	GetHubFirmwareFunc        func(ctx context.Context, hubID string) (*smartthings.HubFirmware, error)
+	HubIsOnlineFunc           func(ctx context.Context, hubID string) (bool, error)
+	GetHubCharacteristicsFunc func(ctx context.Context, hubID string) (smartthings.HubCharacteristics, error)
+	ListEnrolledChannelsFunc  func(ctx context.Context, hubID string) ([]smartthings.EnrolledChannel, error)
+	ListInstalledDriversFunc  func(ctx context.Context, hubID string, deviceID string) ([]smartthings.InstalledDriver, error)
+	GetInstalledDriverFunc    func(ctx context.Context, hubID string, driverID string) (*smartthings.InstalledDriver, error)
+	InstallDriverFunc         func(ctx context.Context, driverID string, hubID string, channelID string) error
+	UninstallDriverFunc       func(ctx context.Context, driverID string, hubID string) error
+	SwitchDriverFunc          func(ctx context.Context, driverID string, hubID string, deviceID string, forceUpdate bool) error
+	EnrolledChannelsFunc      func(ctx context.Context, hubID string) iter.Seq2[smartthings.EnrolledChannel, error]
+	InstalledDriversFunc      func(ctx context.Context, hubID string, deviceID string) iter.Seq2[smartthings.InstalledDriver, error]
+
+	// Edge Driver Operations
+	ListDriversFunc        func(ctx context.Context) ([]smartthings.EdgeDriverSummary, error)
+	ListDefaultDriversFunc func(ctx context.Context) ([]smartthings.EdgeDriver, error)
+	GetDriverFunc          func(ctx context.Context, driverID string) (*smartthings.EdgeDriver, error)
+	GetDriverRevisionFunc  func(ctx context.Context, driverID string, version string) (*smartthings.EdgeDriver, error)
+	DeleteDriverFunc       func(ctx context.Context, driverID string) error
+	UploadDriverFunc       func(ctx context.Context, archiveData []byte) (*smartthings.EdgeDriver, error)
+	DriversFunc            func(ctx context.Context) iter.Seq2[smartthings.EdgeDriverSummary, error]
+
+	// Channel Operations
+	ListChannelsFunc             func(ctx context.Context, opts *smartthings.ChannelListOptions) ([]smartthings.Channel, error)
+	GetChannelFunc               func(ctx context.Context, channelID string) (*smartthings.Channel, error)
+	CreateChannelFunc            func(ctx context.Context, channel *smartthings.ChannelCreate) (*smartthings.Channel, error)
+	UpdateChannelFunc            func(ctx context.Context, channelID string, update *smartthings.ChannelUpdate) (*smartthings.Channel, error)
+	DeleteChannelFunc            func(ctx context.Context, channelID string) error
+	ListAssignedDriversFunc      func(ctx context.Context, channelID string) ([]smartthings.DriverChannelDetails, error)
+	AssignDriverFunc             func(ctx context.Context, channelID string, driverID string, version string) (*smartthings.DriverChannelDetails, error)
+	UnassignDriverFunc           func(ctx context.Context, channelID string, driverID string) error
+	GetDriverChannelMetaInfoFunc func(ctx context.Context, channelID string, driverID string) (*smartthings.EdgeDriver, error)
+	EnrollHubFunc                func(ctx context.Context, channelID string, hubID string) error
+	UnenrollHubFunc              func(ctx context.Context, channelID string, hubID string) error
+	ChannelsFunc                 func(ctx context.Context, opts *smartthings.ChannelListOptions) iter.Seq2[smartthings.Channel, error]
+	AssignedDriversFunc          func(ctx context.Context, channelID string) iter.Seq2[smartthings.DriverChannelDetails, error]
+
+	// Virtual Device Operations
+	CreateVirtualDeviceFunc          func(ctx context.Context, req *smartthings.VirtualDeviceCreateRequest) (*smartthings.Device, error)
+	CreateStandardVirtualDeviceFunc  func(ctx context.Context, req *smartthings.VirtualDeviceStandardCreateRequest) (*smartthings.Device, error)
+	ListVirtualDevicesFunc           func(ctx context.Context, opts *smartthings.VirtualDeviceListOptions) ([]smartthings.Device, error)
+	CreateVirtualDeviceEventsFunc    func(ctx context.Context, deviceID string, events []smartthings.VirtualDeviceEvent) (*smartthings.VirtualDeviceEventsResponse, error)
+	CreateVirtualDeviceWithStateFunc func(ctx context.Context, req *smartthings.VirtualDeviceCreateRequest, initialStates []smartthings.VirtualDeviceEvent) (*smartthings.Device, error)
+	ListSchemaAppsFunc               func(ctx context.Context, includeAllOrganizations bool) ([]smartthings.SchemaApp, error)
+	GetSchemaAppFunc                 func(ctx context.Context, appID string) (*smartthings.SchemaApp, error)
+	CreateSchemaAppFunc              func(ctx context.Context, req *smartthings.SchemaAppRequest, organizationID string) (*smartthings.SchemaCreateResponse, error)
+	UpdateSchemaAppFunc              func(ctx context.Context, appID string, req *smartthings.SchemaAppRequest, organizationID string) error
+	DeleteSchemaAppFunc              func(ctx context.Context, appID string) error
+	GetSchemaAppPageFunc             func(ctx context.Context, appID string, locationID string) (*smartthings.SchemaPage, error)
+	RegenerateSchemaAppOAuthFunc     func(ctx context.Context, appID string) (*smartthings.SchemaCreateResponse, error)
+	ListInstalledSchemaAppsFunc      func(ctx context.Context, locationID string) ([]smartthings.InstalledSchemaApp, error)
+	GetInstalledSchemaAppFunc        func(ctx context.Context, isaID string) (*smartthings.InstalledSchemaApp, error)
+	DeleteInstalledSchemaAppFunc     func(ctx context.Context, isaID string) error
+	PushSchemaDeviceStateFunc        func(ctx context.Context, callback smartthings.SchemaCallback, states []smartthings.SchemaDeviceState) error
+	SchemaAppsFunc                   func(ctx context.Context, includeAllOrganizations bool) iter.Seq2[smartthings.SchemaApp, error]
+	InstalledSchemaAppsFunc          func(ctx context.Context, locationID string) iter.Seq2[smartthings.InstalledSchemaApp, error]
+
+	// Schema App Invitation Operations
+	CreateSchemaAppInvitationFunc func(ctx context.Context, invitation *smartthings.SchemaAppInvitationCreate) (*smartthings.SchemaAppInvitationID, error)
+	ListSchemaAppInvitationsFunc  func(ctx context.Context, schemaAppID string) ([]smartthings.SchemaAppInvitation, error)
+	RevokeSchemaAppInvitationFunc func(ctx context.Context, invitationID string) error
+	SchemaAppInvitationsFunc      func(ctx context.Context, schemaAppID string) iter.Seq2[smartthings.SchemaAppInvitation, error]
+
+	// Organization Operations
+	ListOrganizationsFunc func(ctx context.Context) ([]smartthings.Organization, error)
+	GetOrganizationFunc   func(ctx context.Context, organizationID string) (*smartthings.Organization, error)
+	OrganizationsFunc     func(ctx context.Context) iter.Seq2[smartthings.Organization, error]
+
+	// Notification Operations
+	CreateNotificationFunc            func(ctx context.Context, req *smartthings.NotificationRequest) (*smartthings.NotificationResponse, error)
+	SendNotificationFunc              func(ctx context.Context, locationID string, title string, message string) (*smartthings.NotificationResponse, error)
+	GetLocationServiceInfoFunc        func(ctx context.Context, locationID string) (*smartthings.ServiceLocationInfo, error)
+	GetServiceCapabilitiesListFunc    func(ctx context.Context, locationID string) ([]smartthings.ServiceCapability, error)
+	GetServiceCapabilityFunc          func(ctx context.Context, capability smartthings.ServiceCapability, locationID string) (*smartthings.ServiceCapabilityData, error)
+	GetServiceCapabilitiesDataFunc    func(ctx context.Context, capabilities []smartthings.ServiceCapability, locationID string) (*smartthings.ServiceCapabilityData, error)
+	WatchServiceCapabilityFunc        func(ctx context.Context, capability smartthings.ServiceCapability, locationID string, interval time.Duration) iter.Seq2[*smartthings.ServiceCapabilityData, error]
+	CreateServiceSubscriptionFunc     func(ctx context.Context, req *smartthings.ServiceSubscriptionRequest, installedAppID string, locationID string) (*smartthings.ServiceNewSubscription, error)
+	UpdateServiceSubscriptionFunc     func(ctx context.Context, subscriptionID string, req *smartthings.ServiceSubscriptionRequest, installedAppID string, locationID string) (*smartthings.ServiceNewSubscription, error)
+	DeleteServiceSubscriptionFunc     func(ctx context.Context, subscriptionID string, installedAppID string, locationID string) error
+	DeleteAllServiceSubscriptionsFunc func(ctx context.Context, installedAppID string, locationID string) error
+
+	// TV Control Operations
+	FetchTVStatusFunc     func(ctx context.Context, deviceID string) (*smartthings.TVStatus, error)
+	FetchTVInputsFunc     func(ctx context.Context, deviceID string) ([]smartthings.TVInput, error)
+	SetTVPowerFunc        func(ctx context.Context, deviceID string, on bool) error
+	SetTVVolumeFunc       func(ctx context.Context, deviceID string, volume int) error
+	SetTVMuteFunc         func(ctx context.Context, deviceID string, muted bool) error
+	SetTVInputFunc        func(ctx context.Context, deviceID string, inputID string) error
+	SetTVChannelFunc      func(ctx context.Context, deviceID string, channel int) error
+	SendTVKeyFunc         func(ctx context.Context, deviceID string, key string) error
+	SendTVKeysFunc        func(ctx context.Context, deviceID string, keys []string, delay time.Duration) error
+	LaunchTVAppFunc       func(ctx context.Context, deviceID string, appID string) error
+	LaunchTVAppByNameFunc func(ctx context.Context, deviceID string, name string) error
+	TVPlayFunc            func(ctx context.Context, deviceID string) error
+	TVPauseFunc           func(ctx context.Context, deviceID string) error
+	TVStopFunc            func(ctx context.Context, deviceID string) error
+	TVChannelUpFunc       func(ctx context.Context, deviceID string) error
+	TVChannelDownFunc     func(ctx context.Context, deviceID string) error
+	TVVolumeUpFunc        func(ctx context.Context, deviceID string) error
+	TVVolumeDownFunc      func(ctx context.Context, deviceID string) error
+	SetTVArtModeFunc      func(ctx context.Context, deviceID string, on bool) error
+	SetPictureModeFunc    func(ctx context.Context, deviceID string, mode string) error
+	SetSoundModeFunc      func(ctx context.Context, deviceID string, mode string) error
+
+	// Light Control Operations
+	SetColorFunc            func(ctx context.Context, deviceID string, hue float64, saturation float64) error
+	SetColorTemperatureFunc func(ctx context.Context, deviceID string, kelvin int) error
+	SetLevelFunc            func(ctx context.Context, deviceID string, level int) error
+	SetLevelWithRateFunc    func(ctx context.Context, deviceID string, level int, rate int) error
+
+	// Thermostat Operations
+	SetThermostatSetpointFunc func(ctx context.Context, deviceID string, mode smartthings.ThermostatMode, temp float64) error
+	SetThermostatModeFunc     func(ctx context.Context, deviceID string, mode smartthings.ThermostatMode) error
+
+	// Lock Operations
+	LockDeviceFunc        func(ctx context.Context, deviceID string) error
+	UnlockDeviceFunc      func(ctx context.Context, deviceID string) error
+	UnlockWithTimeoutFunc func(ctx context.Context, deviceID string, seconds int) error
+
+	// Robot Vacuum Operations
+	StartCleaningFunc   func(ctx context.Context, deviceID string) error
+	PauseCleaningFunc   func(ctx context.Context, deviceID string) error
+	ReturnToDockFunc    func(ctx context.Context, deviceID string) error
+	SetCleaningModeFunc func(ctx context.Context, deviceID string, mode string) error
+
+	// Air Conditioner Operations
+	SetACModeFunc     func(ctx context.Context, deviceID string, mode string) error
+	SetACSetpointFunc func(ctx context.Context, deviceID string, temp float64) error
+	SetFanModeFunc    func(ctx context.Context, deviceID string, mode string) error
+
+	// Window Shade Operations
+	OpenShadeFunc     func(ctx context.Context, deviceID string) error
+	CloseShadeFunc    func(ctx context.Context, deviceID string) error
+	PauseShadeFunc    func(ctx context.Context, deviceID string) error
+	SetShadeLevelFunc func(ctx context.Context, deviceID string, level int) error
+
+	// Rate Limit Operations
+	RateLimitInfoFunc           func() *smartthings.RateLimitInfo
+	RateLimitResetTimeFunc      func() time.Time
+	RemainingRequestsFunc       func() int
+	ShouldThrottleFunc          func(threshold int) bool
+	WaitForRateLimitFunc        func(ctx context.Context) error
+	WaitForRateLimitWithCapFunc func(ctx context.Context, maxWait time.Duration) error
+	WaitForRateLimitErrFunc     func(ctx context.Context, err error) error
+
+	// Cache Operations
+	InvalidateCacheFunc           func(resourceType string, ids ...string)
+	InvalidateCapabilityCacheFunc func()
+	InvalidateDeviceStatusFunc    func(deviceID string)
+
+	// Token Operations
+	TokenFunc        func() string
+	SetTokenFunc     func(token string)
+	UserAgentFunc    func() string
+	DryRunFunc       func() bool
+	CircuitStateFunc func() smartthings.CircuitState
+
+	// Logging Operations
+	LogRequestFunc       func(ctx context.Context, method string, path string)
+	LogResponseFunc      func(ctx context.Context, method string, path string, statusCode int, duration time.Duration, err error)
+	LogDeviceCommandFunc func(ctx context.Context, deviceID string, capability string, command string, err error)
+	LogRateLimitFunc     func(ctx context.Context, info smartthings.RateLimitInfo)
+}
+
+// Ensure MockClient implements SmartThingsClient at compile time.
+var _ smartthings.SmartThingsClient = (*MockClient)(nil)
+
+// ListDevices calls ListDevicesFunc if set.
+func (m *MockClient) ListDevices(ctx context.Context) ([]smartthings.Device, error) {
+	if m.ListDevicesFunc != nil {
+		return m.ListDevicesFunc(ctx)
+	}
+	return nil, nil
+}
+
+// ListDevicesWithOptions calls ListDevicesWithOptionsFunc if set.
+func (m *MockClient) ListDevicesWithOptions(ctx context.Context, opts *smartthings.ListDevicesOptions) (*smartthings.PagedDevices, error) {
+	if m.ListDevicesWithOptionsFunc != nil {
+		return m.ListDevicesWithOptionsFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+// ListAllDevices calls ListAllDevicesFunc if set.
+func (m *MockClient) ListAllDevices(ctx context.Context) ([]smartthings.Device, error) {
+	if m.ListAllDevicesFunc != nil {
+		return m.ListAllDevicesFunc(ctx)
+	}
+	return nil, nil
+}
+
+// ListDevicesByLocation calls ListDevicesByLocationFunc if set.
+func (m *MockClient) ListDevicesByLocation(ctx context.Context, locationIDs []string) (map[string][]smartthings.Device, error) {
+	if m.ListDevicesByLocationFunc != nil {
+		return m.ListDevicesByLocationFunc(ctx, locationIDs)
+	}
+	return nil, nil
+}
+
+// GetDevice calls GetDeviceFunc if set.
+func (m *MockClient) GetDevice(ctx context.Context, deviceID string) (*smartthings.Device, error) {
+	if m.GetDeviceFunc != nil {
+		return m.GetDeviceFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// GetDeviceCached calls GetDeviceCachedFunc if set.
+func (m *MockClient) GetDeviceCached(ctx context.Context, deviceID string) (*smartthings.Device, bool, error) {
+	if m.GetDeviceCachedFunc != nil {
+		return m.GetDeviceCachedFunc(ctx, deviceID)
+	}
+	return nil, false, nil
+}
+
+// GetDeviceStatus calls GetDeviceStatusFunc if set.
+func (m *MockClient) GetDeviceStatus(ctx context.Context, deviceID string) (smartthings.Status, error) {
+	if m.GetDeviceStatusFunc != nil {
+		return m.GetDeviceStatusFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// GetDeviceFullStatus calls GetDeviceFullStatusFunc if set.
+func (m *MockClient) GetDeviceFullStatus(ctx context.Context, deviceID string) (map[string]smartthings.Status, error) {
+	if m.GetDeviceFullStatusFunc != nil {
+		return m.GetDeviceFullStatusFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// GetDeviceStatusAllComponents calls GetDeviceStatusAllComponentsFunc if set.
+func (m *MockClient) GetDeviceStatusAllComponents(ctx context.Context, deviceID string) (smartthings.Status, error) {
+	if m.GetDeviceStatusAllComponentsFunc != nil {
+		return m.GetDeviceStatusAllComponentsFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// GetComponentStatus calls GetComponentStatusFunc if set.
+func (m *MockClient) GetComponentStatus(ctx context.Context, deviceID string, componentID string) (smartthings.Status, error) {
+	if m.GetComponentStatusFunc != nil {
+		return m.GetComponentStatusFunc(ctx, deviceID, componentID)
+	}
+	return nil, nil
+}
+
+// GetCapabilityStatus calls GetCapabilityStatusFunc if set.
+func (m *MockClient) GetCapabilityStatus(ctx context.Context, deviceID string, componentID string, capabilityID string) (smartthings.Status, error) {
+	if m.GetCapabilityStatusFunc != nil {
+		return m.GetCapabilityStatusFunc(ctx, deviceID, componentID, capabilityID)
+	}
+	return nil, nil
+}
+
+// ExecuteCommand calls ExecuteCommandFunc if set.
+func (m *MockClient) ExecuteCommand(ctx context.Context, deviceID string, cmd smartthings.Command) error {
+	if m.ExecuteCommandFunc != nil {
+		return m.ExecuteCommandFunc(ctx, deviceID, cmd)
+	}
+	return nil
+}
+
+// ExecuteCommands calls ExecuteCommandsFunc if set.
+func (m *MockClient) ExecuteCommands(ctx context.Context, deviceID string, cmds []smartthings.Command) error {
+	if m.ExecuteCommandsFunc != nil {
+		return m.ExecuteCommandsFunc(ctx, deviceID, cmds)
+	}
+	return nil
+}
+
+// ExecuteCommandSequence calls ExecuteCommandSequenceFunc if set.
+func (m *MockClient) ExecuteCommandSequence(ctx context.Context, deviceID string, steps []smartthings.CommandStep) error {
+	if m.ExecuteCommandSequenceFunc != nil {
+		return m.ExecuteCommandSequenceFunc(ctx, deviceID, steps)
+	}
+	return nil
+}
+
+// ValidateCommand calls ValidateCommandFunc if set.
+func (m *MockClient) ValidateCommand(ctx context.Context, cmd smartthings.Command) error {
+	if m.ValidateCommandFunc != nil {
+		return m.ValidateCommandFunc(ctx, cmd)
+	}
+	return nil
+}
+
+// ExecuteComponentCommand calls ExecuteComponentCommandFunc if set.
+func (m *MockClient) ExecuteComponentCommand(ctx context.Context, deviceID string, component string, capability string, command string, args ...any) error {
+	if m.ExecuteComponentCommandFunc != nil {
+		return m.ExecuteComponentCommandFunc(ctx, deviceID, component, capability, command, args...)
+	}
+	return nil
+}
+
+// ExecuteCommandOnComponent calls ExecuteCommandOnComponentFunc if set.
+func (m *MockClient) ExecuteCommandOnComponent(ctx context.Context, deviceID string, componentID string, cmd smartthings.Command) error {
+	if m.ExecuteCommandOnComponentFunc != nil {
+		return m.ExecuteCommandOnComponentFunc(ctx, deviceID, componentID, cmd)
+	}
+	return nil
+}
+
+// ExecuteCommandAndRefresh calls ExecuteCommandAndRefreshFunc if set.
+func (m *MockClient) ExecuteCommandAndRefresh(ctx context.Context, deviceID string, cmd smartthings.Command) (smartthings.Status, error) {
+	if m.ExecuteCommandAndRefreshFunc != nil {
+		return m.ExecuteCommandAndRefreshFunc(ctx, deviceID, cmd)
+	}
+	return nil, nil
+}
+
+// DeleteDevice calls DeleteDeviceFunc if set.
+func (m *MockClient) DeleteDevice(ctx context.Context, deviceID string) error {
+	if m.DeleteDeviceFunc != nil {
+		return m.DeleteDeviceFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// UpdateDevice calls UpdateDeviceFunc if set.
+func (m *MockClient) UpdateDevice(ctx context.Context, deviceID string, update *smartthings.DeviceUpdate) (*smartthings.Device, error) {
+	if m.UpdateDeviceFunc != nil {
+		return m.UpdateDeviceFunc(ctx, deviceID, update)
+	}
+	return nil, nil
+}
+
+// MoveDevice calls MoveDeviceFunc if set.
+func (m *MockClient) MoveDevice(ctx context.Context, deviceID string, targetLocationID string, targetRoomID string) (*smartthings.Device, error) {
+	if m.MoveDeviceFunc != nil {
+		return m.MoveDeviceFunc(ctx, deviceID, targetLocationID, targetRoomID)
+	}
+	return nil, nil
+}
+
+// GetDeviceHealth calls GetDeviceHealthFunc if set.
+func (m *MockClient) GetDeviceHealth(ctx context.Context, deviceID string) (*smartthings.DeviceHealth, error) {
+	if m.GetDeviceHealthFunc != nil {
+		return m.GetDeviceHealthFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// DeviceHasCapability calls DeviceHasCapabilityFunc if set.
+func (m *MockClient) DeviceHasCapability(ctx context.Context, deviceID string, capability string) (bool, error) {
+	if m.DeviceHasCapabilityFunc != nil {
+		return m.DeviceHasCapabilityFunc(ctx, deviceID, capability)
+	}
+	return false, nil
+}
+
+// FindDeviceByLabel calls FindDeviceByLabelFunc if set.
+func (m *MockClient) FindDeviceByLabel(ctx context.Context, label string) (*smartthings.Device, error) {
+	if m.FindDeviceByLabelFunc != nil {
+		return m.FindDeviceByLabelFunc(ctx, label)
+	}
+	return nil, nil
+}
+
+// SearchDevices calls SearchDevicesFunc if set.
+func (m *MockClient) SearchDevices(ctx context.Context, query string) ([]smartthings.Device, error) {
+	if m.SearchDevicesFunc != nil {
+		return m.SearchDevicesFunc(ctx, query)
+	}
+	return nil, nil
+}
+
+// WaitForDeviceState calls WaitForDeviceStateFunc if set.
+func (m *MockClient) WaitForDeviceState(ctx context.Context, deviceID string, capability string, attribute string, want any, opts *smartthings.WaitOptions) error {
+	if m.WaitForDeviceStateFunc != nil {
+		return m.WaitForDeviceStateFunc(ctx, deviceID, capability, attribute, want, opts)
+	}
+	return nil
+}
+
+// Devices calls DevicesFunc if set.
+func (m *MockClient) Devices(ctx context.Context) iter.Seq2[smartthings.Device, error] {
+	if m.DevicesFunc != nil {
+		return m.DevicesFunc(ctx)
+	}
+	return func(yield func(smartthings.Device, error) bool) {}
+}
+
+// DevicesWithOptions calls DevicesWithOptionsFunc if set.
+func (m *MockClient) DevicesWithOptions(ctx context.Context, opts *smartthings.ListDevicesOptions) iter.Seq2[smartthings.Device, error] {
+	if m.DevicesWithOptionsFunc != nil {
+		return m.DevicesWithOptionsFunc(ctx, opts)
+	}
+	return func(yield func(smartthings.Device, error) bool) {}
+}
+
+// ExecuteCommandBatch calls ExecuteCommandBatchFunc if set.
+func (m *MockClient) ExecuteCommandBatch(ctx context.Context, deviceIDs []string, cmd smartthings.Command, cfg *smartthings.BatchConfig) []smartthings.BatchResult {
+	if m.ExecuteCommandBatchFunc != nil {
+		return m.ExecuteCommandBatchFunc(ctx, deviceIDs, cmd, cfg)
+	}
+	return nil
+}
+
+// ExecuteCommandsBatch calls ExecuteCommandsBatchFunc if set.
+func (m *MockClient) ExecuteCommandsBatch(ctx context.Context, batch []smartthings.BatchCommand, cfg *smartthings.BatchConfig) []smartthings.BatchResult {
+	if m.ExecuteCommandsBatchFunc != nil {
+		return m.ExecuteCommandsBatchFunc(ctx, batch, cfg)
+	}
+	return nil
+}
+
+// ExecuteScenesBatch calls ExecuteScenesBatchFunc if set.
+func (m *MockClient) ExecuteScenesBatch(ctx context.Context, sceneIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchResult {
+	if m.ExecuteScenesBatchFunc != nil {
+		return m.ExecuteScenesBatchFunc(ctx, sceneIDs, cfg)
+	}
+	return nil
+}
+
+// GetDeviceStatusBatch calls GetDeviceStatusBatchFunc if set.
+func (m *MockClient) GetDeviceStatusBatch(ctx context.Context, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchStatusResult {
+	if m.GetDeviceStatusBatchFunc != nil {
+		return m.GetDeviceStatusBatchFunc(ctx, deviceIDs, cfg)
+	}
+	return nil
+}
+
+// GetDeviceHealthBatch calls GetDeviceHealthBatchFunc if set.
+func (m *MockClient) GetDeviceHealthBatch(ctx context.Context, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchHealthResult {
+	if m.GetDeviceHealthBatchFunc != nil {
+		return m.GetDeviceHealthBatchFunc(ctx, deviceIDs, cfg)
+	}
+	return nil
+}
+
+// AssignDevicesToRoom calls AssignDevicesToRoomFunc if set.
+func (m *MockClient) AssignDevicesToRoom(ctx context.Context, locationID string, roomID string, deviceIDs []string) []smartthings.BatchResult {
+	if m.AssignDevicesToRoomFunc != nil {
+		return m.AssignDevicesToRoomFunc(ctx, locationID, roomID, deviceIDs)
+	}
+	return nil
+}
+
+// AssignDevicesToRoomWithConfig calls AssignDevicesToRoomWithConfigFunc if set.
+func (m *MockClient) AssignDevicesToRoomWithConfig(ctx context.Context, locationID string, roomID string, deviceIDs []string, cfg *smartthings.BatchConfig) []smartthings.BatchResult {
+	if m.AssignDevicesToRoomWithConfigFunc != nil {
+		return m.AssignDevicesToRoomWithConfigFunc(ctx, locationID, roomID, deviceIDs, cfg)
+	}
+	return nil
+}
+
+// GetLocationInventory calls GetLocationInventoryFunc if set.
+func (m *MockClient) GetLocationInventory(ctx context.Context, locationID string) ([]smartthings.DeviceWithStatus, error) {
+	if m.GetLocationInventoryFunc != nil {
+		return m.GetLocationInventoryFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// ExportDevices calls ExportDevicesFunc if set.
+func (m *MockClient) ExportDevices(ctx context.Context, w io.Writer) error {
+	if m.ExportDevicesFunc != nil {
+		return m.ExportDevicesFunc(ctx, w)
+	}
+	return nil
+}
+
+// ExportLocations calls ExportLocationsFunc if set.
+func (m *MockClient) ExportLocations(ctx context.Context, w io.Writer) error {
+	if m.ExportLocationsFunc != nil {
+		return m.ExportLocationsFunc(ctx, w)
+	}
+	return nil
+}
+
+// ExportRules calls ExportRulesFunc if set.
+func (m *MockClient) ExportRules(ctx context.Context, w io.Writer) error {
+	if m.ExportRulesFunc != nil {
+		return m.ExportRulesFunc(ctx, w)
+	}
+	return nil
+}
+
+// ImportRules calls ImportRulesFunc if set.
+func (m *MockClient) ImportRules(ctx context.Context, locationID string, r io.Reader) ([]smartthings.Rule, error) {
+	if m.ImportRulesFunc != nil {
+		return m.ImportRulesFunc(ctx, locationID, r)
+	}
+	return nil, nil
+}
+
+// ListLocations calls ListLocationsFunc if set.
+func (m *MockClient) ListLocations(ctx context.Context) ([]smartthings.Location, error) {
+	if m.ListLocationsFunc != nil {
+		return m.ListLocationsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// GetLocation calls GetLocationFunc if set.
+func (m *MockClient) GetLocation(ctx context.Context, locationID string) (*smartthings.Location, error) {
+	if m.GetLocationFunc != nil {
+		return m.GetLocationFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// CreateLocation calls CreateLocationFunc if set.
+func (m *MockClient) CreateLocation(ctx context.Context, location *smartthings.LocationCreate) (*smartthings.Location, error) {
+	if m.CreateLocationFunc != nil {
+		return m.CreateLocationFunc(ctx, location)
+	}
+	return nil, nil
+}
+
+// UpdateLocation calls UpdateLocationFunc if set.
+func (m *MockClient) UpdateLocation(ctx context.Context, locationID string, update *smartthings.LocationUpdate) (*smartthings.Location, error) {
+	if m.UpdateLocationFunc != nil {
+		return m.UpdateLocationFunc(ctx, locationID, update)
+	}
+	return nil, nil
+}
+
+// DeleteLocation calls DeleteLocationFunc if set.
+func (m *MockClient) DeleteLocation(ctx context.Context, locationID string) error {
+	if m.DeleteLocationFunc != nil {
+		return m.DeleteLocationFunc(ctx, locationID)
+	}
+	return nil
+}
+
+// Locations calls LocationsFunc if set.
+func (m *MockClient) Locations(ctx context.Context) iter.Seq2[smartthings.Location, error] {
+	if m.LocationsFunc != nil {
+		return m.LocationsFunc(ctx)
+	}
+	return func(yield func(smartthings.Location, error) bool) {}
+}
+
+// ListRooms calls ListRoomsFunc if set.
+func (m *MockClient) ListRooms(ctx context.Context, locationID string) ([]smartthings.Room, error) {
+	if m.ListRoomsFunc != nil {
+		return m.ListRoomsFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// ListRoomsWithOptions calls ListRoomsWithOptionsFunc if set.
+func (m *MockClient) ListRoomsWithOptions(ctx context.Context, locationID string, opts *smartthings.ListOptions) (*smartthings.PagedRooms, error) {
+	if m.ListRoomsWithOptionsFunc != nil {
+		return m.ListRoomsWithOptionsFunc(ctx, locationID, opts)
+	}
+	return nil, nil
+}
+
+// GetRoom calls GetRoomFunc if set.
+func (m *MockClient) GetRoom(ctx context.Context, locationID string, roomID string) (*smartthings.Room, error) {
+	if m.GetRoomFunc != nil {
+		return m.GetRoomFunc(ctx, locationID, roomID)
+	}
+	return nil, nil
+}
+
+// CreateRoom calls CreateRoomFunc if set.
+func (m *MockClient) CreateRoom(ctx context.Context, locationID string, room *smartthings.RoomCreate) (*smartthings.Room, error) {
+	if m.CreateRoomFunc != nil {
+		return m.CreateRoomFunc(ctx, locationID, room)
+	}
+	return nil, nil
+}
+
+// UpdateRoom calls UpdateRoomFunc if set.
+func (m *MockClient) UpdateRoom(ctx context.Context, locationID string, roomID string, update *smartthings.RoomUpdate) (*smartthings.Room, error) {
+	if m.UpdateRoomFunc != nil {
+		return m.UpdateRoomFunc(ctx, locationID, roomID, update)
+	}
+	return nil, nil
+}
+
+// DeleteRoom calls DeleteRoomFunc if set.
+func (m *MockClient) DeleteRoom(ctx context.Context, locationID string, roomID string) error {
+	if m.DeleteRoomFunc != nil {
+		return m.DeleteRoomFunc(ctx, locationID, roomID)
+	}
+	return nil
+}
+
+// Rooms calls RoomsFunc if set.
+func (m *MockClient) Rooms(ctx context.Context, locationID string) iter.Seq2[smartthings.Room, error] {
+	if m.RoomsFunc != nil {
+		return m.RoomsFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.Room, error) bool) {}
+}
+
+// ListScenes calls ListScenesFunc if set.
+func (m *MockClient) ListScenes(ctx context.Context, locationID string) ([]smartthings.Scene, error) {
+	if m.ListScenesFunc != nil {
+		return m.ListScenesFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// ListScenesWithOptions calls ListScenesWithOptionsFunc if set.
+func (m *MockClient) ListScenesWithOptions(ctx context.Context, locationID string, opts *smartthings.ListOptions) (*smartthings.PagedScenes, error) {
+	if m.ListScenesWithOptionsFunc != nil {
+		return m.ListScenesWithOptionsFunc(ctx, locationID, opts)
+	}
+	return nil, nil
+}
+
+// GetScene calls GetSceneFunc if set.
+func (m *MockClient) GetScene(ctx context.Context, sceneID string) (*smartthings.Scene, error) {
+	if m.GetSceneFunc != nil {
+		return m.GetSceneFunc(ctx, sceneID)
+	}
+	return nil, nil
+}
+
+// ExecuteScene calls ExecuteSceneFunc if set.
+func (m *MockClient) ExecuteScene(ctx context.Context, sceneID string) error {
+	if m.ExecuteSceneFunc != nil {
+		return m.ExecuteSceneFunc(ctx, sceneID)
+	}
+	return nil
+}
+
+// ExecuteSceneWithResult calls ExecuteSceneWithResultFunc if set.
+func (m *MockClient) ExecuteSceneWithResult(ctx context.Context, sceneID string) (*smartthings.SceneExecutionResult, error) {
+	if m.ExecuteSceneWithResultFunc != nil {
+		return m.ExecuteSceneWithResultFunc(ctx, sceneID)
+	}
+	return nil, nil
+}
+
+// BuildSceneFromDevices calls BuildSceneFromDevicesFunc if set.
+func (m *MockClient) BuildSceneFromDevices(ctx context.Context, locationID string, deviceIDs []string) (*smartthings.SceneCreate, error) {
+	if m.BuildSceneFromDevicesFunc != nil {
+		return m.BuildSceneFromDevicesFunc(ctx, locationID, deviceIDs)
+	}
+	return nil, nil
+}
+
+// Scenes calls ScenesFunc if set.
+func (m *MockClient) Scenes(ctx context.Context, locationID string) iter.Seq2[smartthings.Scene, error] {
+	if m.ScenesFunc != nil {
+		return m.ScenesFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.Scene, error) bool) {}
+}
+
+// ListCapabilities calls ListCapabilitiesFunc if set.
+func (m *MockClient) ListCapabilities(ctx context.Context) ([]smartthings.CapabilityReference, error) {
+	if m.ListCapabilitiesFunc != nil {
+		return m.ListCapabilitiesFunc(ctx)
+	}
+	return nil, nil
+}
+
+// ListCapabilitiesWithOptions calls ListCapabilitiesWithOptionsFunc if set.
+func (m *MockClient) ListCapabilitiesWithOptions(ctx context.Context, opts *smartthings.ListCapabilitiesOptions) ([]smartthings.CapabilityReference, error) {
+	if m.ListCapabilitiesWithOptionsFunc != nil {
+		return m.ListCapabilitiesWithOptionsFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+// GetCapability calls GetCapabilityFunc if set.
+func (m *MockClient) GetCapability(ctx context.Context, capabilityID string, version int) (*smartthings.Capability, error) {
+	if m.GetCapabilityFunc != nil {
+		return m.GetCapabilityFunc(ctx, capabilityID, version)
+	}
+	return nil, nil
+}
+
+// Capabilities calls CapabilitiesFunc if set.
+func (m *MockClient) Capabilities(ctx context.Context) iter.Seq2[smartthings.CapabilityReference, error] {
+	if m.CapabilitiesFunc != nil {
+		return m.CapabilitiesFunc(ctx)
+	}
+	return func(yield func(smartthings.CapabilityReference, error) bool) {}
+}
+
+// CapabilitiesWithOptions calls CapabilitiesWithOptionsFunc if set.
+func (m *MockClient) CapabilitiesWithOptions(ctx context.Context, opts *smartthings.ListCapabilitiesOptions) iter.Seq2[smartthings.CapabilityReference, error] {
+	if m.CapabilitiesWithOptionsFunc != nil {
+		return m.CapabilitiesWithOptionsFunc(ctx, opts)
+	}
+	return func(yield func(smartthings.CapabilityReference, error) bool) {}
+}
+
+// GetCapabilityLocalization calls GetCapabilityLocalizationFunc if set.
+func (m *MockClient) GetCapabilityLocalization(ctx context.Context, capabilityID string, version int, locale string) (*smartthings.CapabilityLocalization, error) {
+	if m.GetCapabilityLocalizationFunc != nil {
+		return m.GetCapabilityLocalizationFunc(ctx, capabilityID, version, locale)
+	}
+	return nil, nil
+}
+
+// ListCapabilityLocalizations calls ListCapabilityLocalizationsFunc if set.
+func (m *MockClient) ListCapabilityLocalizations(ctx context.Context, capabilityID string, version int) ([]smartthings.LocaleReference, error) {
+	if m.ListCapabilityLocalizationsFunc != nil {
+		return m.ListCapabilityLocalizationsFunc(ctx, capabilityID, version)
+	}
+	return nil, nil
+}
+
+// ListSubscriptions calls ListSubscriptionsFunc if set.
+func (m *MockClient) ListSubscriptions(ctx context.Context, installedAppID string) ([]smartthings.Subscription, error) {
+	if m.ListSubscriptionsFunc != nil {
+		return m.ListSubscriptionsFunc(ctx, installedAppID)
+	}
+	return nil, nil
+}
+
+// CreateSubscription calls CreateSubscriptionFunc if set.
+func (m *MockClient) CreateSubscription(ctx context.Context, installedAppID string, sub *smartthings.SubscriptionCreate) (*smartthings.Subscription, error) {
+	if m.CreateSubscriptionFunc != nil {
+		return m.CreateSubscriptionFunc(ctx, installedAppID, sub)
+	}
+	return nil, nil
+}
+
+// SubscribeLocationDevices calls SubscribeLocationDevicesFunc if set.
+func (m *MockClient) SubscribeLocationDevices(ctx context.Context, installedAppID string, locationID string) ([]smartthings.Subscription, error) {
+	if m.SubscribeLocationDevicesFunc != nil {
+		return m.SubscribeLocationDevicesFunc(ctx, installedAppID, locationID)
+	}
+	return nil, nil
+}
+
+// DeleteSubscription calls DeleteSubscriptionFunc if set.
+func (m *MockClient) DeleteSubscription(ctx context.Context, installedAppID string, subscriptionID string) error {
+	if m.DeleteSubscriptionFunc != nil {
+		return m.DeleteSubscriptionFunc(ctx, installedAppID, subscriptionID)
+	}
+	return nil
+}
+
+// DeleteAllSubscriptions calls DeleteAllSubscriptionsFunc if set.
+func (m *MockClient) DeleteAllSubscriptions(ctx context.Context, installedAppID string) error {
+	if m.DeleteAllSubscriptionsFunc != nil {
+		return m.DeleteAllSubscriptionsFunc(ctx, installedAppID)
+	}
+	return nil
+}
+
+// Subscriptions calls SubscriptionsFunc if set.
+func (m *MockClient) Subscriptions(ctx context.Context, installedAppID string) iter.Seq2[smartthings.Subscription, error] {
+	if m.SubscriptionsFunc != nil {
+		return m.SubscriptionsFunc(ctx, installedAppID)
+	}
+	return func(yield func(smartthings.Subscription, error) bool) {}
+}
+
+// ListRules calls ListRulesFunc if set.
+func (m *MockClient) ListRules(ctx context.Context, locationID string) ([]smartthings.Rule, error) {
+	if m.ListRulesFunc != nil {
+		return m.ListRulesFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// ListRulesForDevice calls ListRulesForDeviceFunc if set.
+func (m *MockClient) ListRulesForDevice(ctx context.Context, locationID string, deviceID string) ([]smartthings.Rule, error) {
+	if m.ListRulesForDeviceFunc != nil {
+		return m.ListRulesForDeviceFunc(ctx, locationID, deviceID)
+	}
+	return nil, nil
+}
+
+// GetRule calls GetRuleFunc if set.
+func (m *MockClient) GetRule(ctx context.Context, ruleID string) (*smartthings.Rule, error) {
+	if m.GetRuleFunc != nil {
+		return m.GetRuleFunc(ctx, ruleID)
+	}
+	return nil, nil
+}
+
+// CreateRule calls CreateRuleFunc if set.
+func (m *MockClient) CreateRule(ctx context.Context, locationID string, rule *smartthings.RuleCreate) (*smartthings.Rule, error) {
+	if m.CreateRuleFunc != nil {
+		return m.CreateRuleFunc(ctx, locationID, rule)
+	}
+	return nil, nil
+}
+
+// UpdateRule calls UpdateRuleFunc if set.
+func (m *MockClient) UpdateRule(ctx context.Context, ruleID string, rule *smartthings.RuleUpdate) (*smartthings.Rule, error) {
+	if m.UpdateRuleFunc != nil {
+		return m.UpdateRuleFunc(ctx, ruleID, rule)
+	}
+	return nil, nil
+}
+
+// DeleteRule calls DeleteRuleFunc if set.
+func (m *MockClient) DeleteRule(ctx context.Context, ruleID string) error {
+	if m.DeleteRuleFunc != nil {
+		return m.DeleteRuleFunc(ctx, ruleID)
+	}
+	return nil
+}
+
+// DeleteAllRules calls DeleteAllRulesFunc if set.
+func (m *MockClient) DeleteAllRules(ctx context.Context, locationID string) error {
+	if m.DeleteAllRulesFunc != nil {
+		return m.DeleteAllRulesFunc(ctx, locationID)
+	}
+	return nil
+}
+
+// ExecuteRule calls ExecuteRuleFunc if set.
+func (m *MockClient) ExecuteRule(ctx context.Context, ruleID string) error {
+	if m.ExecuteRuleFunc != nil {
+		return m.ExecuteRuleFunc(ctx, ruleID)
+	}
+	return nil
+}
+
+// EnableRule calls EnableRuleFunc if set.
+func (m *MockClient) EnableRule(ctx context.Context, ruleID string) error {
+	if m.EnableRuleFunc != nil {
+		return m.EnableRuleFunc(ctx, ruleID)
+	}
+	return nil
+}
+
+// DisableRule calls DisableRuleFunc if set.
+func (m *MockClient) DisableRule(ctx context.Context, ruleID string) error {
+	if m.DisableRuleFunc != nil {
+		return m.DisableRuleFunc(ctx, ruleID)
+	}
+	return nil
+}
+
+// TestRule calls TestRuleFunc if set.
+func (m *MockClient) TestRule(ctx context.Context, ruleID string) (*smartthings.RuleTestResult, error) {
+	if m.TestRuleFunc != nil {
+		return m.TestRuleFunc(ctx, ruleID)
+	}
+	return nil, nil
+}
+
+// Rules calls RulesFunc if set.
+func (m *MockClient) Rules(ctx context.Context, locationID string) iter.Seq2[smartthings.Rule, error] {
+	if m.RulesFunc != nil {
+		return m.RulesFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.Rule, error) bool) {}
+}
+
+// ListSchedules calls ListSchedulesFunc if set.
+func (m *MockClient) ListSchedules(ctx context.Context, installedAppID string) ([]smartthings.Schedule, error) {
+	if m.ListSchedulesFunc != nil {
+		return m.ListSchedulesFunc(ctx, installedAppID)
+	}
+	return nil, nil
+}
+
+// GetSchedule calls GetScheduleFunc if set.
+func (m *MockClient) GetSchedule(ctx context.Context, installedAppID string, scheduleName string) (*smartthings.Schedule, error) {
+	if m.GetScheduleFunc != nil {
+		return m.GetScheduleFunc(ctx, installedAppID, scheduleName)
+	}
+	return nil, nil
+}
+
+// CreateSchedule calls CreateScheduleFunc if set.
+func (m *MockClient) CreateSchedule(ctx context.Context, installedAppID string, schedule *smartthings.ScheduleCreate) (*smartthings.Schedule, error) {
+	if m.CreateScheduleFunc != nil {
+		return m.CreateScheduleFunc(ctx, installedAppID, schedule)
+	}
+	return nil, nil
+}
+
+// DeleteSchedule calls DeleteScheduleFunc if set.
+func (m *MockClient) DeleteSchedule(ctx context.Context, installedAppID string, scheduleName string) error {
+	if m.DeleteScheduleFunc != nil {
+		return m.DeleteScheduleFunc(ctx, installedAppID, scheduleName)
+	}
+	return nil
+}
+
+// Schedules calls SchedulesFunc if set.
+func (m *MockClient) Schedules(ctx context.Context, installedAppID string) iter.Seq2[smartthings.Schedule, error] {
+	if m.SchedulesFunc != nil {
+		return m.SchedulesFunc(ctx, installedAppID)
+	}
+	return func(yield func(smartthings.Schedule, error) bool) {}
+}
+
+// ListInstalledApps calls ListInstalledAppsFunc if set.
+func (m *MockClient) ListInstalledApps(ctx context.Context, locationID string) ([]smartthings.InstalledApp, error) {
+	if m.ListInstalledAppsFunc != nil {
+		return m.ListInstalledAppsFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// GetInstalledApp calls GetInstalledAppFunc if set.
+func (m *MockClient) GetInstalledApp(ctx context.Context, installedAppID string) (*smartthings.InstalledApp, error) {
+	if m.GetInstalledAppFunc != nil {
+		return m.GetInstalledAppFunc(ctx, installedAppID)
+	}
+	return nil, nil
+}
+
+// DeleteInstalledApp calls DeleteInstalledAppFunc if set.
+func (m *MockClient) DeleteInstalledApp(ctx context.Context, installedAppID string) error {
+	if m.DeleteInstalledAppFunc != nil {
+		return m.DeleteInstalledAppFunc(ctx, installedAppID)
+	}
+	return nil
+}
+
+// ListInstalledAppConfigs calls ListInstalledAppConfigsFunc if set.
+func (m *MockClient) ListInstalledAppConfigs(ctx context.Context, installedAppID string) ([]smartthings.InstalledAppConfigItem, error) {
+	if m.ListInstalledAppConfigsFunc != nil {
+		return m.ListInstalledAppConfigsFunc(ctx, installedAppID)
+	}
+	return nil, nil
+}
+
+// GetInstalledAppConfig calls GetInstalledAppConfigFunc if set.
+func (m *MockClient) GetInstalledAppConfig(ctx context.Context, installedAppID string, configID string) (*smartthings.InstalledAppConfiguration, error) {
+	if m.GetInstalledAppConfigFunc != nil {
+		return m.GetInstalledAppConfigFunc(ctx, installedAppID, configID)
+	}
+	return nil, nil
+}
+
+// UpdateInstalledAppConfig calls UpdateInstalledAppConfigFunc if set.
+func (m *MockClient) UpdateInstalledAppConfig(ctx context.Context, installedAppID string, configID string, config *smartthings.InstalledAppConfiguration) (*smartthings.InstalledAppConfiguration, error) {
+	if m.UpdateInstalledAppConfigFunc != nil {
+		return m.UpdateInstalledAppConfigFunc(ctx, installedAppID, configID, config)
+	}
+	return nil, nil
+}
+
+// GetCurrentInstalledAppConfig calls GetCurrentInstalledAppConfigFunc if set.
+func (m *MockClient) GetCurrentInstalledAppConfig(ctx context.Context, installedAppID string) (*smartthings.InstalledAppConfiguration, error) {
+	if m.GetCurrentInstalledAppConfigFunc != nil {
+		return m.GetCurrentInstalledAppConfigFunc(ctx, installedAppID)
+	}
+	return nil, nil
+}
+
+// InstalledApps calls InstalledAppsFunc if set.
+func (m *MockClient) InstalledApps(ctx context.Context, locationID string) iter.Seq2[smartthings.InstalledApp, error] {
+	if m.InstalledAppsFunc != nil {
+		return m.InstalledAppsFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.InstalledApp, error) bool) {}
+}
+
+// ListModes calls ListModesFunc if set.
+func (m *MockClient) ListModes(ctx context.Context, locationID string) ([]smartthings.Mode, error) {
+	if m.ListModesFunc != nil {
+		return m.ListModesFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// GetMode calls GetModeFunc if set.
+func (m *MockClient) GetMode(ctx context.Context, locationID string, modeID string) (*smartthings.Mode, error) {
+	if m.GetModeFunc != nil {
+		return m.GetModeFunc(ctx, locationID, modeID)
+	}
+	return nil, nil
+}
+
+// GetCurrentMode calls GetCurrentModeFunc if set.
+func (m *MockClient) GetCurrentMode(ctx context.Context, locationID string) (*smartthings.Mode, error) {
+	if m.GetCurrentModeFunc != nil {
+		return m.GetCurrentModeFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// SetCurrentMode calls SetCurrentModeFunc if set.
+func (m *MockClient) SetCurrentMode(ctx context.Context, locationID string, modeID string) (*smartthings.Mode, error) {
+	if m.SetCurrentModeFunc != nil {
+		return m.SetCurrentModeFunc(ctx, locationID, modeID)
+	}
+	return nil, nil
+}
+
+// SetModeForPresence calls SetModeForPresenceFunc if set.
+func (m *MockClient) SetModeForPresence(ctx context.Context, locationID string, anyonePresent bool, homeMode string, awayMode string) error {
+	if m.SetModeForPresenceFunc != nil {
+		return m.SetModeForPresenceFunc(ctx, locationID, anyonePresent, homeMode, awayMode)
+	}
+	return nil
+}
+
+// Modes calls ModesFunc if set.
+func (m *MockClient) Modes(ctx context.Context, locationID string) iter.Seq2[smartthings.Mode, error] {
+	if m.ModesFunc != nil {
+		return m.ModesFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.Mode, error) bool) {}
+}
+
+// GetDeviceEvents calls GetDeviceEventsFunc if set.
+func (m *MockClient) GetDeviceEvents(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedEvents, error) {
+	if m.GetDeviceEventsFunc != nil {
+		return m.GetDeviceEventsFunc(ctx, deviceID, opts)
+	}
+	return nil, nil
+}
+
+// GetDeviceEventsPage calls GetDeviceEventsPageFunc if set.
+func (m *MockClient) GetDeviceEventsPage(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedEvents, string, error) {
+	if m.GetDeviceEventsPageFunc != nil {
+		return m.GetDeviceEventsPageFunc(ctx, deviceID, opts)
+	}
+	return nil, "", nil
+}
+
+// GetDeviceStates calls GetDeviceStatesFunc if set.
+func (m *MockClient) GetDeviceStates(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.PagedStates, error) {
+	if m.GetDeviceStatesFunc != nil {
+		return m.GetDeviceStatesFunc(ctx, deviceID, opts)
+	}
+	return nil, nil
+}
+
+// GetEnergyUsage calls GetEnergyUsageFunc if set.
+func (m *MockClient) GetEnergyUsage(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) (*smartthings.EnergyUsageSummary, error) {
+	if m.GetEnergyUsageFunc != nil {
+		return m.GetEnergyUsageFunc(ctx, deviceID, opts)
+	}
+	return nil, nil
+}
+
+// DeviceEvents calls DeviceEventsFunc if set.
+func (m *MockClient) DeviceEvents(ctx context.Context, deviceID string, opts *smartthings.HistoryOptions) iter.Seq2[smartthings.DeviceEvent, error] {
+	if m.DeviceEventsFunc != nil {
+		return m.DeviceEventsFunc(ctx, deviceID, opts)
+	}
+	return func(yield func(smartthings.DeviceEvent, error) bool) {}
+}
+
+// DeviceEventsFiltered calls DeviceEventsFilteredFunc if set.
+func (m *MockClient) DeviceEventsFiltered(ctx context.Context, deviceID string, filter smartthings.EventFilter) iter.Seq2[smartthings.DeviceEvent, error] {
+	if m.DeviceEventsFilteredFunc != nil {
+		return m.DeviceEventsFilteredFunc(ctx, deviceID, filter)
+	}
+	return func(yield func(smartthings.DeviceEvent, error) bool) {}
+}
+
+// DeviceEventStream calls DeviceEventStreamFunc if set.
+func (m *MockClient) DeviceEventStream(ctx context.Context, deviceIDs []string) (iter.Seq2[smartthings.DeviceEvent, error], error) {
+	if m.DeviceEventStreamFunc != nil {
+		return m.DeviceEventStreamFunc(ctx, deviceIDs)
+	}
+	return func(yield func(smartthings.DeviceEvent, error) bool) {}, nil
+}
+
+// ListApps calls ListAppsFunc if set.
+func (m *MockClient) ListApps(ctx context.Context) ([]smartthings.App, error) {
+	if m.ListAppsFunc != nil {
+		return m.ListAppsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// GetApp calls GetAppFunc if set.
+func (m *MockClient) GetApp(ctx context.Context, appID string) (*smartthings.App, error) {
+	if m.GetAppFunc != nil {
+		return m.GetAppFunc(ctx, appID)
+	}
+	return nil, nil
+}
+
+// CreateApp calls CreateAppFunc if set.
+func (m *MockClient) CreateApp(ctx context.Context, app *smartthings.AppCreate) (*smartthings.App, error) {
+	if m.CreateAppFunc != nil {
+		return m.CreateAppFunc(ctx, app)
+	}
+	return nil, nil
+}
+
+// UpdateApp calls UpdateAppFunc if set.
+func (m *MockClient) UpdateApp(ctx context.Context, appID string, update *smartthings.AppUpdate) (*smartthings.App, error) {
+	if m.UpdateAppFunc != nil {
+		return m.UpdateAppFunc(ctx, appID, update)
+	}
+	return nil, nil
+}
+
+// DeleteApp calls DeleteAppFunc if set.
+func (m *MockClient) DeleteApp(ctx context.Context, appID string) error {
+	if m.DeleteAppFunc != nil {
+		return m.DeleteAppFunc(ctx, appID)
+	}
+	return nil
+}
+
+// GetAppOAuth calls GetAppOAuthFunc if set.
+func (m *MockClient) GetAppOAuth(ctx context.Context, appID string) (*smartthings.AppOAuth, error) {
+	if m.GetAppOAuthFunc != nil {
+		return m.GetAppOAuthFunc(ctx, appID)
+	}
+	return nil, nil
+}
+
+// UpdateAppOAuth calls UpdateAppOAuthFunc if set.
+func (m *MockClient) UpdateAppOAuth(ctx context.Context, appID string, oauth *smartthings.AppOAuth) (*smartthings.AppOAuth, error) {
+	if m.UpdateAppOAuthFunc != nil {
+		return m.UpdateAppOAuthFunc(ctx, appID, oauth)
+	}
+	return nil, nil
+}
+
+// GenerateAppOAuth calls GenerateAppOAuthFunc if set.
+func (m *MockClient) GenerateAppOAuth(ctx context.Context, appID string) (*smartthings.AppOAuthGenerated, error) {
+	if m.GenerateAppOAuthFunc != nil {
+		return m.GenerateAppOAuthFunc(ctx, appID)
+	}
+	return nil, nil
+}
+
+// Apps calls AppsFunc if set.
+func (m *MockClient) Apps(ctx context.Context) iter.Seq2[smartthings.App, error] {
+	if m.AppsFunc != nil {
+		return m.AppsFunc(ctx)
+	}
+	return func(yield func(smartthings.App, error) bool) {}
+}
+
+// ListDeviceProfiles calls ListDeviceProfilesFunc if set.
+func (m *MockClient) ListDeviceProfiles(ctx context.Context) ([]smartthings.DeviceProfileFull, error) {
+	if m.ListDeviceProfilesFunc != nil {
+		return m.ListDeviceProfilesFunc(ctx)
+	}
+	return nil, nil
+}
+
+// GetDeviceProfile calls GetDeviceProfileFunc if set.
+func (m *MockClient) GetDeviceProfile(ctx context.Context, profileID string) (*smartthings.DeviceProfileFull, error) {
+	if m.GetDeviceProfileFunc != nil {
+		return m.GetDeviceProfileFunc(ctx, profileID)
+	}
+	return nil, nil
+}
+
+// CreateDeviceProfile calls CreateDeviceProfileFunc if set.
+func (m *MockClient) CreateDeviceProfile(ctx context.Context, profile *smartthings.DeviceProfileCreate) (*smartthings.DeviceProfileFull, error) {
+	if m.CreateDeviceProfileFunc != nil {
+		return m.CreateDeviceProfileFunc(ctx, profile)
+	}
+	return nil, nil
+}
+
+// UpdateDeviceProfile calls UpdateDeviceProfileFunc if set.
+func (m *MockClient) UpdateDeviceProfile(ctx context.Context, profileID string, update *smartthings.DeviceProfileUpdate) (*smartthings.DeviceProfileFull, error) {
+	if m.UpdateDeviceProfileFunc != nil {
+		return m.UpdateDeviceProfileFunc(ctx, profileID, update)
+	}
+	return nil, nil
+}
+
+// DeleteDeviceProfile calls DeleteDeviceProfileFunc if set.
+func (m *MockClient) DeleteDeviceProfile(ctx context.Context, profileID string) error {
+	if m.DeleteDeviceProfileFunc != nil {
+		return m.DeleteDeviceProfileFunc(ctx, profileID)
+	}
+	return nil
+}
+
+// DeviceProfiles calls DeviceProfilesFunc if set.
+func (m *MockClient) DeviceProfiles(ctx context.Context) iter.Seq2[smartthings.DeviceProfileFull, error] {
+	if m.DeviceProfilesFunc != nil {
+		return m.DeviceProfilesFunc(ctx)
+	}
+	return func(yield func(smartthings.DeviceProfileFull, error) bool) {}
+}
+
+// ListDevicePreferences calls ListDevicePreferencesFunc if set.
+func (m *MockClient) ListDevicePreferences(ctx context.Context, namespace string) ([]smartthings.DevicePreference, error) {
+	if m.ListDevicePreferencesFunc != nil {
+		return m.ListDevicePreferencesFunc(ctx, namespace)
+	}
+	return nil, nil
+}
+
+// GetDevicePreference calls GetDevicePreferenceFunc if set.
+func (m *MockClient) GetDevicePreference(ctx context.Context, preferenceID string) (*smartthings.DevicePreference, error) {
+	if m.GetDevicePreferenceFunc != nil {
+		return m.GetDevicePreferenceFunc(ctx, preferenceID)
+	}
+	return nil, nil
+}
+
+// CreateDevicePreference calls CreateDevicePreferenceFunc if set.
+func (m *MockClient) CreateDevicePreference(ctx context.Context, pref *smartthings.DevicePreferenceCreate) (*smartthings.DevicePreference, error) {
+	if m.CreateDevicePreferenceFunc != nil {
+		return m.CreateDevicePreferenceFunc(ctx, pref)
+	}
+	return nil, nil
+}
+
+// UpdateDevicePreference calls UpdateDevicePreferenceFunc if set.
+func (m *MockClient) UpdateDevicePreference(ctx context.Context, preferenceID string, pref *smartthings.DevicePreference) (*smartthings.DevicePreference, error) {
+	if m.UpdateDevicePreferenceFunc != nil {
+		return m.UpdateDevicePreferenceFunc(ctx, preferenceID, pref)
+	}
+	return nil, nil
+}
+
+// CreatePreferenceTranslations calls CreatePreferenceTranslationsFunc if set.
+func (m *MockClient) CreatePreferenceTranslations(ctx context.Context, preferenceID string, localization *smartthings.PreferenceLocalization) (*smartthings.PreferenceLocalization, error) {
+	if m.CreatePreferenceTranslationsFunc != nil {
+		return m.CreatePreferenceTranslationsFunc(ctx, preferenceID, localization)
+	}
+	return nil, nil
+}
+
+// GetPreferenceTranslations calls GetPreferenceTranslationsFunc if set.
+func (m *MockClient) GetPreferenceTranslations(ctx context.Context, preferenceID string, locale string) (*smartthings.PreferenceLocalization, error) {
+	if m.GetPreferenceTranslationsFunc != nil {
+		return m.GetPreferenceTranslationsFunc(ctx, preferenceID, locale)
+	}
+	return nil, nil
+}
+
+// ListPreferenceTranslations calls ListPreferenceTranslationsFunc if set.
+func (m *MockClient) ListPreferenceTranslations(ctx context.Context, preferenceID string) ([]smartthings.LocaleReference, error) {
+	if m.ListPreferenceTranslationsFunc != nil {
+		return m.ListPreferenceTranslationsFunc(ctx, preferenceID)
+	}
+	return nil, nil
+}
+
+// UpdatePreferenceTranslations calls UpdatePreferenceTranslationsFunc if set.
+func (m *MockClient) UpdatePreferenceTranslations(ctx context.Context, preferenceID string, localization *smartthings.PreferenceLocalization) (*smartthings.PreferenceLocalization, error) {
+	if m.UpdatePreferenceTranslationsFunc != nil {
+		return m.UpdatePreferenceTranslationsFunc(ctx, preferenceID, localization)
+	}
+	return nil, nil
+}
+
+// DevicePreferences calls DevicePreferencesFunc if set.
+func (m *MockClient) DevicePreferences(ctx context.Context, namespace string) iter.Seq2[smartthings.DevicePreference, error] {
+	if m.DevicePreferencesFunc != nil {
+		return m.DevicePreferencesFunc(ctx, namespace)
+	}
+	return func(yield func(smartthings.DevicePreference, error) bool) {}
+}
+
+// GeneratePresentation calls GeneratePresentationFunc if set.
+func (m *MockClient) GeneratePresentation(ctx context.Context, profileID string) (*smartthings.PresentationDeviceConfig, error) {
+	if m.GeneratePresentationFunc != nil {
+		return m.GeneratePresentationFunc(ctx, profileID)
+	}
+	return nil, nil
+}
+
+// CreatePresentationConfig calls CreatePresentationConfigFunc if set.
+func (m *MockClient) CreatePresentationConfig(ctx context.Context, config *smartthings.PresentationDeviceConfigCreate) (*smartthings.PresentationDeviceConfig, error) {
+	if m.CreatePresentationConfigFunc != nil {
+		return m.CreatePresentationConfigFunc(ctx, config)
+	}
+	return nil, nil
+}
+
+// GetPresentationConfig calls GetPresentationConfigFunc if set.
+func (m *MockClient) GetPresentationConfig(ctx context.Context, presentationID string, manufacturerName string) (*smartthings.PresentationDeviceConfig, error) {
+	if m.GetPresentationConfigFunc != nil {
+		return m.GetPresentationConfigFunc(ctx, presentationID, manufacturerName)
+	}
+	return nil, nil
+}
+
+// GetDevicePresentation calls GetDevicePresentationFunc if set.
+func (m *MockClient) GetDevicePresentation(ctx context.Context, presentationID string, manufacturerName string) (*smartthings.PresentationDevicePresentation, error) {
+	if m.GetDevicePresentationFunc != nil {
+		return m.GetDevicePresentationFunc(ctx, presentationID, manufacturerName)
+	}
+	return nil, nil
+}
+
+// BuildDeviceUIModel calls BuildDeviceUIModelFunc if set.
+func (m *MockClient) BuildDeviceUIModel(ctx context.Context, deviceID string) (*smartthings.DeviceUIModel, error) {
+	if m.BuildDeviceUIModelFunc != nil {
+		return m.BuildDeviceUIModelFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// GetHub calls GetHubFunc if set.
+func (m *MockClient) GetHub(ctx context.Context, hubID string) (*smartthings.Hub, error) {
+	if m.GetHubFunc != nil {
+		return m.GetHubFunc(ctx, hubID)
+	}
+	return nil, nil
+}
+
+// GetHubFirmware calls GetHubFirmwareFunc if set.
+func (m *MockClient) GetHubFirmware(ctx context.Context, hubID string) (*smartthings.HubFirmware, error) {
+	if m.GetHubFirmwareFunc != nil {
+		return m.GetHubFirmwareFunc(ctx, hubID)
+	}
+	return nil, nil
+}
+
+// HubIsOnline calls HubIsOnlineFunc if set.
+func (m *MockClient) HubIsOnline(ctx context.Context, hubID string) (bool, error) {
+	if m.HubIsOnlineFunc != nil {
+		return m.HubIsOnlineFunc(ctx, hubID)
+	}
+	return false, nil
+}
+
+// GetHubCharacteristics calls GetHubCharacteristicsFunc if set.
+func (m *MockClient) GetHubCharacteristics(ctx context.Context, hubID string) (smartthings.HubCharacteristics, error) {
+	if m.GetHubCharacteristicsFunc != nil {
+		return m.GetHubCharacteristicsFunc(ctx, hubID)
+	}
+	return nil, nil
+}
+
+// ListEnrolledChannels calls ListEnrolledChannelsFunc if set.
+func (m *MockClient) ListEnrolledChannels(ctx context.Context, hubID string) ([]smartthings.EnrolledChannel, error) {
+	if m.ListEnrolledChannelsFunc != nil {
+		return m.ListEnrolledChannelsFunc(ctx, hubID)
+	}
+	return nil, nil
+}
+
+// ListInstalledDrivers calls ListInstalledDriversFunc if set.
+func (m *MockClient) ListInstalledDrivers(ctx context.Context, hubID string, deviceID string) ([]smartthings.InstalledDriver, error) {
+	if m.ListInstalledDriversFunc != nil {
+		return m.ListInstalledDriversFunc(ctx, hubID, deviceID)
+	}
+	return nil, nil
+}
+
+// GetInstalledDriver calls GetInstalledDriverFunc if set.
+func (m *MockClient) GetInstalledDriver(ctx context.Context, hubID string, driverID string) (*smartthings.InstalledDriver, error) {
+	if m.GetInstalledDriverFunc != nil {
+		return m.GetInstalledDriverFunc(ctx, hubID, driverID)
+	}
+	return nil, nil
+}
+
+// InstallDriver calls InstallDriverFunc if set.
+func (m *MockClient) InstallDriver(ctx context.Context, driverID string, hubID string, channelID string) error {
+	if m.InstallDriverFunc != nil {
+		return m.InstallDriverFunc(ctx, driverID, hubID, channelID)
+	}
+	return nil
+}
+
+// UninstallDriver calls UninstallDriverFunc if set.
+func (m *MockClient) UninstallDriver(ctx context.Context, driverID string, hubID string) error {
+	if m.UninstallDriverFunc != nil {
+		return m.UninstallDriverFunc(ctx, driverID, hubID)
+	}
+	return nil
+}
+
+// SwitchDriver calls SwitchDriverFunc if set.
+func (m *MockClient) SwitchDriver(ctx context.Context, driverID string, hubID string, deviceID string, forceUpdate bool) error {
+	if m.SwitchDriverFunc != nil {
+		return m.SwitchDriverFunc(ctx, driverID, hubID, deviceID, forceUpdate)
+	}
+	return nil
+}
+
+// EnrolledChannels calls EnrolledChannelsFunc if set.
+func (m *MockClient) EnrolledChannels(ctx context.Context, hubID string) iter.Seq2[smartthings.EnrolledChannel, error] {
+	if m.EnrolledChannelsFunc != nil {
+		return m.EnrolledChannelsFunc(ctx, hubID)
+	}
+	return func(yield func(smartthings.EnrolledChannel, error) bool) {}
+}
+
+// InstalledDrivers calls InstalledDriversFunc if set.
+func (m *MockClient) InstalledDrivers(ctx context.Context, hubID string, deviceID string) iter.Seq2[smartthings.InstalledDriver, error] {
+	if m.InstalledDriversFunc != nil {
+		return m.InstalledDriversFunc(ctx, hubID, deviceID)
+	}
+	return func(yield func(smartthings.InstalledDriver, error) bool) {}
+}
+
+// ListDrivers calls ListDriversFunc if set.
+func (m *MockClient) ListDrivers(ctx context.Context) ([]smartthings.EdgeDriverSummary, error) {
+	if m.ListDriversFunc != nil {
+		return m.ListDriversFunc(ctx)
+	}
+	return nil, nil
+}
+
+// ListDefaultDrivers calls ListDefaultDriversFunc if set.
+func (m *MockClient) ListDefaultDrivers(ctx context.Context) ([]smartthings.EdgeDriver, error) {
+	if m.ListDefaultDriversFunc != nil {
+		return m.ListDefaultDriversFunc(ctx)
+	}
+	return nil, nil
+}
+
+// GetDriver calls GetDriverFunc if set.
+func (m *MockClient) GetDriver(ctx context.Context, driverID string) (*smartthings.EdgeDriver, error) {
+	if m.GetDriverFunc != nil {
+		return m.GetDriverFunc(ctx, driverID)
+	}
+	return nil, nil
+}
+
+// GetDriverRevision calls GetDriverRevisionFunc if set.
+func (m *MockClient) GetDriverRevision(ctx context.Context, driverID string, version string) (*smartthings.EdgeDriver, error) {
+	if m.GetDriverRevisionFunc != nil {
+		return m.GetDriverRevisionFunc(ctx, driverID, version)
+	}
+	return nil, nil
+}
+
+// DeleteDriver calls DeleteDriverFunc if set.
+func (m *MockClient) DeleteDriver(ctx context.Context, driverID string) error {
+	if m.DeleteDriverFunc != nil {
+		return m.DeleteDriverFunc(ctx, driverID)
+	}
+	return nil
+}
+
+// UploadDriver calls UploadDriverFunc if set.
+func (m *MockClient) UploadDriver(ctx context.Context, archiveData []byte) (*smartthings.EdgeDriver, error) {
+	if m.UploadDriverFunc != nil {
+		return m.UploadDriverFunc(ctx, archiveData)
+	}
+	return nil, nil
+}
+
+// Drivers calls DriversFunc if set.
+func (m *MockClient) Drivers(ctx context.Context) iter.Seq2[smartthings.EdgeDriverSummary, error] {
+	if m.DriversFunc != nil {
+		return m.DriversFunc(ctx)
+	}
+	return func(yield func(smartthings.EdgeDriverSummary, error) bool) {}
+}
+
+// ListChannels calls ListChannelsFunc if set.
+func (m *MockClient) ListChannels(ctx context.Context, opts *smartthings.ChannelListOptions) ([]smartthings.Channel, error) {
+	if m.ListChannelsFunc != nil {
+		return m.ListChannelsFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+// GetChannel calls GetChannelFunc if set.
+func (m *MockClient) GetChannel(ctx context.Context, channelID string) (*smartthings.Channel, error) {
+	if m.GetChannelFunc != nil {
+		return m.GetChannelFunc(ctx, channelID)
+	}
+	return nil, nil
+}
+
+// CreateChannel calls CreateChannelFunc if set.
+func (m *MockClient) CreateChannel(ctx context.Context, channel *smartthings.ChannelCreate) (*smartthings.Channel, error) {
+	if m.CreateChannelFunc != nil {
+		return m.CreateChannelFunc(ctx, channel)
+	}
+	return nil, nil
+}
+
+// UpdateChannel calls UpdateChannelFunc if set.
+func (m *MockClient) UpdateChannel(ctx context.Context, channelID string, update *smartthings.ChannelUpdate) (*smartthings.Channel, error) {
+	if m.UpdateChannelFunc != nil {
+		return m.UpdateChannelFunc(ctx, channelID, update)
+	}
+	return nil, nil
+}
+
+// DeleteChannel calls DeleteChannelFunc if set.
+func (m *MockClient) DeleteChannel(ctx context.Context, channelID string) error {
+	if m.DeleteChannelFunc != nil {
+		return m.DeleteChannelFunc(ctx, channelID)
+	}
+	return nil
+}
+
+// ListAssignedDrivers calls ListAssignedDriversFunc if set.
+func (m *MockClient) ListAssignedDrivers(ctx context.Context, channelID string) ([]smartthings.DriverChannelDetails, error) {
+	if m.ListAssignedDriversFunc != nil {
+		return m.ListAssignedDriversFunc(ctx, channelID)
+	}
+	return nil, nil
+}
+
+// AssignDriver calls AssignDriverFunc if set.
+func (m *MockClient) AssignDriver(ctx context.Context, channelID string, driverID string, version string) (*smartthings.DriverChannelDetails, error) {
+	if m.AssignDriverFunc != nil {
+		return m.AssignDriverFunc(ctx, channelID, driverID, version)
+	}
+	return nil, nil
+}
+
+// UnassignDriver calls UnassignDriverFunc if set.
+func (m *MockClient) UnassignDriver(ctx context.Context, channelID string, driverID string) error {
+	if m.UnassignDriverFunc != nil {
+		return m.UnassignDriverFunc(ctx, channelID, driverID)
+	}
+	return nil
+}
+
+// GetDriverChannelMetaInfo calls GetDriverChannelMetaInfoFunc if set.
+func (m *MockClient) GetDriverChannelMetaInfo(ctx context.Context, channelID string, driverID string) (*smartthings.EdgeDriver, error) {
+	if m.GetDriverChannelMetaInfoFunc != nil {
+		return m.GetDriverChannelMetaInfoFunc(ctx, channelID, driverID)
+	}
+	return nil, nil
+}
+
+// EnrollHub calls EnrollHubFunc if set.
+func (m *MockClient) EnrollHub(ctx context.Context, channelID string, hubID string) error {
+	if m.EnrollHubFunc != nil {
+		return m.EnrollHubFunc(ctx, channelID, hubID)
+	}
+	return nil
+}
+
+// UnenrollHub calls UnenrollHubFunc if set.
+func (m *MockClient) UnenrollHub(ctx context.Context, channelID string, hubID string) error {
+	if m.UnenrollHubFunc != nil {
+		return m.UnenrollHubFunc(ctx, channelID, hubID)
+	}
+	return nil
+}
+
+// Channels calls ChannelsFunc if set.
+func (m *MockClient) Channels(ctx context.Context, opts *smartthings.ChannelListOptions) iter.Seq2[smartthings.Channel, error] {
+	if m.ChannelsFunc != nil {
+		return m.ChannelsFunc(ctx, opts)
+	}
+	return func(yield func(smartthings.Channel, error) bool) {}
+}
+
+// AssignedDrivers calls AssignedDriversFunc if set.
+func (m *MockClient) AssignedDrivers(ctx context.Context, channelID string) iter.Seq2[smartthings.DriverChannelDetails, error] {
+	if m.AssignedDriversFunc != nil {
+		return m.AssignedDriversFunc(ctx, channelID)
+	}
+	return func(yield func(smartthings.DriverChannelDetails, error) bool) {}
+}
+
+// CreateVirtualDevice calls CreateVirtualDeviceFunc if set.
+func (m *MockClient) CreateVirtualDevice(ctx context.Context, req *smartthings.VirtualDeviceCreateRequest) (*smartthings.Device, error) {
+	if m.CreateVirtualDeviceFunc != nil {
+		return m.CreateVirtualDeviceFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+// CreateStandardVirtualDevice calls CreateStandardVirtualDeviceFunc if set.
+func (m *MockClient) CreateStandardVirtualDevice(ctx context.Context, req *smartthings.VirtualDeviceStandardCreateRequest) (*smartthings.Device, error) {
+	if m.CreateStandardVirtualDeviceFunc != nil {
+		return m.CreateStandardVirtualDeviceFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+// ListVirtualDevices calls ListVirtualDevicesFunc if set.
+func (m *MockClient) ListVirtualDevices(ctx context.Context, opts *smartthings.VirtualDeviceListOptions) ([]smartthings.Device, error) {
+	if m.ListVirtualDevicesFunc != nil {
+		return m.ListVirtualDevicesFunc(ctx, opts)
+	}
+	return nil, nil
+}
+
+// CreateVirtualDeviceEvents calls CreateVirtualDeviceEventsFunc if set.
+func (m *MockClient) CreateVirtualDeviceEvents(ctx context.Context, deviceID string, events []smartthings.VirtualDeviceEvent) (*smartthings.VirtualDeviceEventsResponse, error) {
+	if m.CreateVirtualDeviceEventsFunc != nil {
+		return m.CreateVirtualDeviceEventsFunc(ctx, deviceID, events)
+	}
+	return nil, nil
+}
+
+// CreateVirtualDeviceWithState calls CreateVirtualDeviceWithStateFunc if set.
+func (m *MockClient) CreateVirtualDeviceWithState(ctx context.Context, req *smartthings.VirtualDeviceCreateRequest, initialStates []smartthings.VirtualDeviceEvent) (*smartthings.Device, error) {
+	if m.CreateVirtualDeviceWithStateFunc != nil {
+		return m.CreateVirtualDeviceWithStateFunc(ctx, req, initialStates)
+	}
+	return nil, nil
+}
+
+// ListSchemaApps calls ListSchemaAppsFunc if set.
+func (m *MockClient) ListSchemaApps(ctx context.Context, includeAllOrganizations bool) ([]smartthings.SchemaApp, error) {
+	if m.ListSchemaAppsFunc != nil {
+		return m.ListSchemaAppsFunc(ctx, includeAllOrganizations)
+	}
+	return nil, nil
+}
+
+// GetSchemaApp calls GetSchemaAppFunc if set.
+func (m *MockClient) GetSchemaApp(ctx context.Context, appID string) (*smartthings.SchemaApp, error) {
+	if m.GetSchemaAppFunc != nil {
+		return m.GetSchemaAppFunc(ctx, appID)
+	}
+	return nil, nil
+}
+
+// CreateSchemaApp calls CreateSchemaAppFunc if set.
+func (m *MockClient) CreateSchemaApp(ctx context.Context, req *smartthings.SchemaAppRequest, organizationID string) (*smartthings.SchemaCreateResponse, error) {
+	if m.CreateSchemaAppFunc != nil {
+		return m.CreateSchemaAppFunc(ctx, req, organizationID)
+	}
+	return nil, nil
+}
+
+// UpdateSchemaApp calls UpdateSchemaAppFunc if set.
+func (m *MockClient) UpdateSchemaApp(ctx context.Context, appID string, req *smartthings.SchemaAppRequest, organizationID string) error {
+	if m.UpdateSchemaAppFunc != nil {
+		return m.UpdateSchemaAppFunc(ctx, appID, req, organizationID)
+	}
+	return nil
+}
+
+// DeleteSchemaApp calls DeleteSchemaAppFunc if set.
+func (m *MockClient) DeleteSchemaApp(ctx context.Context, appID string) error {
+	if m.DeleteSchemaAppFunc != nil {
+		return m.DeleteSchemaAppFunc(ctx, appID)
+	}
+	return nil
+}
+
+// GetSchemaAppPage calls GetSchemaAppPageFunc if set.
+func (m *MockClient) GetSchemaAppPage(ctx context.Context, appID string, locationID string) (*smartthings.SchemaPage, error) {
+	if m.GetSchemaAppPageFunc != nil {
+		return m.GetSchemaAppPageFunc(ctx, appID, locationID)
+	}
+	return nil, nil
+}
+
+// RegenerateSchemaAppOAuth calls RegenerateSchemaAppOAuthFunc if set.
+func (m *MockClient) RegenerateSchemaAppOAuth(ctx context.Context, appID string) (*smartthings.SchemaCreateResponse, error) {
+	if m.RegenerateSchemaAppOAuthFunc != nil {
+		return m.RegenerateSchemaAppOAuthFunc(ctx, appID)
+	}
+	return nil, nil
+}
+
+// ListInstalledSchemaApps calls ListInstalledSchemaAppsFunc if set.
+func (m *MockClient) ListInstalledSchemaApps(ctx context.Context, locationID string) ([]smartthings.InstalledSchemaApp, error) {
+	if m.ListInstalledSchemaAppsFunc != nil {
+		return m.ListInstalledSchemaAppsFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// GetInstalledSchemaApp calls GetInstalledSchemaAppFunc if set.
+func (m *MockClient) GetInstalledSchemaApp(ctx context.Context, isaID string) (*smartthings.InstalledSchemaApp, error) {
+	if m.GetInstalledSchemaAppFunc != nil {
+		return m.GetInstalledSchemaAppFunc(ctx, isaID)
+	}
+	return nil, nil
+}
+
+// DeleteInstalledSchemaApp calls DeleteInstalledSchemaAppFunc if set.
+func (m *MockClient) DeleteInstalledSchemaApp(ctx context.Context, isaID string) error {
+	if m.DeleteInstalledSchemaAppFunc != nil {
+		return m.DeleteInstalledSchemaAppFunc(ctx, isaID)
+	}
+	return nil
+}
+
+// PushSchemaDeviceState calls PushSchemaDeviceStateFunc if set.
+func (m *MockClient) PushSchemaDeviceState(ctx context.Context, callback smartthings.SchemaCallback, states []smartthings.SchemaDeviceState) error {
+	if m.PushSchemaDeviceStateFunc != nil {
+		return m.PushSchemaDeviceStateFunc(ctx, callback, states)
+	}
+	return nil
+}
+
+// SchemaApps calls SchemaAppsFunc if set.
+func (m *MockClient) SchemaApps(ctx context.Context, includeAllOrganizations bool) iter.Seq2[smartthings.SchemaApp, error] {
+	if m.SchemaAppsFunc != nil {
+		return m.SchemaAppsFunc(ctx, includeAllOrganizations)
+	}
+	return func(yield func(smartthings.SchemaApp, error) bool) {}
+}
+
+// InstalledSchemaApps calls InstalledSchemaAppsFunc if set.
+func (m *MockClient) InstalledSchemaApps(ctx context.Context, locationID string) iter.Seq2[smartthings.InstalledSchemaApp, error] {
+	if m.InstalledSchemaAppsFunc != nil {
+		return m.InstalledSchemaAppsFunc(ctx, locationID)
+	}
+	return func(yield func(smartthings.InstalledSchemaApp, error) bool) {}
+}
+
+// CreateSchemaAppInvitation calls CreateSchemaAppInvitationFunc if set.
+func (m *MockClient) CreateSchemaAppInvitation(ctx context.Context, invitation *smartthings.SchemaAppInvitationCreate) (*smartthings.SchemaAppInvitationID, error) {
+	if m.CreateSchemaAppInvitationFunc != nil {
+		return m.CreateSchemaAppInvitationFunc(ctx, invitation)
+	}
+	return nil, nil
+}
+
+// ListSchemaAppInvitations calls ListSchemaAppInvitationsFunc if set.
+func (m *MockClient) ListSchemaAppInvitations(ctx context.Context, schemaAppID string) ([]smartthings.SchemaAppInvitation, error) {
+	if m.ListSchemaAppInvitationsFunc != nil {
+		return m.ListSchemaAppInvitationsFunc(ctx, schemaAppID)
+	}
+	return nil, nil
+}
+
+// RevokeSchemaAppInvitation calls RevokeSchemaAppInvitationFunc if set.
+func (m *MockClient) RevokeSchemaAppInvitation(ctx context.Context, invitationID string) error {
+	if m.RevokeSchemaAppInvitationFunc != nil {
+		return m.RevokeSchemaAppInvitationFunc(ctx, invitationID)
+	}
+	return nil
+}
+
+// SchemaAppInvitations calls SchemaAppInvitationsFunc if set.
+func (m *MockClient) SchemaAppInvitations(ctx context.Context, schemaAppID string) iter.Seq2[smartthings.SchemaAppInvitation, error] {
+	if m.SchemaAppInvitationsFunc != nil {
+		return m.SchemaAppInvitationsFunc(ctx, schemaAppID)
+	}
+	return func(yield func(smartthings.SchemaAppInvitation, error) bool) {}
+}
+
+// ListOrganizations calls ListOrganizationsFunc if set.
+func (m *MockClient) ListOrganizations(ctx context.Context) ([]smartthings.Organization, error) {
+	if m.ListOrganizationsFunc != nil {
+		return m.ListOrganizationsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// GetOrganization calls GetOrganizationFunc if set.
+func (m *MockClient) GetOrganization(ctx context.Context, organizationID string) (*smartthings.Organization, error) {
+	if m.GetOrganizationFunc != nil {
+		return m.GetOrganizationFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+// Organizations calls OrganizationsFunc if set.
+func (m *MockClient) Organizations(ctx context.Context) iter.Seq2[smartthings.Organization, error] {
+	if m.OrganizationsFunc != nil {
+		return m.OrganizationsFunc(ctx)
+	}
+	return func(yield func(smartthings.Organization, error) bool) {}
+}
+
+// CreateNotification calls CreateNotificationFunc if set.
+func (m *MockClient) CreateNotification(ctx context.Context, req *smartthings.NotificationRequest) (*smartthings.NotificationResponse, error) {
+	if m.CreateNotificationFunc != nil {
+		return m.CreateNotificationFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+// SendNotification calls SendNotificationFunc if set.
+func (m *MockClient) SendNotification(ctx context.Context, locationID string, title string, message string) (*smartthings.NotificationResponse, error) {
+	if m.SendNotificationFunc != nil {
+		return m.SendNotificationFunc(ctx, locationID, title, message)
+	}
+	return nil, nil
+}
+
+// GetLocationServiceInfo calls GetLocationServiceInfoFunc if set.
+func (m *MockClient) GetLocationServiceInfo(ctx context.Context, locationID string) (*smartthings.ServiceLocationInfo, error) {
+	if m.GetLocationServiceInfoFunc != nil {
+		return m.GetLocationServiceInfoFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// GetServiceCapabilitiesList calls GetServiceCapabilitiesListFunc if set.
+func (m *MockClient) GetServiceCapabilitiesList(ctx context.Context, locationID string) ([]smartthings.ServiceCapability, error) {
+	if m.GetServiceCapabilitiesListFunc != nil {
+		return m.GetServiceCapabilitiesListFunc(ctx, locationID)
+	}
+	return nil, nil
+}
+
+// GetServiceCapability calls GetServiceCapabilityFunc if set.
+func (m *MockClient) GetServiceCapability(ctx context.Context, capability smartthings.ServiceCapability, locationID string) (*smartthings.ServiceCapabilityData, error) {
+	if m.GetServiceCapabilityFunc != nil {
+		return m.GetServiceCapabilityFunc(ctx, capability, locationID)
+	}
+	return nil, nil
+}
+
+// GetServiceCapabilitiesData calls GetServiceCapabilitiesDataFunc if set.
+func (m *MockClient) GetServiceCapabilitiesData(ctx context.Context, capabilities []smartthings.ServiceCapability, locationID string) (*smartthings.ServiceCapabilityData, error) {
+	if m.GetServiceCapabilitiesDataFunc != nil {
+		return m.GetServiceCapabilitiesDataFunc(ctx, capabilities, locationID)
+	}
+	return nil, nil
+}
+
+// WatchServiceCapability calls WatchServiceCapabilityFunc if set.
+func (m *MockClient) WatchServiceCapability(ctx context.Context, capability smartthings.ServiceCapability, locationID string, interval time.Duration) iter.Seq2[*smartthings.ServiceCapabilityData, error] {
+	if m.WatchServiceCapabilityFunc != nil {
+		return m.WatchServiceCapabilityFunc(ctx, capability, locationID, interval)
+	}
+	return func(yield func(*smartthings.ServiceCapabilityData, error) bool) {}
+}
+
+// CreateServiceSubscription calls CreateServiceSubscriptionFunc if set.
+func (m *MockClient) CreateServiceSubscription(ctx context.Context, req *smartthings.ServiceSubscriptionRequest, installedAppID string, locationID string) (*smartthings.ServiceNewSubscription, error) {
+	if m.CreateServiceSubscriptionFunc != nil {
+		return m.CreateServiceSubscriptionFunc(ctx, req, installedAppID, locationID)
+	}
+	return nil, nil
+}
+
+// UpdateServiceSubscription calls UpdateServiceSubscriptionFunc if set.
+func (m *MockClient) UpdateServiceSubscription(ctx context.Context, subscriptionID string, req *smartthings.ServiceSubscriptionRequest, installedAppID string, locationID string) (*smartthings.ServiceNewSubscription, error) {
+	if m.UpdateServiceSubscriptionFunc != nil {
+		return m.UpdateServiceSubscriptionFunc(ctx, subscriptionID, req, installedAppID, locationID)
+	}
+	return nil, nil
+}
+
+// DeleteServiceSubscription calls DeleteServiceSubscriptionFunc if set.
+func (m *MockClient) DeleteServiceSubscription(ctx context.Context, subscriptionID string, installedAppID string, locationID string) error {
+	if m.DeleteServiceSubscriptionFunc != nil {
+		return m.DeleteServiceSubscriptionFunc(ctx, subscriptionID, installedAppID, locationID)
+	}
+	return nil
+}
+
+// DeleteAllServiceSubscriptions calls DeleteAllServiceSubscriptionsFunc if set.
+func (m *MockClient) DeleteAllServiceSubscriptions(ctx context.Context, installedAppID string, locationID string) error {
+	if m.DeleteAllServiceSubscriptionsFunc != nil {
+		return m.DeleteAllServiceSubscriptionsFunc(ctx, installedAppID, locationID)
+	}
+	return nil
+}
+
+// FetchTVStatus calls FetchTVStatusFunc if set.
+func (m *MockClient) FetchTVStatus(ctx context.Context, deviceID string) (*smartthings.TVStatus, error) {
+	if m.FetchTVStatusFunc != nil {
+		return m.FetchTVStatusFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// FetchTVInputs calls FetchTVInputsFunc if set.
+func (m *MockClient) FetchTVInputs(ctx context.Context, deviceID string) ([]smartthings.TVInput, error) {
+	if m.FetchTVInputsFunc != nil {
+		return m.FetchTVInputsFunc(ctx, deviceID)
+	}
+	return nil, nil
+}
+
+// SetTVPower calls SetTVPowerFunc if set.
+func (m *MockClient) SetTVPower(ctx context.Context, deviceID string, on bool) error {
+	if m.SetTVPowerFunc != nil {
+		return m.SetTVPowerFunc(ctx, deviceID, on)
+	}
+	return nil
+}
+
+// SetTVVolume calls SetTVVolumeFunc if set.
+func (m *MockClient) SetTVVolume(ctx context.Context, deviceID string, volume int) error {
+	if m.SetTVVolumeFunc != nil {
+		return m.SetTVVolumeFunc(ctx, deviceID, volume)
+	}
+	return nil
+}
+
+// SetTVMute calls SetTVMuteFunc if set.
+func (m *MockClient) SetTVMute(ctx context.Context, deviceID string, muted bool) error {
+	if m.SetTVMuteFunc != nil {
+		return m.SetTVMuteFunc(ctx, deviceID, muted)
+	}
+	return nil
+}
+
+// SetTVInput calls SetTVInputFunc if set.
+func (m *MockClient) SetTVInput(ctx context.Context, deviceID string, inputID string) error {
+	if m.SetTVInputFunc != nil {
+		return m.SetTVInputFunc(ctx, deviceID, inputID)
+	}
+	return nil
+}
+
+// SetTVChannel calls SetTVChannelFunc if set.
+func (m *MockClient) SetTVChannel(ctx context.Context, deviceID string, channel int) error {
+	if m.SetTVChannelFunc != nil {
+		return m.SetTVChannelFunc(ctx, deviceID, channel)
+	}
+	return nil
+}
+
+// SendTVKey calls SendTVKeyFunc if set.
+func (m *MockClient) SendTVKey(ctx context.Context, deviceID string, key string) error {
+	if m.SendTVKeyFunc != nil {
+		return m.SendTVKeyFunc(ctx, deviceID, key)
+	}
+	return nil
+}
+
+// SendTVKeys calls SendTVKeysFunc if set.
+func (m *MockClient) SendTVKeys(ctx context.Context, deviceID string, keys []string, delay time.Duration) error {
+	if m.SendTVKeysFunc != nil {
+		return m.SendTVKeysFunc(ctx, deviceID, keys, delay)
+	}
+	return nil
+}
+
+// LaunchTVApp calls LaunchTVAppFunc if set.
+func (m *MockClient) LaunchTVApp(ctx context.Context, deviceID string, appID string) error {
+	if m.LaunchTVAppFunc != nil {
+		return m.LaunchTVAppFunc(ctx, deviceID, appID)
+	}
+	return nil
+}
+
+// LaunchTVAppByName calls LaunchTVAppByNameFunc if set.
+func (m *MockClient) LaunchTVAppByName(ctx context.Context, deviceID string, name string) error {
+	if m.LaunchTVAppByNameFunc != nil {
+		return m.LaunchTVAppByNameFunc(ctx, deviceID, name)
+	}
+	return nil
+}
+
+// TVPlay calls TVPlayFunc if set.
+func (m *MockClient) TVPlay(ctx context.Context, deviceID string) error {
+	if m.TVPlayFunc != nil {
+		return m.TVPlayFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVPause calls TVPauseFunc if set.
+func (m *MockClient) TVPause(ctx context.Context, deviceID string) error {
+	if m.TVPauseFunc != nil {
+		return m.TVPauseFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVStop calls TVStopFunc if set.
+func (m *MockClient) TVStop(ctx context.Context, deviceID string) error {
+	if m.TVStopFunc != nil {
+		return m.TVStopFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVChannelUp calls TVChannelUpFunc if set.
+func (m *MockClient) TVChannelUp(ctx context.Context, deviceID string) error {
+	if m.TVChannelUpFunc != nil {
+		return m.TVChannelUpFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVChannelDown calls TVChannelDownFunc if set.
+func (m *MockClient) TVChannelDown(ctx context.Context, deviceID string) error {
+	if m.TVChannelDownFunc != nil {
+		return m.TVChannelDownFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVVolumeUp calls TVVolumeUpFunc if set.
+func (m *MockClient) TVVolumeUp(ctx context.Context, deviceID string) error {
+	if m.TVVolumeUpFunc != nil {
+		return m.TVVolumeUpFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// TVVolumeDown calls TVVolumeDownFunc if set.
+func (m *MockClient) TVVolumeDown(ctx context.Context, deviceID string) error {
+	if m.TVVolumeDownFunc != nil {
+		return m.TVVolumeDownFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// SetTVArtMode calls SetTVArtModeFunc if set.
+func (m *MockClient) SetTVArtMode(ctx context.Context, deviceID string, on bool) error {
+	if m.SetTVArtModeFunc != nil {
+		return m.SetTVArtModeFunc(ctx, deviceID, on)
+	}
+	return nil
+}
+
+// SetPictureMode calls SetPictureModeFunc if set.
+func (m *MockClient) SetPictureMode(ctx context.Context, deviceID string, mode string) error {
+	if m.SetPictureModeFunc != nil {
+		return m.SetPictureModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// SetSoundMode calls SetSoundModeFunc if set.
+func (m *MockClient) SetSoundMode(ctx context.Context, deviceID string, mode string) error {
+	if m.SetSoundModeFunc != nil {
+		return m.SetSoundModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// SetColor calls SetColorFunc if set.
+func (m *MockClient) SetColor(ctx context.Context, deviceID string, hue float64, saturation float64) error {
+	if m.SetColorFunc != nil {
+		return m.SetColorFunc(ctx, deviceID, hue, saturation)
+	}
+	return nil
+}
+
+// SetColorTemperature calls SetColorTemperatureFunc if set.
+func (m *MockClient) SetColorTemperature(ctx context.Context, deviceID string, kelvin int) error {
+	if m.SetColorTemperatureFunc != nil {
+		return m.SetColorTemperatureFunc(ctx, deviceID, kelvin)
+	}
+	return nil
+}
+
+// SetLevel calls SetLevelFunc if set.
+func (m *MockClient) SetLevel(ctx context.Context, deviceID string, level int) error {
+	if m.SetLevelFunc != nil {
+		return m.SetLevelFunc(ctx, deviceID, level)
+	}
+	return nil
+}
+
+// SetLevelWithRate calls SetLevelWithRateFunc if set.
+func (m *MockClient) SetLevelWithRate(ctx context.Context, deviceID string, level int, rate int) error {
+	if m.SetLevelWithRateFunc != nil {
+		return m.SetLevelWithRateFunc(ctx, deviceID, level, rate)
+	}
+	return nil
+}
+
+// SetThermostatSetpoint calls SetThermostatSetpointFunc if set.
+func (m *MockClient) SetThermostatSetpoint(ctx context.Context, deviceID string, mode smartthings.ThermostatMode, temp float64) error {
+	if m.SetThermostatSetpointFunc != nil {
+		return m.SetThermostatSetpointFunc(ctx, deviceID, mode, temp)
+	}
+	return nil
+}
+
+// SetThermostatMode calls SetThermostatModeFunc if set.
+func (m *MockClient) SetThermostatMode(ctx context.Context, deviceID string, mode smartthings.ThermostatMode) error {
+	if m.SetThermostatModeFunc != nil {
+		return m.SetThermostatModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// LockDevice calls LockDeviceFunc if set.
+func (m *MockClient) LockDevice(ctx context.Context, deviceID string) error {
+	if m.LockDeviceFunc != nil {
+		return m.LockDeviceFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// UnlockDevice calls UnlockDeviceFunc if set.
+func (m *MockClient) UnlockDevice(ctx context.Context, deviceID string) error {
+	if m.UnlockDeviceFunc != nil {
+		return m.UnlockDeviceFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// UnlockWithTimeout calls UnlockWithTimeoutFunc if set.
+func (m *MockClient) UnlockWithTimeout(ctx context.Context, deviceID string, seconds int) error {
+	if m.UnlockWithTimeoutFunc != nil {
+		return m.UnlockWithTimeoutFunc(ctx, deviceID, seconds)
+	}
+	return nil
+}
+
+// StartCleaning calls StartCleaningFunc if set.
+func (m *MockClient) StartCleaning(ctx context.Context, deviceID string) error {
+	if m.StartCleaningFunc != nil {
+		return m.StartCleaningFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// PauseCleaning calls PauseCleaningFunc if set.
+func (m *MockClient) PauseCleaning(ctx context.Context, deviceID string) error {
+	if m.PauseCleaningFunc != nil {
+		return m.PauseCleaningFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// ReturnToDock calls ReturnToDockFunc if set.
+func (m *MockClient) ReturnToDock(ctx context.Context, deviceID string) error {
+	if m.ReturnToDockFunc != nil {
+		return m.ReturnToDockFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// SetCleaningMode calls SetCleaningModeFunc if set.
+func (m *MockClient) SetCleaningMode(ctx context.Context, deviceID string, mode string) error {
+	if m.SetCleaningModeFunc != nil {
+		return m.SetCleaningModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// SetACMode calls SetACModeFunc if set.
+func (m *MockClient) SetACMode(ctx context.Context, deviceID string, mode string) error {
+	if m.SetACModeFunc != nil {
+		return m.SetACModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// SetACSetpoint calls SetACSetpointFunc if set.
+func (m *MockClient) SetACSetpoint(ctx context.Context, deviceID string, temp float64) error {
+	if m.SetACSetpointFunc != nil {
+		return m.SetACSetpointFunc(ctx, deviceID, temp)
+	}
+	return nil
+}
+
+// SetFanMode calls SetFanModeFunc if set.
+func (m *MockClient) SetFanMode(ctx context.Context, deviceID string, mode string) error {
+	if m.SetFanModeFunc != nil {
+		return m.SetFanModeFunc(ctx, deviceID, mode)
+	}
+	return nil
+}
+
+// OpenShade calls OpenShadeFunc if set.
+func (m *MockClient) OpenShade(ctx context.Context, deviceID string) error {
+	if m.OpenShadeFunc != nil {
+		return m.OpenShadeFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// CloseShade calls CloseShadeFunc if set.
+func (m *MockClient) CloseShade(ctx context.Context, deviceID string) error {
+	if m.CloseShadeFunc != nil {
+		return m.CloseShadeFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// PauseShade calls PauseShadeFunc if set.
+func (m *MockClient) PauseShade(ctx context.Context, deviceID string) error {
+	if m.PauseShadeFunc != nil {
+		return m.PauseShadeFunc(ctx, deviceID)
+	}
+	return nil
+}
+
+// SetShadeLevel calls SetShadeLevelFunc if set.
+func (m *MockClient) SetShadeLevel(ctx context.Context, deviceID string, level int) error {
+	if m.SetShadeLevelFunc != nil {
+		return m.SetShadeLevelFunc(ctx, deviceID, level)
+	}
+	return nil
+}
+
+// RateLimitInfo calls RateLimitInfoFunc if set.
+func (m *MockClient) RateLimitInfo() *smartthings.RateLimitInfo {
+	if m.RateLimitInfoFunc != nil {
+		return m.RateLimitInfoFunc()
+	}
+	return nil
+}
+
+// RateLimitResetTime calls RateLimitResetTimeFunc if set.
+func (m *MockClient) RateLimitResetTime() time.Time {
+	if m.RateLimitResetTimeFunc != nil {
+		return m.RateLimitResetTimeFunc()
+	}
+	return time.Time{}
+}
+
+// RemainingRequests calls RemainingRequestsFunc if set.
+func (m *MockClient) RemainingRequests() int {
+	if m.RemainingRequestsFunc != nil {
+		return m.RemainingRequestsFunc()
+	}
+	return 0
+}
+
+// ShouldThrottle calls ShouldThrottleFunc if set.
+func (m *MockClient) ShouldThrottle(threshold int) bool {
+	if m.ShouldThrottleFunc != nil {
+		return m.ShouldThrottleFunc(threshold)
+	}
+	return false
+}
+
+// WaitForRateLimit calls WaitForRateLimitFunc if set.
+func (m *MockClient) WaitForRateLimit(ctx context.Context) error {
+	if m.WaitForRateLimitFunc != nil {
+		return m.WaitForRateLimitFunc(ctx)
+	}
+	return nil
+}
+
+// WaitForRateLimitWithCap calls WaitForRateLimitWithCapFunc if set.
+func (m *MockClient) WaitForRateLimitWithCap(ctx context.Context, maxWait time.Duration) error {
+	if m.WaitForRateLimitWithCapFunc != nil {
+		return m.WaitForRateLimitWithCapFunc(ctx, maxWait)
+	}
+	return nil
+}
+
+// WaitForRateLimitErr calls WaitForRateLimitErrFunc if set.
+func (m *MockClient) WaitForRateLimitErr(ctx context.Context, err error) error {
+	if m.WaitForRateLimitErrFunc != nil {
+		return m.WaitForRateLimitErrFunc(ctx, err)
+	}
+	return nil
+}
+
+// InvalidateCache calls InvalidateCacheFunc if set.
+func (m *MockClient) InvalidateCache(resourceType string, ids ...string) {
+	if m.InvalidateCacheFunc != nil {
+		m.InvalidateCacheFunc(resourceType, ids...)
+	}
+}
+
+// InvalidateCapabilityCache calls InvalidateCapabilityCacheFunc if set.
+func (m *MockClient) InvalidateCapabilityCache() {
+	if m.InvalidateCapabilityCacheFunc != nil {
+		m.InvalidateCapabilityCacheFunc()
+	}
+}
+
+// InvalidateDeviceStatus calls InvalidateDeviceStatusFunc if set.
+func (m *MockClient) InvalidateDeviceStatus(deviceID string) {
+	if m.InvalidateDeviceStatusFunc != nil {
+		m.InvalidateDeviceStatusFunc(deviceID)
+	}
+}
+
+// Token calls TokenFunc if set.
+func (m *MockClient) Token() string {
+	if m.TokenFunc != nil {
+		return m.TokenFunc()
+	}
+	return ""
+}
+
+// SetToken calls SetTokenFunc if set.
+func (m *MockClient) SetToken(token string) {
+	if m.SetTokenFunc != nil {
+		m.SetTokenFunc(token)
+	}
+}
+
+// UserAgent calls UserAgentFunc if set.
+func (m *MockClient) UserAgent() string {
+	if m.UserAgentFunc != nil {
+		return m.UserAgentFunc()
+	}
+	return ""
+}
+
+// DryRun calls DryRunFunc if set.
+func (m *MockClient) DryRun() bool {
+	if m.DryRunFunc != nil {
+		return m.DryRunFunc()
+	}
+	return false
+}
+
+// CircuitState calls CircuitStateFunc if set.
+func (m *MockClient) CircuitState() smartthings.CircuitState {
+	if m.CircuitStateFunc != nil {
+		return m.CircuitStateFunc()
+	}
+	return ""
+}
+
+// LogRequest calls LogRequestFunc if set.
+func (m *MockClient) LogRequest(ctx context.Context, method string, path string) {
+	if m.LogRequestFunc != nil {
+		m.LogRequestFunc(ctx, method, path)
+	}
+}
+
+// LogResponse calls LogResponseFunc if set.
+func (m *MockClient) LogResponse(ctx context.Context, method string, path string, statusCode int, duration time.Duration, err error) {
+	if m.LogResponseFunc != nil {
+		m.LogResponseFunc(ctx, method, path, statusCode, duration, err)
+	}
+}
+
+// LogDeviceCommand calls LogDeviceCommandFunc if set.
+func (m *MockClient) LogDeviceCommand(ctx context.Context, deviceID string, capability string, command string, err error) {
+	if m.LogDeviceCommandFunc != nil {
+		m.LogDeviceCommandFunc(ctx, deviceID, capability, command, err)
+	}
+}
+
+// LogRateLimit calls LogRateLimitFunc if set.
+func (m *MockClient) LogRateLimit(ctx context.Context, info smartthings.RateLimitInfo) {
+	if m.LogRateLimitFunc != nil {
+		m.LogRateLimitFunc(ctx, info)
+	}
+}
@@ -0,0 +1,67 @@
+package smartthingstest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+func TestMockClient_ZeroValues(t *testing.T) {
+	var mock MockClient
+	ctx := context.Background()
+
+	devices, err := mock.ListDevices(ctx)
+	if devices != nil || err != nil {
+		t.Errorf("ListDevices() = %v, %v; want nil, nil", devices, err)
+	}
+	if err := mock.ExecuteCommand(ctx, "device-1", smartthings.Command{}); err != nil {
+		t.Errorf("ExecuteCommand() error = %v, want nil", err)
+	}
+	if info := mock.RateLimitInfo(); info != nil {
+		t.Errorf("RateLimitInfo() = %v, want nil", info)
+	}
+	if mock.Token() != "" {
+		t.Errorf("Token() = %q, want empty", mock.Token())
+	}
+
+	// Iterators must be safe to range over when unset.
+	for d, err := range mock.Devices(ctx) {
+		t.Errorf("unexpected device %v (err %v)", d, err)
+	}
+}
+
+func TestMockClient_Funcs(t *testing.T) {
+	errBoom := errors.New("boom")
+	var gotID string
+	var gotArgs []any
+
+	mock := &MockClient{
+		GetDeviceStatusFunc: func(ctx context.Context, deviceID string) (smartthings.Status, error) {
+			gotID = deviceID
+			return smartthings.Status{"switch": "on"}, nil
+		},
+		ExecuteComponentCommandFunc: func(ctx context.Context, deviceID, component, capability, command string, args ...any) error {
+			gotArgs = args
+			return errBoom
+		},
+	}
+
+	var client smartthings.SmartThingsClient = mock
+	status, err := client.GetDeviceStatus(context.Background(), "device-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotID != "device-1" || status["switch"] != "on" {
+		t.Errorf("GetDeviceStatus() = %v for %q", status, gotID)
+	}
+
+	err = client.ExecuteComponentCommand(context.Background(), "device-1", "main", "switchLevel", "setLevel", 50, 2)
+	if err != errBoom {
+		t.Errorf("expected errBoom, got %v", err)
+	}
+	if len(gotArgs) != 2 || gotArgs[0] != 50 {
+		t.Errorf("args = %v, want [50 2]", gotArgs)
+	}
+}
@@ -0,0 +1,184 @@
+// Package smartthingstest provides test doubles for code that depends on
+// smartthings.SmartThingsClient.
+//
+// MockClient implements every interface method with an overridable func
+// field, so tests can inject behavior without running a stub HTTP server:
+//
+//	mock := smartthingstest.NewMockClient(
+//	    smartthings.Device{DeviceID: "light-1", Label: "Kitchen Light"},
+//	)
+//	mock.ExecuteCommandFunc = func(ctx context.Context, deviceID string, cmd smartthings.Command) error {
+//	    return nil
+//	}
+//	runMyCode(mock)
+package smartthingstest
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync"
+
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+// NewMockClient returns a MockClient backed by an in-memory copy of devices.
+//
+// The device operations ListDevices, ListDevicesWithOptions, ListAllDevices,
+// GetDevice, UpdateDevice, DeleteDevice, Devices and DevicesWithOptions are
+// wired to the in-memory list. GetDevice, UpdateDevice and DeleteDevice return
+// smartthings.ErrNotFound for unknown IDs. All other methods return zero values
+// until their func field is set. Any func field may be replaced after creation.
+func NewMockClient(devices ...smartthings.Device) *MockClient {
+	store := &deviceStore{devices: slices.Clone(devices)}
+
+	return &MockClient{
+		ListDevicesFunc: func(ctx context.Context) ([]smartthings.Device, error) {
+			return store.list(nil), nil
+		},
+		ListAllDevicesFunc: func(ctx context.Context) ([]smartthings.Device, error) {
+			return store.list(nil), nil
+		},
+		ListDevicesWithOptionsFunc: func(ctx context.Context, opts *smartthings.ListDevicesOptions) (*smartthings.PagedDevices, error) {
+			return &smartthings.PagedDevices{Items: store.list(opts)}, nil
+		},
+		GetDeviceFunc: func(ctx context.Context, deviceID string) (*smartthings.Device, error) {
+			if deviceID == "" {
+				return nil, smartthings.ErrEmptyDeviceID
+			}
+			return store.get(deviceID)
+		},
+		UpdateDeviceFunc: func(ctx context.Context, deviceID string, update *smartthings.DeviceUpdate) (*smartthings.Device, error) {
+			if deviceID == "" {
+				return nil, smartthings.ErrEmptyDeviceID
+			}
+			return store.update(deviceID, update)
+		},
+		DeleteDeviceFunc: func(ctx context.Context, deviceID string) error {
+			if deviceID == "" {
+				return smartthings.ErrEmptyDeviceID
+			}
+			return store.delete(deviceID)
+		},
+		DevicesFunc: func(ctx context.Context) iter.Seq2[smartthings.Device, error] {
+			return store.iterate(nil)
+		},
+		DevicesWithOptionsFunc: func(ctx context.Context, opts *smartthings.ListDevicesOptions) iter.Seq2[smartthings.Device, error] {
+			return store.iterate(opts)
+		},
+	}
+}
+
+// deviceStore is the in-memory device list behind NewMockClient.
+type deviceStore struct {
+	mu      sync.Mutex
+	devices []smartthings.Device
+}
+
+// list returns a copy of the devices matching opts.
+func (s *deviceStore) list(opts *smartthings.ListDevicesOptions) []smartthings.Device {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]smartthings.Device, 0, len(s.devices))
+	for _, d := range s.devices {
+		if matchesOptions(d, opts) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// iterate yields a snapshot of the devices matching opts.
+func (s *deviceStore) iterate(opts *smartthings.ListDevicesOptions) iter.Seq2[smartthings.Device, error] {
+	return func(yield func(smartthings.Device, error) bool) {
+		for _, d := range s.list(opts) {
+			if !yield(d, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *deviceStore) get(deviceID string) (*smartthings.Device, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.index(deviceID)
+	if i < 0 {
+		return nil, smartthings.ErrNotFound
+	}
+	d := s.devices[i]
+	return &d, nil
+}
+
+func (s *deviceStore) update(deviceID string, update *smartthings.DeviceUpdate) (*smartthings.Device, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.index(deviceID)
+	if i < 0 {
+		return nil, smartthings.ErrNotFound
+	}
+	if update != nil && update.Label != "" {
+		s.devices[i].Label = update.Label
+	}
+	if update != nil && update.RoomID != "" {
+		s.devices[i].RoomID = update.RoomID
+	}
+	d := s.devices[i]
+	return &d, nil
+}
+
+func (s *deviceStore) delete(deviceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.index(deviceID)
+	if i < 0 {
+		return smartthings.ErrNotFound
+	}
+	s.devices = slices.Delete(s.devices, i, i+1)
+	return nil
+}
+
+// index returns the position of deviceID, or -1. The caller must hold s.mu.
+func (s *deviceStore) index(deviceID string) int {
+	return slices.IndexFunc(s.devices, func(d smartthings.Device) bool {
+		return d.DeviceID == deviceID
+	})
+}
+
+// matchesOptions reports whether a device passes the DeviceID, LocationID,
+// Capability and Type filters in opts. Pagination options are ignored.
+func matchesOptions(d smartthings.Device, opts *smartthings.ListDevicesOptions) bool {
+	if opts == nil {
+		return true
+	}
+	if len(opts.DeviceID) > 0 && !slices.Contains(opts.DeviceID, d.DeviceID) {
+		return false
+	}
+	if len(opts.LocationID) > 0 && !slices.Contains(opts.LocationID, d.LocationID) {
+		return false
+	}
+	if opts.Type != "" && string(d.Type) != opts.Type {
+		return false
+	}
+	for _, capability := range opts.Capability {
+		if !hasCapability(d, capability) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasCapability(d smartthings.Device, capability string) bool {
+	for _, comp := range d.Components {
+		for _, c := range comp.Capabilities {
+			if c.ID == capability {
+				return true
+			}
+		}
+	}
+	return false
+}
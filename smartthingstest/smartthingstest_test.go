@@ -0,0 +1,148 @@
+package smartthingstest
+
+import (
+	"context"
+	"testing"
+
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+func testDevices() []smartthings.Device {
+	return []smartthings.Device{
+		{
+			DeviceID:   "light-1",
+			Label:      "Kitchen Light",
+			LocationID: "loc-1",
+			Components: []smartthings.Component{{ID: "main", Capabilities: []smartthings.CapabilityRef{{ID: "switch"}}}},
+		},
+		{
+			DeviceID:   "sensor-1",
+			Label:      "Door Sensor",
+			LocationID: "loc-2",
+			Components: []smartthings.Component{{ID: "main", Capabilities: []smartthings.CapabilityRef{{ID: "contactSensor"}}}},
+		},
+	}
+}
+
+func TestNewMockClient_Devices(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list", func(t *testing.T) {
+		mock := NewMockClient(testDevices()...)
+		devices, err := mock.ListDevices(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(devices) != 2 {
+			t.Errorf("got %d devices, want 2", len(devices))
+		}
+
+		var count int
+		for _, err := range mock.Devices(ctx) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+		}
+		if count != 2 {
+			t.Errorf("iterated %d devices, want 2", count)
+		}
+	})
+
+	t.Run("list with options", func(t *testing.T) {
+		mock := NewMockClient(testDevices()...)
+		tests := []struct {
+			name string
+			opts *smartthings.ListDevicesOptions
+			want []string
+		}{
+			{"nil options", nil, []string{"light-1", "sensor-1"}},
+			{"by capability", &smartthings.ListDevicesOptions{Capability: []string{"switch"}}, []string{"light-1"}},
+			{"by location", &smartthings.ListDevicesOptions{LocationID: []string{"loc-2"}}, []string{"sensor-1"}},
+			{"by device ID", &smartthings.ListDevicesOptions{DeviceID: []string{"missing"}}, nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp, err := mock.ListDevicesWithOptions(ctx, tt.opts)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(resp.Items) != len(tt.want) {
+					t.Fatalf("got %d devices, want %d", len(resp.Items), len(tt.want))
+				}
+				for i, d := range resp.Items {
+					if d.DeviceID != tt.want[i] {
+						t.Errorf("Items[%d] = %q, want %q", i, d.DeviceID, tt.want[i])
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		mock := NewMockClient(testDevices()...)
+		d, err := mock.GetDevice(ctx, "light-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d.Label != "Kitchen Light" {
+			t.Errorf("Label = %q, want %q", d.Label, "Kitchen Light")
+		}
+		if _, err := mock.GetDevice(ctx, "missing"); !smartthings.IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		if _, err := mock.GetDevice(ctx, ""); err != smartthings.ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		mock := NewMockClient(testDevices()...)
+		d, err := mock.UpdateDevice(ctx, "light-1", &smartthings.DeviceUpdate{Label: "Pantry Light"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d.Label != "Pantry Light" {
+			t.Errorf("Label = %q, want %q", d.Label, "Pantry Light")
+		}
+		if got, _ := mock.GetDevice(ctx, "light-1"); got.Label != "Pantry Light" {
+			t.Errorf("stored Label = %q, want %q", got.Label, "Pantry Light")
+		}
+
+		if _, err := mock.UpdateDevice(ctx, "light-1", &smartthings.DeviceUpdate{RoomID: "room-2"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, _ := mock.GetDevice(ctx, "light-1"); got.RoomID != "room-2" || got.Label != "Pantry Light" {
+			t.Errorf("stored device = %q in %q, want %q in %q", got.Label, got.RoomID, "Pantry Light", "room-2")
+		}
+
+		if err := mock.DeleteDevice(ctx, "sensor-1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := mock.DeleteDevice(ctx, "sensor-1"); !smartthings.IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+		if devices, _ := mock.ListDevices(ctx); len(devices) != 1 {
+			t.Errorf("got %d devices after delete, want 1", len(devices))
+		}
+	})
+
+	t.Run("does not alias input", func(t *testing.T) {
+		devices := testDevices()
+		mock := NewMockClient(devices...)
+		devices[0].Label = "changed"
+		if d, _ := mock.GetDevice(ctx, "light-1"); d.Label != "Kitchen Light" {
+			t.Errorf("Label = %q, want %q", d.Label, "Kitchen Light")
+		}
+	})
+
+	t.Run("override func", func(t *testing.T) {
+		mock := NewMockClient(testDevices()...)
+		mock.ListDevicesFunc = func(ctx context.Context) ([]smartthings.Device, error) {
+			return nil, smartthings.ErrUnauthorized
+		}
+		if _, err := mock.ListDevices(ctx); err != smartthings.ErrUnauthorized {
+			t.Errorf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package smartthings
+
+import (
+	"slices"
+	"sort"
+)
+
+// Components returns the component IDs of a multi-component status, as
+// returned by GetDeviceStatusAllComponents, with "main" first and the rest
+// in name order.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatusAllComponents(ctx, deviceID)
+//	for _, id := range status.Components() {
+//	    fmt.Println(id, status.Capabilities(id))
+//	}
+func (s Status) Components() []string {
+	ids := statusMapKeys(s)
+	if i := slices.Index(ids, "main"); i > 0 {
+		ids = slices.Insert(slices.Delete(ids, i, i+1), 0, "main")
+	}
+	return ids
+}
+
+// Component returns the status of one component of a multi-component
+// status, in the same form GetComponentStatus returns. Returns nil if the
+// component is not present.
+func (s Status) Component(id string) Status {
+	switch v := s[id].(type) {
+	case Status:
+		return v
+	case map[string]any:
+		return Status(v)
+	}
+	return nil
+}
+
+// Capabilities returns the capability IDs reported for a component of a
+// multi-component status, in name order.
+func (s Status) Capabilities(component string) []string {
+	return statusMapKeys(s.Component(component))
+}
+
+// statusMapKeys returns the sorted keys of m whose values are objects.
+func statusMapKeys(m Status) []string {
+	var keys []string
+	for key, v := range m {
+		switch v.(type) {
+		case Status, map[string]any:
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
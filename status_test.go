@@ -0,0 +1,68 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestStatus_Components(t *testing.T) {
+	var status Status
+	err := json.Unmarshal([]byte(`{
+		"freezer": {"temperatureMeasurement": {"temperature": {"value": -18}}},
+		"main": {"switch": {"switch": {"value": "on"}}, "contactSensor": {"contact": {"value": "closed"}}},
+		"cooler": {"temperatureMeasurement": {"temperature": {"value": 3}}},
+		"note": "not a component"
+	}`), &status)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := status.Components(), []string{"main", "cooler", "freezer"}; !slices.Equal(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+	if got, want := status.Capabilities("main"), []string{"contactSensor", "switch"}; !slices.Equal(got, want) {
+		t.Errorf("Capabilities(main) = %v, want %v", got, want)
+	}
+	if v, _ := GetFloat(status.Component("freezer"), "temperatureMeasurement", "temperature", "value"); v != -18 {
+		t.Errorf("freezer temperature = %v, want -18", v)
+	}
+
+	if status.Component("missing") != nil || status.Component("note") != nil {
+		t.Error("expected nil for missing and non-object components")
+	}
+	if caps := status.Capabilities("missing"); len(caps) != 0 {
+		t.Errorf("Capabilities(missing) = %v", caps)
+	}
+	if ids := Status(nil).Components(); len(ids) != 0 {
+		t.Errorf("nil Components() = %v", ids)
+	}
+}
+
+func TestStatus_Components_AllComponentsStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"components": {
+			"main": {"switch": {"switch": {"value": "on"}}},
+			"hood": {"fanSpeed": {"fanSpeed": {"value": 2}}}
+		}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	status, err := client.GetDeviceStatusAllComponents(context.Background(), "dev-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := status.Components(), []string{"main", "hood"}; !slices.Equal(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+	if got := status.Capabilities("hood"); !slices.Equal(got, []string{"fanSpeed"}) {
+		t.Errorf("Capabilities(hood) = %v", got)
+	}
+	if v, _ := GetString(status.Component("main"), "switch", "switch", "value"); v != "on" {
+		t.Errorf("main switch = %q, want on", v)
+	}
+}
@@ -3,7 +3,9 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // Subscription represents a webhook subscription.
@@ -134,6 +136,63 @@ func (c *Client) CreateSubscription(ctx context.Context, installedAppID string,
 	return &created, nil
 }
 
+// SubscribeLocationDevices subscribes an installed app to the events of every
+// device in a location and returns the created subscriptions.
+//
+// The SmartThings API has no location-wide device source type, so a single
+// CAPABILITY subscription with "*" capability and attribute wildcards is
+// tried first. If the API rejects it, one DEVICE subscription per device in
+// the location is created instead; failures are joined into the returned
+// error alongside the subscriptions that were created. Per-device
+// subscriptions count against the installed app's subscription limit.
+func (c *Client) SubscribeLocationDevices(ctx context.Context, installedAppID, locationID string) ([]Subscription, error) {
+	if installedAppID == "" {
+		return nil, ErrEmptyInstalledAppID
+	}
+	if locationID == "" {
+		return nil, ErrEmptyLocationID
+	}
+
+	sub, err := c.CreateSubscription(ctx, installedAppID, &SubscriptionCreate{
+		SourceType: "CAPABILITY",
+		Capability: &CapabilitySubscription{
+			LocationID: locationID,
+			Capability: "*",
+			Attribute:  "*",
+		},
+	})
+	if err == nil {
+		return []Subscription{*sub}, nil
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusBadRequest && apiErr.StatusCode != http.StatusUnprocessableEntity) {
+		return nil, err
+	}
+
+	var subs []Subscription
+	var errs []error
+	for device, err := range c.DevicesWithOptions(ctx, &ListDevicesOptions{LocationID: []string{locationID}}) {
+		if err != nil {
+			return subs, errors.Join(append(errs, err)...)
+		}
+		sub, err := c.CreateSubscription(ctx, installedAppID, &SubscriptionCreate{
+			SourceType: "DEVICE",
+			Device: &DeviceSubscription{
+				DeviceID:    device.DeviceID,
+				ComponentID: "*",
+				Capability:  "*",
+				Attribute:   "*",
+			},
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("device %s: %w", device.DeviceID, err))
+			continue
+		}
+		subs = append(subs, *sub)
+	}
+	return subs, errors.Join(errs...)
+}
+
 // DeleteSubscription deletes a specific subscription.
 func (c *Client) DeleteSubscription(ctx context.Context, installedAppID, subscriptionID string) error {
 	if installedAppID == "" {
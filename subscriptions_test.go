@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -182,6 +183,93 @@ func TestClient_CreateSubscription(t *testing.T) {
 	})
 }
 
+func TestClient_SubscribeLocationDevices(t *testing.T) {
+	t.Run("single capability subscription", func(t *testing.T) {
+		var posts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			posts++
+			var req SubscriptionCreate
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.SourceType != "CAPABILITY" || req.Capability == nil {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+			if req.Capability.LocationID != "loc-1" || req.Capability.Capability != "*" || req.Capability.Attribute != "*" {
+				t.Errorf("capability = %+v", req.Capability)
+			}
+			json.NewEncoder(w).Encode(Subscription{ID: "sub-1", SourceType: "CAPABILITY"})
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		subs, err := client.SubscribeLocationDevices(context.Background(), "app-123", "loc-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(subs) != 1 || subs[0].ID != "sub-1" || posts != 1 {
+			t.Errorf("subs = %+v, posts = %d", subs, posts)
+		}
+	})
+
+	t.Run("falls back to per-device subscriptions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				if got := r.URL.Query().Get("locationId"); got != "loc-1" {
+					t.Errorf("locationId = %q, want loc-1", got)
+				}
+				json.NewEncoder(w).Encode(map[string]any{"items": []Device{{DeviceID: "d1"}, {DeviceID: "d2"}, {DeviceID: "d3"}}})
+				return
+			}
+			var req SubscriptionCreate
+			json.NewDecoder(r.Body).Decode(&req)
+			switch {
+			case req.SourceType == "CAPABILITY":
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"error":{"code":"ConstraintViolationError","message":"capability must not be a wildcard"}}`))
+			case req.Device.DeviceID == "d2":
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				json.NewEncoder(w).Encode(Subscription{ID: "sub-" + req.Device.DeviceID, SourceType: "DEVICE", Device: req.Device})
+			}
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		subs, err := client.SubscribeLocationDevices(context.Background(), "app-123", "loc-1")
+		if err == nil || !strings.Contains(err.Error(), "device d2") {
+			t.Errorf("expected error for d2, got %v", err)
+		}
+		if len(subs) != 2 || subs[0].ID != "sub-d1" || subs[1].ID != "sub-d3" {
+			t.Fatalf("subs = %+v", subs)
+		}
+		if d := subs[0].Device; d.ComponentID != "*" || d.Capability != "*" || d.Attribute != "*" {
+			t.Errorf("device subscription = %+v, want wildcards", d)
+		}
+	})
+
+	t.Run("other errors are returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		subs, err := client.SubscribeLocationDevices(context.Background(), "app-123", "loc-1")
+		if !IsUnauthorized(err) || subs != nil {
+			t.Errorf("subs = %v, err = %v, want unauthorized", subs, err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if _, err := client.SubscribeLocationDevices(context.Background(), "", "loc-1"); err != ErrEmptyInstalledAppID {
+			t.Errorf("expected ErrEmptyInstalledAppID, got %v", err)
+		}
+		if _, err := client.SubscribeLocationDevices(context.Background(), "app-123", ""); err != ErrEmptyLocationID {
+			t.Errorf("expected ErrEmptyLocationID, got %v", err)
+		}
+	})
+}
+
 func TestClient_DeleteSubscription(t *testing.T) {
 	t.Run("successful deletion", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
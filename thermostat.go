@@ -0,0 +1,118 @@
+package smartthings
+
+import "context"
+
+// Thermostat Helpers
+//
+// These helpers cover the standard SmartThings thermostat capabilities
+// (temperatureMeasurement, thermostatHeatingSetpoint, thermostatCoolingSetpoint,
+// thermostatMode, thermostatOperatingState).
+
+// ExtractThermostatStatus extracts thermostat state from a device status.
+// Unit is taken from the temperature reading, falling back to the setpoints.
+// Setpoints reported in a different unit are converted to Unit.
+// Returns nil if the status has no thermostat capabilities.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if t := smartthings.ExtractThermostatStatus(status); t != nil && t.Temperature != nil {
+//	    fmt.Printf("%.1f°%s (%s)\n", *t.Temperature, t.Unit, t.OperatingState)
+//	}
+func ExtractThermostatStatus(status Status) *ThermostatStatus {
+	_, hasMode := GetMap(status, "thermostatMode")
+	_, hasHeat := GetMap(status, "thermostatHeatingSetpoint")
+	_, hasCool := GetMap(status, "thermostatCoolingSetpoint")
+	_, hasState := GetMap(status, "thermostatOperatingState")
+	if !hasMode && !hasHeat && !hasCool && !hasState {
+		return nil
+	}
+
+	result := &ThermostatStatus{}
+
+	// Path: temperatureMeasurement.temperature.{value,unit}
+	temp, tempUnit := temperatureReading(status, "temperatureMeasurement", "temperature")
+	// Path: thermostatHeatingSetpoint.heatingSetpoint.{value,unit}
+	heat, heatUnit := temperatureReading(status, "thermostatHeatingSetpoint", "heatingSetpoint")
+	// Path: thermostatCoolingSetpoint.coolingSetpoint.{value,unit}
+	cool, coolUnit := temperatureReading(status, "thermostatCoolingSetpoint", "coolingSetpoint")
+
+	for _, unit := range []string{tempUnit, heatUnit, coolUnit} {
+		if unit != "" {
+			result.Unit = unit
+			break
+		}
+	}
+
+	result.Temperature = convertTemperature(temp, tempUnit, result.Unit)
+	result.HeatingSetpoint = convertTemperature(heat, heatUnit, result.Unit)
+	result.CoolingSetpoint = convertTemperature(cool, coolUnit, result.Unit)
+
+	// Path: thermostatMode.thermostatMode.value
+	if mode, ok := GetString(status, "thermostatMode", "thermostatMode", "value"); ok {
+		result.Mode = ThermostatMode(mode)
+	}
+
+	// Path: thermostatOperatingState.thermostatOperatingState.value
+	if state, ok := GetString(status, "thermostatOperatingState", "thermostatOperatingState", "value"); ok {
+		result.OperatingState = state
+	}
+
+	return result
+}
+
+// temperatureReading returns a temperature attribute's value and normalized
+// unit ("C", "F", or "" if absent).
+func temperatureReading(status Status, capability, attribute string) (*float64, string) {
+	value, ok := GetFloat(status, capability, attribute, "value")
+	if !ok {
+		return nil, ""
+	}
+	unit, _ := GetString(status, capability, attribute, "unit")
+	return &value, temperatureUnit(unit)
+}
+
+// convertTemperature converts a temperature between "C" and "F" with
+// NormalizeTemperature. The value is returned unchanged if either unit is
+// unknown or they match.
+func convertTemperature(value *float64, from, to string) *float64 {
+	if value == nil {
+		return nil
+	}
+	v := NormalizeTemperature(*value, from, to)
+	return &v
+}
+
+// SetThermostatSetpoint sets the heating or cooling setpoint used by mode.
+// ThermostatModeHeat and ThermostatModeEmergencyHeat set the heating setpoint;
+// ThermostatModeCool sets the cooling setpoint. Other modes return
+// ErrInvalidThermostatMode. The temperature is in the device's configured unit.
+//
+// Example:
+//
+//	err := client.SetThermostatSetpoint(ctx, deviceID, smartthings.ThermostatModeHeat, 68)
+func (c *Client) SetThermostatSetpoint(ctx context.Context, deviceID string, mode ThermostatMode, temp float64) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+
+	switch mode {
+	case ThermostatModeHeat, ThermostatModeEmergencyHeat:
+		return c.ExecuteCommand(ctx, deviceID, NewCommand("thermostatHeatingSetpoint", "setHeatingSetpoint", temp))
+	case ThermostatModeCool:
+		return c.ExecuteCommand(ctx, deviceID, NewCommand("thermostatCoolingSetpoint", "setCoolingSetpoint", temp))
+	default:
+		return ErrInvalidThermostatMode
+	}
+}
+
+// SetThermostatMode sets a thermostat's operating mode.
+func (c *Client) SetThermostatMode(ctx context.Context, deviceID string, mode ThermostatMode) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if mode == "" {
+		return ErrEmptyMode
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("thermostatMode", "setThermostatMode", string(mode)))
+}
@@ -0,0 +1,172 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractThermostatStatus(t *testing.T) {
+	t.Run("full status", func(t *testing.T) {
+		status := Status{
+			"temperatureMeasurement":    map[string]any{"temperature": map[string]any{"value": 71.0, "unit": "F"}},
+			"thermostatHeatingSetpoint": map[string]any{"heatingSetpoint": map[string]any{"value": 68.0, "unit": "F"}},
+			"thermostatCoolingSetpoint": map[string]any{"coolingSetpoint": map[string]any{"value": 76.0, "unit": "F"}},
+			"thermostatMode":            map[string]any{"thermostatMode": map[string]any{"value": "heat"}},
+			"thermostatOperatingState":  map[string]any{"thermostatOperatingState": map[string]any{"value": "heating"}},
+		}
+
+		result := ExtractThermostatStatus(status)
+		if result == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if result.Unit != "F" {
+			t.Errorf("Unit = %q, want %q", result.Unit, "F")
+		}
+		if result.Temperature == nil || *result.Temperature != 71 {
+			t.Errorf("Temperature = %v, want 71", result.Temperature)
+		}
+		if result.HeatingSetpoint == nil || *result.HeatingSetpoint != 68 {
+			t.Errorf("HeatingSetpoint = %v, want 68", result.HeatingSetpoint)
+		}
+		if result.CoolingSetpoint == nil || *result.CoolingSetpoint != 76 {
+			t.Errorf("CoolingSetpoint = %v, want 76", result.CoolingSetpoint)
+		}
+		if result.Mode != ThermostatModeHeat {
+			t.Errorf("Mode = %q, want %q", result.Mode, ThermostatModeHeat)
+		}
+		if result.OperatingState != "heating" {
+			t.Errorf("OperatingState = %q, want %q", result.OperatingState, "heating")
+		}
+	})
+
+	t.Run("mixed units converted to temperature unit", func(t *testing.T) {
+		status := Status{
+			"temperatureMeasurement":    map[string]any{"temperature": map[string]any{"value": 21.0, "unit": "C"}},
+			"thermostatHeatingSetpoint": map[string]any{"heatingSetpoint": map[string]any{"value": 68.0, "unit": "F"}},
+		}
+
+		result := ExtractThermostatStatus(status)
+		if result.Unit != "C" {
+			t.Errorf("Unit = %q, want %q", result.Unit, "C")
+		}
+		if result.HeatingSetpoint == nil || math.Abs(*result.HeatingSetpoint-20) > 0.001 {
+			t.Errorf("HeatingSetpoint = %v, want 20", result.HeatingSetpoint)
+		}
+	})
+
+	t.Run("unit from setpoint", func(t *testing.T) {
+		status := Status{
+			"thermostatCoolingSetpoint": map[string]any{"coolingSetpoint": map[string]any{"value": 24.0, "unit": "C"}},
+		}
+
+		result := ExtractThermostatStatus(status)
+		if result.Unit != "C" {
+			t.Errorf("Unit = %q, want %q", result.Unit, "C")
+		}
+		if result.Temperature != nil {
+			t.Errorf("Temperature = %v, want nil", *result.Temperature)
+		}
+	})
+
+	t.Run("no thermostat capabilities", func(t *testing.T) {
+		status := Status{
+			"temperatureMeasurement": map[string]any{"temperature": map[string]any{"value": 21.0, "unit": "C"}},
+		}
+		if result := ExtractThermostatStatus(status); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
+
+func TestClient_SetThermostatSetpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           ThermostatMode
+		wantCapability string
+		wantCommand    string
+	}{
+		{"heat", ThermostatModeHeat, "thermostatHeatingSetpoint", "setHeatingSetpoint"},
+		{"emergency heat", ThermostatModeEmergencyHeat, "thermostatHeatingSetpoint", "setHeatingSetpoint"},
+		{"cool", ThermostatModeCool, "thermostatCoolingSetpoint", "setCoolingSetpoint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != tt.wantCapability || cmd.Command != tt.wantCommand {
+					t.Errorf("command = %s.%s, want %s.%s", cmd.Capability, cmd.Command, tt.wantCapability, tt.wantCommand)
+				}
+				if len(cmd.Arguments) != 1 || cmd.Arguments[0].(float64) != 70.5 {
+					t.Errorf("arguments = %v, want [70.5]", cmd.Arguments)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := client.SetThermostatSetpoint(context.Background(), "thermostat-1", tt.mode, 70.5); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("unsupported mode", func(t *testing.T) {
+		client, _ := NewClient("token")
+		err := client.SetThermostatSetpoint(context.Background(), "thermostat-1", ThermostatModeAuto, 70)
+		if err != ErrInvalidThermostatMode {
+			t.Errorf("expected ErrInvalidThermostatMode, got %v", err)
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		err := client.SetThermostatSetpoint(context.Background(), "", ThermostatModeHeat, 70)
+		if err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
+func TestClient_SetThermostatMode(t *testing.T) {
+	t.Run("successful command", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req CommandRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			cmd := req.Commands[0]
+			if cmd.Capability != "thermostatMode" || cmd.Command != "setThermostatMode" {
+				t.Errorf("command = %s.%s, want thermostatMode.setThermostatMode", cmd.Capability, cmd.Command)
+			}
+			if len(cmd.Arguments) != 1 || cmd.Arguments[0] != "cool" {
+				t.Errorf("arguments = %v, want [cool]", cmd.Arguments)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.SetThermostatMode(context.Background(), "thermostat-1", ThermostatModeCool); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("empty mode", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.SetThermostatMode(context.Background(), "thermostat-1", ""); err != ErrEmptyMode {
+			t.Errorf("expected ErrEmptyMode, got %v", err)
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.SetThermostatMode(context.Background(), "", ThermostatModeCool); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
@@ -2,6 +2,12 @@ package smartthings
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
 )
 
 // TV Control Methods
@@ -133,12 +139,41 @@ func (c *Client) SendTVKey(ctx context.Context, deviceID, key string) error {
 	if key == "" {
 		return ErrEmptyKey
 	}
-	// Map common keys to Samsung format
-	mappedKey := key
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("samsungvd.remoteControl", "send", mapTVKey(key)))
+}
+
+// SendTVKeys sends a sequence of remote control key presses in order,
+// waiting delay between each one. It stops at the first failure and reports
+// which key failed. Keys are mapped the same way as SendTVKey.
+//
+// Example:
+//
+//	err := client.SendTVKeys(ctx, tvID, []string{"HOME", "DOWN", "DOWN", "ENTER"}, 300*time.Millisecond)
+func (c *Client) SendTVKeys(ctx context.Context, deviceID string, keys []string, delay time.Duration) error {
+	if slices.Contains(keys, "") {
+		return ErrEmptyKey
+	}
+	for i, key := range keys {
+		if i > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("key %d (%s): %w", i, key, ctx.Err())
+			case <-time.After(delay):
+			}
+		}
+		if err := c.SendTVKey(ctx, deviceID, key); err != nil {
+			return fmt.Errorf("key %d (%s): %w", i, key, err)
+		}
+	}
+	return nil
+}
+
+// mapTVKey maps common key names to the Samsung remote control format.
+func mapTVKey(key string) string {
 	if key == "ENTER" {
-		mappedKey = "KEY_ENTER"
+		return "KEY_ENTER"
 	}
-	return c.ExecuteCommand(ctx, deviceID, NewCommand("samsungvd.remoteControl", "send", mappedKey))
+	return key
 }
 
 // SetTVChannel sets the TV channel directly.
@@ -184,6 +219,61 @@ func (c *Client) TVStop(ctx context.Context, deviceID string) error {
 	return c.ExecuteCommand(ctx, deviceID, NewCommand("mediaPlayback", "stop"))
 }
 
+// Art Mode Methods
+
+// ambientCapabilities lists the ambient (art mode) capability names used by
+// Samsung Frame TVs, newest first.
+var ambientCapabilities = []string{"samsungvd.ambient", "samsungvd.ambient18"}
+
+// SetTVArtMode turns art (ambient) mode on or off on a Samsung Frame TV.
+// The command is sent to samsungvd.ambient first; if the TV rejects that
+// capability, the legacy samsungvd.ambient18 capability is tried.
+func (c *Client) SetTVArtMode(ctx context.Context, deviceID string, on bool) error {
+	command := "setAmbientOff"
+	if on {
+		command = "setAmbientOn"
+	}
+	var err error
+	for _, capability := range ambientCapabilities {
+		err = c.ExecuteCommand(ctx, deviceID, NewCommand(capability, command))
+		if !isUnsupportedCapability(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// isUnsupportedCapability reports whether a command failed because the device
+// does not implement the capability it was sent to.
+func isUnsupportedCapability(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || IsDeviceOffline(err) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity
+}
+
+// ExtractAmbientStatus extracts art (ambient) mode content and brightness
+// from a Samsung Frame TV status. Both samsungvd.ambient and the legacy
+// samsungvd.ambient18 capabilities are checked.
+// Returns nil if neither capability reports any ambient attributes.
+func ExtractAmbientStatus(status Status) *AmbientStatus {
+	for _, capability := range ambientCapabilities {
+		content, hasContent := GetString(status, capability, "ambientContent", "value")
+		brightness, hasBrightness := GetInt(status, capability, "brightness", "value")
+		if !hasContent && !hasBrightness {
+			continue
+		}
+		result := &AmbientStatus{Content: content}
+		if hasBrightness {
+			brightness = max(0, min(brightness, 100))
+			result.Brightness = &brightness
+		}
+		return result
+	}
+	return nil
+}
+
 // extractStrings extracts a string array from a status path.
 func extractStrings(status Status, keys ...string) []string {
 	arr, ok := GetArray(status, keys...)
@@ -251,6 +341,26 @@ func (c *Client) LaunchTVApp(ctx context.Context, deviceID, appID string) error
 	return c.ExecuteCommand(ctx, deviceID, NewCommand("custom.launchapp", "launchApp", appID))
 }
 
+// LaunchTVAppByName launches an app on the TV by its display name, such as
+// "Netflix" or "YouTube". The name is matched case-insensitively against the
+// app names and IDs the TV reports, then against CommonTVApps for apps the
+// TV does not list. Returns ErrAppNotFound if no app matches.
+func (c *Client) LaunchTVAppByName(ctx context.Context, deviceID, name string) error {
+	if name == "" {
+		return ErrEmptyAppID
+	}
+	status, err := c.GetDeviceStatus(ctx, deviceID)
+	if err != nil {
+		return err
+	}
+	for _, app := range slices.Concat(GetTVApps(status), CommonTVApps()) {
+		if strings.EqualFold(app.Name, name) || strings.EqualFold(app.ID, name) {
+			return c.LaunchTVApp(ctx, deviceID, app.ID)
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrAppNotFound, name)
+}
+
 // GetTVApps extracts available apps from a device status.
 func GetTVApps(status Status) []TVApp {
 	var apps []TVApp
@@ -3,9 +3,12 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetTVStatus(t *testing.T) {
@@ -369,6 +372,82 @@ func TestClient_SendTVKey(t *testing.T) {
 	}
 }
 
+func TestClient_SendTVKeys(t *testing.T) {
+	t.Run("sends keys in order", func(t *testing.T) {
+		var sent []any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req CommandRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			sent = append(sent, req.Commands[0].Arguments[0])
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.SendTVKeys(context.Background(), "tv-device", []string{"HOME", "DOWN", "ENTER"}, time.Millisecond)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []any{"HOME", "DOWN", "KEY_ENTER"}
+		if len(sent) != len(want) {
+			t.Fatalf("sent %v, want %v", sent, want)
+		}
+		for i := range want {
+			if sent[i] != want[i] {
+				t.Errorf("sent[%d] = %v, want %v", i, sent[i], want[i])
+			}
+		}
+	})
+
+	t.Run("stops at first failure", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 2 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.SendTVKeys(context.Background(), "tv-device", []string{"UP", "LEFT", "ENTER"}, 0)
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "key 1 (LEFT)") {
+			t.Errorf("error %q does not name the failed key", err)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("empty key sends nothing", func(t *testing.T) {
+		client, _ := NewClient("token")
+		err := client.SendTVKeys(context.Background(), "tv-device", []string{"UP", ""}, 0)
+		if err != ErrEmptyKey {
+			t.Errorf("expected ErrEmptyKey, got %v", err)
+		}
+	})
+
+	t.Run("canceled during delay", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.SendTVKeys(ctx, "tv-device", []string{"UP", "DOWN"}, time.Minute)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
 func TestClient_SetTVChannel(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var req CommandRequest
@@ -633,6 +712,102 @@ func TestClient_LaunchTVApp(t *testing.T) {
 	}
 }
 
+func TestClient_LaunchTVAppByName(t *testing.T) {
+	newServer := func(status Status, launched *string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(status)
+				return
+			}
+			var req CommandRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			*launched, _ = req.Commands[0].Arguments[0].(string)
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+
+	t.Run("resolves name from device apps", func(t *testing.T) {
+		status := Status{
+			"custom.launchapp": map[string]any{
+				"supportedAppIds": map[string]any{
+					"value": []any{
+						map[string]any{"id": "org.tizen.netflix-app", "name": "Netflix"},
+						map[string]any{"id": "111299001912", "name": "YouTube"},
+					},
+				},
+			},
+		}
+		var launched string
+		server := newServer(status, &launched)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.LaunchTVAppByName(context.Background(), "tv-device", "youtube"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if launched != "111299001912" {
+			t.Errorf("launched %q, want %q", launched, "111299001912")
+		}
+	})
+
+	t.Run("falls back to common apps", func(t *testing.T) {
+		var launched string
+		server := newServer(Status{}, &launched)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.LaunchTVAppByName(context.Background(), "tv-device", "MAX"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if launched != "HBO Max" {
+			t.Errorf("launched %q, want %q", launched, "HBO Max")
+		}
+	})
+
+	t.Run("falls back to common apps missing from device apps", func(t *testing.T) {
+		status := Status{
+			"custom.launchapp": map[string]any{
+				"supportedAppIds": map[string]any{
+					"value": []any{map[string]any{"id": "org.tizen.netflix-app", "name": "Netflix"}},
+				},
+			},
+		}
+		var launched string
+		server := newServer(status, &launched)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.LaunchTVAppByName(context.Background(), "tv-device", "disney+"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if launched != "Disney+" {
+			t.Errorf("launched %q, want %q", launched, "Disney+")
+		}
+	})
+
+	t.Run("unknown app", func(t *testing.T) {
+		var launched string
+		server := newServer(Status{}, &launched)
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.LaunchTVAppByName(context.Background(), "tv-device", "NoSuchApp")
+		if !errors.Is(err, ErrAppNotFound) {
+			t.Errorf("expected ErrAppNotFound, got %v", err)
+		}
+		if launched != "" {
+			t.Errorf("unexpected launch of %q", launched)
+		}
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if err := client.LaunchTVAppByName(context.Background(), "tv-device", ""); err != ErrEmptyAppID {
+			t.Errorf("expected ErrEmptyAppID, got %v", err)
+		}
+	})
+}
+
 func TestGetTVApps(t *testing.T) {
 	t.Run("with apps", func(t *testing.T) {
 		status := Status{
@@ -725,3 +900,144 @@ func TestLaunchTVApp_EmptyAppID(t *testing.T) {
 		t.Errorf("expected ErrEmptyAppID, got %v", err)
 	}
 }
+
+func TestClient_SetTVArtMode(t *testing.T) {
+	t.Run("samsungvd.ambient", func(t *testing.T) {
+		for _, tt := range []struct {
+			on   bool
+			want string
+		}{{true, "setAmbientOn"}, {false, "setAmbientOff"}} {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if req.Commands[0].Capability != "samsungvd.ambient" {
+					t.Errorf("capability = %q, want %q", req.Commands[0].Capability, "samsungvd.ambient")
+				}
+				if req.Commands[0].Command != tt.want {
+					t.Errorf("command = %q, want %q", req.Commands[0].Command, tt.want)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := client.SetTVArtMode(context.Background(), "tv-device", tt.on); err != nil {
+				t.Errorf("on=%v: unexpected error: %v", tt.on, err)
+			}
+			server.Close()
+		}
+	})
+
+	t.Run("falls back to legacy capability", func(t *testing.T) {
+		var capabilities []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req CommandRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			capabilities = append(capabilities, req.Commands[0].Capability)
+			if req.Commands[0].Capability == "samsungvd.ambient" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"error":{"code":"ConstraintViolationError","message":"capability not supported"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if err := client.SetTVArtMode(context.Background(), "tv-device", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(capabilities) != 2 || capabilities[1] != "samsungvd.ambient18" {
+			t.Errorf("capabilities tried = %v, want samsungvd.ambient then samsungvd.ambient18", capabilities)
+		}
+	})
+
+	t.Run("offline device is not retried", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error":{"code":"ConstraintViolationError","message":"Device is offline"}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.SetTVArtMode(context.Background(), "tv-device", true)
+		if !IsDeviceOffline(err) {
+			t.Errorf("expected offline error, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+}
+
+func TestExtractAmbientStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         Status
+		wantNil        bool
+		wantContent    string
+		wantBrightness *int
+	}{
+		{
+			name: "samsungvd.ambient",
+			status: Status{
+				"samsungvd.ambient": map[string]any{
+					"ambientContent": map[string]any{"value": "MY-F0001"},
+					"brightness":     map[string]any{"value": float64(40)},
+				},
+			},
+			wantContent:    "MY-F0001",
+			wantBrightness: ptrInt(40),
+		},
+		{
+			name: "legacy samsungvd.ambient18",
+			status: Status{
+				"samsungvd.ambient18": map[string]any{
+					"ambientContent": map[string]any{"value": "clock"},
+				},
+			},
+			wantContent: "clock",
+		},
+		{
+			name: "brightness clamped",
+			status: Status{
+				"samsungvd.ambient": map[string]any{
+					"brightness": map[string]any{"value": float64(150)},
+				},
+			},
+			wantBrightness: ptrInt(100),
+		},
+		{
+			name:    "no ambient capability",
+			status:  Status{"switch": map[string]any{"switch": map[string]any{"value": "on"}}},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractAmbientStatus(tt.status)
+			if tt.wantNil {
+				if result != nil {
+					t.Errorf("expected nil, got %+v", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("expected non-nil result")
+			}
+			if result.Content != tt.wantContent {
+				t.Errorf("Content = %q, want %q", result.Content, tt.wantContent)
+			}
+			if (result.Brightness == nil) != (tt.wantBrightness == nil) ||
+				(result.Brightness != nil && *result.Brightness != *tt.wantBrightness) {
+				t.Errorf("Brightness = %v, want %v", result.Brightness, tt.wantBrightness)
+			}
+		})
+	}
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
@@ -1,5 +1,11 @@
 package smartthings
 
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Status represents the raw device status response as a flexible map.
 // The SmartThings API returns deeply nested JSON structures that vary by device type.
 type Status map[string]any
@@ -51,6 +57,7 @@ type Device struct {
 type HubDeviceInfo struct {
 	HubEUI          string          `json:"hubEui,omitempty"`
 	FirmwareVersion string          `json:"firmwareVersion,omitempty"`
+	UpdateAvailable bool            `json:"updateAvailable,omitempty"`
 	HubData         *HubDeviceData  `json:"hubData,omitempty"`
 	HubDrivers      []HubDriverInfo `json:"hubDrivers,omitempty"`
 }
@@ -149,7 +156,8 @@ type DeviceHealth struct {
 
 // DeviceUpdate is the request body for updating a device.
 type DeviceUpdate struct {
-	Label string `json:"label,omitempty"`
+	Label  string `json:"label,omitempty"`
+	RoomID string `json:"roomId,omitempty"`
 }
 
 // PageInfo contains pagination information from API responses.
@@ -176,6 +184,28 @@ type ListDevicesOptions struct {
 	IncludeRestricted bool     // Include restricted devices
 }
 
+// ListOptions contains generic pagination options for list endpoints.
+type ListOptions struct {
+	Max  int // Max results per page (server default if zero)
+	Page int // Page number (0-based)
+}
+
+// encode returns the options as a URL query string (without the leading "?").
+func (o *ListOptions) encode(params url.Values) string {
+	if params == nil {
+		params = url.Values{}
+	}
+	if o != nil {
+		if o.Max > 0 {
+			params.Set("max", strconv.Itoa(o.Max))
+		}
+		if o.Page > 0 {
+			params.Set("page", strconv.Itoa(o.Page))
+		}
+	}
+	return params.Encode()
+}
+
 // PagedDevices is the response from ListDevicesWithOptions.
 type PagedDevices struct {
 	Items    []Device `json:"items"`
@@ -210,6 +240,13 @@ type TVStatus struct {
 	InputSource string `json:"input_source"` // e.g., "HDMI1", "Netflix"
 }
 
+// AmbientStatus represents the art (ambient) mode state of a Samsung Frame TV.
+// Use ExtractAmbientStatus to extract from a device status response.
+type AmbientStatus struct {
+	Content    string `json:"content,omitempty"`    // Artwork or ambient content being shown
+	Brightness *int   `json:"brightness,omitempty"` // Ambient brightness (0-100)
+}
+
 // TVInput represents an available TV input source.
 type TVInput struct {
 	ID   string `json:"id"`
@@ -396,3 +433,104 @@ type RangeDetailedStatus struct {
 	OvenTempMin        *int     `json:"oven_temp_min,omitempty"` // Min temp (F)
 	OvenTempMax        *int     `json:"oven_temp_max,omitempty"` // Max temp (F)
 }
+
+// ColorControlStatus represents the color state of a color-capable light.
+// Use ExtractColorControl to extract from a device status response.
+type ColorControlStatus struct {
+	Hue              float64 `json:"hue"`                         // 0-100 (SmartThings scale)
+	Saturation       float64 `json:"saturation"`                  // 0-100
+	ColorTemperature *int    `json:"color_temperature,omitempty"` // Kelvin, if colorTemperature is present
+	Hex              string  `json:"hex"`                         // RGB hex string, e.g. "#FF0000"
+}
+
+// ThermostatMode is a thermostatMode value.
+type ThermostatMode string
+
+// Thermostat mode constants.
+const (
+	ThermostatModeAuto          ThermostatMode = "auto"
+	ThermostatModeCool          ThermostatMode = "cool"
+	ThermostatModeHeat          ThermostatMode = "heat"
+	ThermostatModeEmergencyHeat ThermostatMode = "emergency heat"
+	ThermostatModeOff           ThermostatMode = "off"
+)
+
+// ThermostatStatus represents the state of a thermostat.
+// Use ExtractThermostatStatus to extract from a device status response.
+// All temperatures are expressed in Unit.
+type ThermostatStatus struct {
+	Temperature     *float64       `json:"temperature,omitempty"`      // Current temperature
+	HeatingSetpoint *float64       `json:"heating_setpoint,omitempty"` // Heat-to temperature
+	CoolingSetpoint *float64       `json:"cooling_setpoint,omitempty"` // Cool-to temperature
+	Mode            ThermostatMode `json:"mode,omitempty"`             // e.g. "heat", "cool", "auto"
+	OperatingState  string         `json:"operating_state,omitempty"`  // e.g. "heating", "idle"
+	Unit            string         `json:"unit,omitempty"`             // "C" or "F"
+}
+
+// LockStatus represents the state of a smart lock.
+// Use ExtractLockStatus to extract from a device status response.
+type LockStatus struct {
+	Locked         bool       `json:"locked"`
+	State          string     `json:"state"`                      // "locked", "unlocked", "unlocked with timeout", "unknown"
+	LastChangeTime *time.Time `json:"last_change_time,omitempty"` // When the lock state last changed, if reported
+}
+
+// MediaPlaybackStatus represents the playback state and current track of a
+// speaker or media player.
+// Use ExtractMediaPlaybackStatus to extract from a device status response.
+type MediaPlaybackStatus struct {
+	PlaybackStatus            string   `json:"playback_status,omitempty"` // e.g. "playing", "paused", "stopped"
+	Title                     string   `json:"title,omitempty"`
+	Artist                    string   `json:"artist,omitempty"`
+	Album                     string   `json:"album,omitempty"`
+	SupportedPlaybackCommands []string `json:"supported_playback_commands,omitempty"` // e.g. "play", "pause", "stop"
+	CanNextTrack              bool     `json:"can_next_track"`
+	CanPreviousTrack          bool     `json:"can_previous_track"`
+}
+
+// RobotVacuumStatus represents the state of a robot vacuum.
+// Use ExtractRobotVacuumStatus to extract from a device status response.
+type RobotVacuumStatus struct {
+	Battery      *int   `json:"battery,omitempty"`       // Battery percentage (0-100)
+	Movement     string `json:"movement,omitempty"`      // e.g. "cleaning", "homing", "charging", "pause", "idle"
+	CleaningMode string `json:"cleaning_mode,omitempty"` // e.g. "auto", "part", "repeat", "manual"
+	TurboMode    string `json:"turbo_mode,omitempty"`    // "on", "off", or "silence"
+	CurrentArea  string `json:"current_area,omitempty"`  // Area being cleaned, if reported
+}
+
+// AirConditionerStatus represents the state of an air conditioner.
+// Use ExtractAirConditionerStatus to extract from a device status response.
+type AirConditionerStatus struct {
+	Mode        string   `json:"mode,omitempty"`        // e.g. "cool", "dry", "wind", "auto", "heat"
+	Setpoint    *float64 `json:"setpoint,omitempty"`    // Cooling setpoint, in Unit
+	Temperature *float64 `json:"temperature,omitempty"` // Current temperature, in Unit
+	Unit        string   `json:"unit,omitempty"`        // "C" or "F"
+	FanMode     string   `json:"fan_mode,omitempty"`    // e.g. "auto", "low", "medium", "high"
+	SwingMode   string   `json:"swing_mode,omitempty"`  // fanOscillationMode value, if present
+}
+
+// ShadeStatus represents the state of a window shade or blind.
+// Use ExtractShadeStatus to extract from a device status response.
+type ShadeStatus struct {
+	State string `json:"state,omitempty"` // "open", "closed", "partially open", "opening", "closing", "unknown"
+	Level *int   `json:"level,omitempty"` // Open percentage (0 = closed, 100 = fully open)
+}
+
+// BatteryStatus represents the battery level of a battery-powered device.
+// Use ExtractBatteryStatus to extract from a device status response.
+type BatteryStatus struct {
+	Percent int  `json:"percent"` // Battery level (0-100)
+	Low     bool `json:"low"`     // True if Percent is below LowBatteryThreshold
+}
+
+// AirQualityStatus represents the readings of an air purifier or air quality
+// monitor. Dust levels are in μg/m³.
+// Use ExtractAirQuality to extract from a device status response.
+type AirQualityStatus struct {
+	AirQualityIndex *int `json:"air_quality_index,omitempty"` // airQualitySensor index (CAQI)
+	PM25            *int `json:"pm25,omitempty"`              // Fine dust (PM2.5)
+	PM10            *int `json:"pm10,omitempty"`              // Dust (PM10)
+	PM1             *int `json:"pm1,omitempty"`               // Very fine dust (PM1.0)
+	Odor            *int `json:"odor,omitempty"`              // odorSensor level
+	CO2             *int `json:"co2,omitempty"`               // Carbon dioxide, in ppm
+}
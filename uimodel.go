@@ -0,0 +1,237 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// UIControlKind identifies how a UIControl should be rendered.
+type UIControlKind string
+
+const (
+	// UIControlToggle is a two-state attribute that can be set, such as switch.
+	UIControlToggle UIControlKind = "toggle"
+	// UIControlSlider is a numeric attribute with a setter, such as switchLevel.
+	UIControlSlider UIControlKind = "slider"
+	// UIControlEnum is an attribute with a fixed set of settable values, such as thermostatMode.
+	UIControlEnum UIControlKind = "enum"
+	// UIControlState is a read-only attribute, such as temperatureMeasurement.
+	UIControlState UIControlKind = "state"
+)
+
+// DeviceUIModel is a render-ready view of a device, built by BuildDeviceUIModel.
+type DeviceUIModel struct {
+	DeviceID string      `json:"deviceId"`
+	Label    string      `json:"label"`
+	IconURL  string      `json:"iconUrl,omitempty"`
+	Controls []UIControl `json:"controls"`
+}
+
+// UIControl is a single attribute of a device with its current value and
+// what is needed to render and change it.
+type UIControl struct {
+	Component  string        `json:"component"`
+	Capability string        `json:"capability"`
+	Attribute  string        `json:"attribute"`
+	Kind       UIControlKind `json:"kind"`
+	Value      any           `json:"value,omitempty"`
+	Unit       string        `json:"unit,omitempty"`
+	Min        *float64      `json:"min,omitempty"`     // Slider lower bound
+	Max        *float64      `json:"max,omitempty"`     // Slider upper bound
+	Step       float64       `json:"step,omitempty"`    // Slider step, if the presentation sets one
+	Options    []string      `json:"options,omitempty"` // Toggle and enum values
+	Setter     string        `json:"setter,omitempty"`  // Command that sets the attribute, if any
+}
+
+// CommandFor returns the command that sets the control to value: the
+// attribute's setter with value as its argument, or for toggles and enums
+// without a setter, the command named after the value (e.g. "on").
+// Returns false for read-only controls.
+//
+// Example:
+//
+//	if cmd, ok := control.CommandFor("off"); ok {
+//	    err = client.ExecuteCommand(ctx, model.DeviceID, cmd)
+//	}
+func (u UIControl) CommandFor(value any) (Command, bool) {
+	var cmd Command
+	switch {
+	case u.Kind == UIControlState:
+		return Command{}, false
+	case u.Setter != "":
+		cmd = NewCommand(u.Capability, u.Setter, value)
+	default:
+		cmd = NewCommand(u.Capability, fmt.Sprint(value))
+	}
+	cmd.Component = u.Component
+	return cmd, true
+}
+
+// BuildDeviceUIModel combines a device's presentation, capability
+// definitions, and current status into a list of controls, in the order of
+// the presentation's detail view. Each attribute maps to a control kind:
+//
+//   - toggle: a string with two allowed values that can be set, either by a
+//     setter or by commands named after the values (switch on/off)
+//   - slider: a number with a setter; Min and Max come from the presentation's
+//     range if it has one, otherwise from the capability schema
+//   - enum: a string with more allowed values and a setter; the presentation's
+//     enabled values narrow Options when set
+//   - state: anything else, shown read-only
+//
+// Devices without a presentation use their profile's components and
+// capabilities instead. Capabilities whose definition is not found (such as
+// private custom capabilities) contribute read-only controls for the
+// attributes present in the status.
+//
+// Example:
+//
+//	model, err := client.BuildDeviceUIModel(ctx, deviceID)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, ctl := range model.Controls {
+//	    fmt.Printf("%s/%s [%s] = %v\n", ctl.Capability, ctl.Attribute, ctl.Kind, ctl.Value)
+//	}
+func (c *Client) BuildDeviceUIModel(ctx context.Context, deviceID string) (*DeviceUIModel, error) {
+	if deviceID == "" {
+		return nil, ErrEmptyDeviceID
+	}
+
+	device, err := c.GetDevice(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &DeviceUIModel{DeviceID: device.DeviceID, Label: device.Label}
+	if model.Label == "" {
+		model.Label = device.Name
+	}
+
+	var entries []PresentationConfigEntry
+	if device.PresentationID != "" {
+		presentation, err := c.GetDevicePresentation(ctx, device.PresentationID, device.ManufacturerName)
+		if err != nil {
+			return nil, err
+		}
+		model.IconURL = presentation.IconURL
+		entries = presentation.DetailView
+	}
+	if len(entries) == 0 {
+		for _, comp := range device.Components {
+			for _, ref := range comp.Capabilities {
+				entries = append(entries, PresentationConfigEntry{Component: comp.ID, Capability: ref.ID, Version: ref.Version})
+			}
+		}
+	}
+
+	status, err := c.GetDeviceFullStatus(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		component := entry.Component
+		if component == "" {
+			component = "main"
+		}
+
+		capability, err := c.commandCapability(ctx, entry.Capability)
+		if IsNotFound(err) {
+			capability = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("capability %s: %w", entry.Capability, err)
+		}
+
+		for _, attr := range uiAttributeNames(capability, status[component], entry.Capability) {
+			model.Controls = append(model.Controls, buildUIControl(component, entry, capability, attr, status[component]))
+		}
+	}
+
+	return model, nil
+}
+
+// uiAttributeNames lists the attributes to show for a capability, in name
+// order: those in its definition, or those in status if it has none.
+func uiAttributeNames(capability *Capability, status Status, capabilityID string) []string {
+	var names []string
+	if capability != nil {
+		for name := range capability.Attributes {
+			names = append(names, name)
+		}
+	} else if attrs, ok := GetMap(status, capabilityID); ok {
+		for name := range attrs {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// buildUIControl maps one attribute to a control.
+func buildUIControl(component string, entry PresentationConfigEntry, capability *Capability, attr string, status Status) UIControl {
+	control := UIControl{
+		Component:  component,
+		Capability: entry.Capability,
+		Attribute:  attr,
+		Kind:       UIControlState,
+	}
+	if value, ok := GetMap(status, entry.Capability, attr); ok {
+		control.Value = value["value"]
+		control.Unit, _ = value["unit"].(string)
+	}
+	if capability == nil {
+		return control
+	}
+
+	def := capability.Attributes[attr]
+	schema := def.Schema
+	control.Min, control.Max = schema.Minimum, schema.Maximum
+
+	var options []string
+	for _, v := range schema.Enum {
+		if s, ok := v.(string); ok {
+			options = append(options, s)
+		}
+	}
+
+	// Presentation overrides, keyed "<attribute>.value"
+	for _, v := range entry.Values {
+		if v.Key != attr+".value" {
+			continue
+		}
+		if v.Range != nil {
+			control.Min, control.Max = &v.Range.Min, &v.Range.Max
+		}
+		control.Step = v.Step
+		if len(v.EnabledValues) > 0 {
+			options = v.EnabledValues
+		}
+	}
+
+	valueCommands := len(options) > 0 && !slices.ContainsFunc(options, func(o string) bool {
+		_, ok := capability.Commands[o]
+		return !ok
+	})
+
+	switch {
+	case (schema.Type == "integer" || schema.Type == "number") && def.Setter != "":
+		control.Kind = UIControlSlider
+	case schema.Type == "string" && len(options) == 2 && (def.Setter != "" || valueCommands):
+		control.Kind = UIControlToggle
+	case schema.Type == "string" && len(options) > 0 && (def.Setter != "" || valueCommands):
+		control.Kind = UIControlEnum
+	}
+	if control.Kind != UIControlState {
+		control.Setter = def.Setter
+	}
+	if control.Kind == UIControlToggle || control.Kind == UIControlEnum {
+		control.Options = options
+	}
+	if control.Kind != UIControlSlider {
+		control.Min, control.Max, control.Step = nil, nil, 0
+	}
+	return control
+}
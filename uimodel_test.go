@@ -0,0 +1,167 @@
+package smartthings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+const uiModelStatus = `{"components": {"main": {
+	"switch": {"switch": {"value": "on"}},
+	"switchLevel": {"level": {"value": 40, "unit": "%"}},
+	"thermostatMode": {"thermostatMode": {"value": "heat"}},
+	"temperatureMeasurement": {"temperature": {"value": 21.5, "unit": "C"}},
+	"custom.picturemode": {"pictureMode": {"value": "Movie"}}
+}}}`
+
+func uiModelServer(t *testing.T, device, presentation string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/devices/dev-1":
+			w.Write([]byte(device))
+		case "/devices/dev-1/status":
+			w.Write([]byte(uiModelStatus))
+		case "/presentation":
+			if r.URL.Query().Get("presentationId") != "pres-1" || r.URL.Query().Get("manufacturerName") != "acme" {
+				t.Errorf("unexpected presentation query %q", r.URL.RawQuery)
+			}
+			w.Write([]byte(presentation))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func findControl(t *testing.T, model *DeviceUIModel, capability, attribute string) UIControl {
+	t.Helper()
+	i := slices.IndexFunc(model.Controls, func(c UIControl) bool {
+		return c.Capability == capability && c.Attribute == attribute
+	})
+	if i < 0 {
+		t.Fatalf("no control for %s.%s in %+v", capability, attribute, model.Controls)
+	}
+	return model.Controls[i]
+}
+
+func TestClient_BuildDeviceUIModel(t *testing.T) {
+	device := `{"deviceId": "dev-1", "label": "Living Room", "presentationId": "pres-1", "manufacturerName": "acme"}`
+	presentation := `{"presentationId": "pres-1", "iconUrl": "icon.png", "detailView": [
+		{"component": "main", "capability": "switch"},
+		{"component": "main", "capability": "switchLevel", "values": [{"key": "level.value", "range": {"min": 1, "max": 90}, "step": 5}]},
+		{"component": "main", "capability": "thermostatMode", "values": [{"key": "thermostatMode.value", "enabledValues": ["heat", "off"]}]},
+		{"component": "main", "capability": "temperatureMeasurement"},
+		{"component": "main", "capability": "custom.picturemode"}
+	]}`
+	server := uiModelServer(t, device, presentation)
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	model, err := client.BuildDeviceUIModel(context.Background(), "dev-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model.Label != "Living Room" || model.IconURL != "icon.png" || len(model.Controls) != 5 {
+		t.Fatalf("model = %+v", model)
+	}
+	if model.Controls[0].Capability != "switch" || model.Controls[4].Capability != "custom.picturemode" {
+		t.Errorf("controls not in detail view order: %+v", model.Controls)
+	}
+
+	t.Run("toggle", func(t *testing.T) {
+		ctl := findControl(t, model, "switch", "switch")
+		if ctl.Kind != UIControlToggle || ctl.Value != "on" || !slices.Equal(ctl.Options, []string{"on", "off"}) {
+			t.Errorf("switch control = %+v", ctl)
+		}
+		cmd, ok := ctl.CommandFor("off")
+		if !ok || cmd.Component != "main" || cmd.Capability != "switch" || cmd.Command != "off" || len(cmd.Arguments) != 0 {
+			t.Errorf("CommandFor(off) = %+v, %v", cmd, ok)
+		}
+	})
+
+	t.Run("slider with presentation range", func(t *testing.T) {
+		ctl := findControl(t, model, "switchLevel", "level")
+		if ctl.Kind != UIControlSlider || ctl.Value != 40.0 || ctl.Unit != "%" || ctl.Step != 5 {
+			t.Errorf("level control = %+v", ctl)
+		}
+		if ctl.Min == nil || *ctl.Min != 1 || ctl.Max == nil || *ctl.Max != 90 {
+			t.Errorf("range = %v - %v, want 1 - 90", ctl.Min, ctl.Max)
+		}
+		cmd, _ := ctl.CommandFor(60)
+		if cmd.Command != "setLevel" || len(cmd.Arguments) != 1 || cmd.Arguments[0] != 60 {
+			t.Errorf("CommandFor(60) = %+v", cmd)
+		}
+	})
+
+	t.Run("enabled values narrow an enum to a toggle", func(t *testing.T) {
+		ctl := findControl(t, model, "thermostatMode", "thermostatMode")
+		if ctl.Kind != UIControlToggle || !slices.Equal(ctl.Options, []string{"heat", "off"}) || ctl.Setter != "setThermostatMode" {
+			t.Errorf("thermostatMode control = %+v", ctl)
+		}
+	})
+
+	t.Run("read-only state", func(t *testing.T) {
+		ctl := findControl(t, model, "temperatureMeasurement", "temperature")
+		if ctl.Kind != UIControlState || ctl.Value != 21.5 || ctl.Unit != "C" || ctl.Min != nil {
+			t.Errorf("temperature control = %+v", ctl)
+		}
+		if _, ok := ctl.CommandFor(20); ok {
+			t.Error("expected no command for a read-only control")
+		}
+	})
+
+	t.Run("unknown capability from status", func(t *testing.T) {
+		ctl := findControl(t, model, "custom.picturemode", "pictureMode")
+		if ctl.Kind != UIControlState || ctl.Value != "Movie" {
+			t.Errorf("pictureMode control = %+v", ctl)
+		}
+	})
+}
+
+func TestClient_BuildDeviceUIModel_NoPresentation(t *testing.T) {
+	device := `{"deviceId": "dev-1", "name": "thermostat", "components": [
+		{"id": "main", "capabilities": [{"id": "thermostatMode", "version": 1}]}
+	]}`
+	server := uiModelServer(t, device, "")
+	defer server.Close()
+
+	client, _ := NewClient("token", WithBaseURL(server.URL))
+	model, err := client.BuildDeviceUIModel(context.Background(), "dev-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model.Label != "thermostat" || len(model.Controls) != 1 {
+		t.Fatalf("model = %+v", model)
+	}
+	ctl := model.Controls[0]
+	if ctl.Kind != UIControlEnum || ctl.Value != "heat" || len(ctl.Options) != 6 {
+		t.Errorf("thermostatMode control = %+v", ctl)
+	}
+	cmd, _ := ctl.CommandFor("cool")
+	if cmd.Command != "setThermostatMode" || cmd.Arguments[0] != "cool" {
+		t.Errorf("CommandFor(cool) = %+v", cmd)
+	}
+}
+
+func TestClient_BuildDeviceUIModel_Errors(t *testing.T) {
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		if _, err := client.BuildDeviceUIModel(context.Background(), ""); err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+
+	t.Run("device not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		if _, err := client.BuildDeviceUIModel(context.Background(), "dev-1"); !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
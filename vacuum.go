@@ -0,0 +1,90 @@
+package smartthings
+
+import "context"
+
+// Robot Vacuum Helpers
+//
+// These helpers cover the SmartThings robot cleaner capabilities
+// (robotCleanerMovement, robotCleanerCleaningMode, robotCleanerTurboMode)
+// used by Samsung Jetbot and similar robot vacuums.
+
+// ExtractRobotVacuumStatus extracts robot vacuum state from a device status.
+// Returns nil if the status has no robotCleanerMovement or
+// robotCleanerCleaningMode capability.
+//
+// Example:
+//
+//	status, _ := client.GetDeviceStatus(ctx, deviceID)
+//	if vac := smartthings.ExtractRobotVacuumStatus(status); vac != nil && vac.Movement == "cleaning" {
+//	    fmt.Printf("cleaning %s\n", vac.CurrentArea)
+//	}
+func ExtractRobotVacuumStatus(status Status) *RobotVacuumStatus {
+	_, hasMovement := GetMap(status, "robotCleanerMovement")
+	_, hasMode := GetMap(status, "robotCleanerCleaningMode")
+	if !hasMovement && !hasMode {
+		return nil
+	}
+
+	result := &RobotVacuumStatus{}
+
+	// Path: battery.battery.value
+	if battery, ok := GetInt(status, "battery", "battery", "value"); ok {
+		result.Battery = &battery
+	}
+
+	// Path: robotCleanerMovement.robotCleanerMovement.value
+	if value, ok := GetString(status, "robotCleanerMovement", "robotCleanerMovement", "value"); ok {
+		result.Movement = value
+	}
+
+	// Path: robotCleanerCleaningMode.robotCleanerCleaningMode.value
+	if value, ok := GetString(status, "robotCleanerCleaningMode", "robotCleanerCleaningMode", "value"); ok {
+		result.CleaningMode = value
+	}
+
+	// Path: robotCleanerTurboMode.robotCleanerTurboMode.value
+	if value, ok := GetString(status, "robotCleanerTurboMode", "robotCleanerTurboMode", "value"); ok {
+		result.TurboMode = value
+	}
+
+	// Path: samsungce.robotCleanerMapCleaningInfo.area.value
+	if value, ok := GetString(status, "samsungce.robotCleanerMapCleaningInfo", "area", "value"); ok {
+		result.CurrentArea = value
+	}
+
+	return result
+}
+
+// StartCleaning starts a cleaning run.
+func (c *Client) StartCleaning(ctx context.Context, deviceID string) error {
+	return c.setRobotCleanerMovement(ctx, deviceID, "cleaning")
+}
+
+// PauseCleaning pauses the current cleaning run.
+func (c *Client) PauseCleaning(ctx context.Context, deviceID string) error {
+	return c.setRobotCleanerMovement(ctx, deviceID, "pause")
+}
+
+// ReturnToDock sends the vacuum back to its charging dock.
+func (c *Client) ReturnToDock(ctx context.Context, deviceID string) error {
+	return c.setRobotCleanerMovement(ctx, deviceID, "homing")
+}
+
+// SetCleaningMode sets the cleaning mode (e.g. "auto", "part", "repeat", "manual").
+func (c *Client) SetCleaningMode(ctx context.Context, deviceID, mode string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	if mode == "" {
+		return ErrEmptyMode
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("robotCleanerCleaningMode", "setRobotCleanerCleaningMode", mode))
+}
+
+// setRobotCleanerMovement sends a robotCleanerMovement.setRobotCleanerMovement command.
+func (c *Client) setRobotCleanerMovement(ctx context.Context, deviceID, movement string) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+	return c.ExecuteCommand(ctx, deviceID, NewCommand("robotCleanerMovement", "setRobotCleanerMovement", movement))
+}
@@ -0,0 +1,116 @@
+package smartthings
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractRobotVacuumStatus(t *testing.T) {
+	t.Run("full status", func(t *testing.T) {
+		status := Status{
+			"battery":                  map[string]any{"battery": map[string]any{"value": float64(87)}},
+			"robotCleanerMovement":     map[string]any{"robotCleanerMovement": map[string]any{"value": "cleaning"}},
+			"robotCleanerCleaningMode": map[string]any{"robotCleanerCleaningMode": map[string]any{"value": "auto"}},
+			"robotCleanerTurboMode":    map[string]any{"robotCleanerTurboMode": map[string]any{"value": "on"}},
+			"samsungce.robotCleanerMapCleaningInfo": map[string]any{
+				"area": map[string]any{"value": "Living Room"},
+			},
+		}
+
+		result := ExtractRobotVacuumStatus(status)
+		if result == nil {
+			t.Fatal("expected non-nil result")
+		}
+		if result.Battery == nil || *result.Battery != 87 {
+			t.Errorf("Battery = %v, want 87", result.Battery)
+		}
+		if result.Movement != "cleaning" {
+			t.Errorf("Movement = %q, want %q", result.Movement, "cleaning")
+		}
+		if result.CleaningMode != "auto" {
+			t.Errorf("CleaningMode = %q, want %q", result.CleaningMode, "auto")
+		}
+		if result.TurboMode != "on" {
+			t.Errorf("TurboMode = %q, want %q", result.TurboMode, "on")
+		}
+		if result.CurrentArea != "Living Room" {
+			t.Errorf("CurrentArea = %q, want %q", result.CurrentArea, "Living Room")
+		}
+	})
+
+	t.Run("minimal status", func(t *testing.T) {
+		status := Status{
+			"robotCleanerMovement": map[string]any{"robotCleanerMovement": map[string]any{"value": "charging"}},
+		}
+
+		result := ExtractRobotVacuumStatus(status)
+		if result.Battery != nil {
+			t.Errorf("Battery = %v, want nil", *result.Battery)
+		}
+		if result.Movement != "charging" {
+			t.Errorf("Movement = %q, want %q", result.Movement, "charging")
+		}
+	})
+
+	t.Run("not a vacuum", func(t *testing.T) {
+		status := Status{"battery": map[string]any{"battery": map[string]any{"value": float64(50)}}}
+		if result := ExtractRobotVacuumStatus(status); result != nil {
+			t.Errorf("expected nil, got %+v", result)
+		}
+	})
+}
+
+func TestClient_RobotVacuumCommands(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(c *Client) error
+		wantCapability string
+		wantCommand    string
+		wantArg        string
+	}{
+		{"start", func(c *Client) error { return c.StartCleaning(context.Background(), "vac-1") }, "robotCleanerMovement", "setRobotCleanerMovement", "cleaning"},
+		{"pause", func(c *Client) error { return c.PauseCleaning(context.Background(), "vac-1") }, "robotCleanerMovement", "setRobotCleanerMovement", "pause"},
+		{"dock", func(c *Client) error { return c.ReturnToDock(context.Background(), "vac-1") }, "robotCleanerMovement", "setRobotCleanerMovement", "homing"},
+		{"mode", func(c *Client) error { return c.SetCleaningMode(context.Background(), "vac-1", "repeat") }, "robotCleanerCleaningMode", "setRobotCleanerCleaningMode", "repeat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req CommandRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				cmd := req.Commands[0]
+				if cmd.Capability != tt.wantCapability || cmd.Command != tt.wantCommand {
+					t.Errorf("command = %s.%s, want %s.%s", cmd.Capability, cmd.Command, tt.wantCapability, tt.wantCommand)
+				}
+				if len(cmd.Arguments) != 1 || cmd.Arguments[0] != tt.wantArg {
+					t.Errorf("arguments = %v, want [%s]", cmd.Arguments, tt.wantArg)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, _ := NewClient("token", WithBaseURL(server.URL))
+			if err := tt.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		client, _ := NewClient("token")
+		ctx := context.Background()
+		if err := client.StartCleaning(ctx, ""); err != ErrEmptyDeviceID {
+			t.Errorf("StartCleaning: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetCleaningMode(ctx, "", "auto"); err != ErrEmptyDeviceID {
+			t.Errorf("SetCleaningMode: expected ErrEmptyDeviceID, got %v", err)
+		}
+		if err := client.SetCleaningMode(ctx, "vac-1", ""); err != ErrEmptyMode {
+			t.Errorf("SetCleaningMode: expected ErrEmptyMode, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+// Package vaultstore provides a smartthings.TokenStore backed by a HashiCorp
+// Vault KV version 2 secrets engine.
+//
+// It is a separate module so that the core smartthings package does not
+// depend on the Vault client:
+//
+//	go get github.com/tj-smith47/smartthings-go/vaultstore
+//
+// Example:
+//
+//	vault, _ := vaultapi.NewClient(vaultapi.DefaultConfig())
+//	store := vaultstore.NewVaultTokenStore(vault, "secret/smartthings/tokens")
+//	client, err := smartthings.NewOAuthClient(cfg, store)
+package vaultstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+// VaultTokenStore stores OAuth tokens as a secret in a Vault KV v2 engine.
+type VaultTokenStore struct {
+	kv   *vaultapi.KVv2
+	path string
+}
+
+// NewVaultTokenStore creates a VaultTokenStore that stores tokens at path,
+// given as "<mount>/<secret path>" (e.g. "secret/smartthings/tokens" for the
+// secret "smartthings/tokens" in the KV v2 engine mounted at "secret").
+func NewVaultTokenStore(client *vaultapi.Client, path string) *VaultTokenStore {
+	mount, secretPath, _ := strings.Cut(strings.Trim(path, "/"), "/")
+	return &VaultTokenStore{
+		kv:   client.KVv2(mount),
+		path: secretPath,
+	}
+}
+
+// SaveTokens writes the full token response as a new version of the secret.
+func (v *VaultTokenStore) SaveTokens(ctx context.Context, tokens *smartthings.TokenResponse) error {
+	if tokens == nil {
+		return fmt.Errorf("tokens cannot be nil")
+	}
+
+	// Round-trip through JSON so the secret uses TokenResponse's JSON field names.
+	raw, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tokens: %w", err)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("failed to marshal tokens: %w", err)
+	}
+
+	if _, err := v.kv.Put(ctx, v.path, data); err != nil {
+		return fmt.Errorf("failed to write tokens to vault: %w", err)
+	}
+	return nil
+}
+
+// LoadTokens reads the latest version of the secret.
+// If the stored tokens have no expiry time but do have an expires_in value,
+// ExpiresAt is reconstructed relative to when the secret version was created.
+func (v *VaultTokenStore) LoadTokens(ctx context.Context) (*smartthings.TokenResponse, error) {
+	secret, err := v.kv.Get(ctx, v.path)
+	if err != nil {
+		if errors.Is(err, vaultapi.ErrSecretNotFound) {
+			return nil, fmt.Errorf("no tokens stored in vault: %w", err)
+		}
+		return nil, fmt.Errorf("failed to read tokens from vault: %w", err)
+	}
+
+	raw, err := json.Marshal(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tokens from vault: %w", err)
+	}
+	var tokens smartthings.TokenResponse
+	if err := json.Unmarshal(raw, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse tokens from vault: %w", err)
+	}
+
+	if tokens.ExpiresAt.IsZero() && tokens.ExpiresIn > 0 {
+		created := time.Now()
+		if secret.VersionMetadata != nil && !secret.VersionMetadata.CreatedTime.IsZero() {
+			created = secret.VersionMetadata.CreatedTime
+		}
+		tokens.ExpiresAt = created.Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+
+	return &tokens, nil
+}
+
+// Delete permanently removes the secret and all of its versions.
+func (v *VaultTokenStore) Delete(ctx context.Context) error {
+	if err := v.kv.DeleteMetadata(ctx, v.path); err != nil {
+		return fmt.Errorf("failed to delete tokens from vault: %w", err)
+	}
+	return nil
+}
+
+// Exists reports whether the secret has a readable current version.
+func (v *VaultTokenStore) Exists(ctx context.Context) (bool, error) {
+	_, err := v.kv.Get(ctx, v.path)
+	if errors.Is(err, vaultapi.ErrSecretNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read tokens from vault: %w", err)
+	}
+	return true, nil
+}
+
+// Verify interface compliance at compile time.
+var _ smartthings.TokenStore = (*VaultTokenStore)(nil)
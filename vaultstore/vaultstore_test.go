@@ -0,0 +1,145 @@
+package vaultstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	smartthings "github.com/tj-smith47/smartthings-go"
+)
+
+// fakeKV is a minimal in-memory stand-in for a Vault KV v2 engine mounted at "secret".
+type fakeKV struct {
+	mu      sync.Mutex
+	data    map[string]map[string]any
+	created time.Time
+}
+
+func newFakeVault(t *testing.T) (*vaultapi.Client, *fakeKV) {
+	t.Helper()
+	kv := &fakeKV{data: map[string]map[string]any{}, created: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kv.mu.Lock()
+		defer kv.mu.Unlock()
+
+		dataPath, isData := strings.CutPrefix(r.URL.Path, "/v1/secret/data/")
+		metadataPath, isMetadata := strings.CutPrefix(r.URL.Path, "/v1/secret/metadata/")
+		switch {
+		case isData && (r.Method == http.MethodPut || r.Method == http.MethodPost):
+			var body struct {
+				Data map[string]any `json:"data"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			kv.data[dataPath] = body.Data
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"version": 1}})
+		case isData && r.Method == http.MethodGet:
+			data, ok := kv.data[dataPath]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errors":[]}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+				"data":     data,
+				"metadata": map[string]any{"created_time": kv.created.Format(time.RFC3339Nano), "version": 1},
+			}})
+		case isMetadata && r.Method == http.MethodDelete:
+			delete(kv.data, metadataPath)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := vaultapi.NewClient(&vaultapi.Config{Address: server.URL})
+	if err != nil {
+		t.Fatalf("vaultapi.NewClient: %v", err)
+	}
+	client.SetToken("vault-token")
+	return client, kv
+}
+
+func TestVaultTokenStore(t *testing.T) {
+	ctx := context.Background()
+	client, kv := newFakeVault(t)
+	store := NewVaultTokenStore(client, "secret/smartthings/tokens")
+
+	if ok, err := store.Exists(ctx); err != nil || ok {
+		t.Fatalf("Exists before save = %v, %v; want false, nil", ok, err)
+	}
+	if _, err := store.LoadTokens(ctx); err == nil {
+		t.Fatal("expected error loading missing tokens")
+	}
+
+	expiresAt := time.Date(2026, 5, 6, 7, 8, 9, 0, time.UTC)
+	tokens := &smartthings.TokenResponse{
+		AccessToken:    "access",
+		RefreshToken:   "refresh",
+		ExpiresIn:      3600,
+		TokenType:      "Bearer",
+		Scope:          "r:devices:*",
+		InstalledAppID: "app-1",
+		ExpiresAt:      expiresAt,
+	}
+	if err := store.SaveTokens(ctx, tokens); err != nil {
+		t.Fatalf("SaveTokens: %v", err)
+	}
+	if got := kv.data["smartthings/tokens"]["access_token"]; got != "access" {
+		t.Errorf("stored access_token = %v, want %q", got, "access")
+	}
+
+	if ok, err := store.Exists(ctx); err != nil || !ok {
+		t.Fatalf("Exists after save = %v, %v; want true, nil", ok, err)
+	}
+
+	loaded, err := store.LoadTokens(ctx)
+	if err != nil {
+		t.Fatalf("LoadTokens: %v", err)
+	}
+	if loaded.AccessToken != "access" || loaded.RefreshToken != "refresh" || loaded.InstalledAppID != "app-1" {
+		t.Errorf("loaded = %+v", loaded)
+	}
+	if !loaded.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("ExpiresAt = %v, want %v", loaded.ExpiresAt, expiresAt)
+	}
+
+	if err := store.Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if ok, _ := store.Exists(ctx); ok {
+		t.Error("expected tokens to be deleted")
+	}
+}
+
+func TestVaultTokenStore_ReconstructsExpiresAt(t *testing.T) {
+	ctx := context.Background()
+	client, kv := newFakeVault(t)
+	store := NewVaultTokenStore(client, "/secret/st/")
+
+	kv.data["st"] = map[string]any{"access_token": "access", "expires_in": 600}
+
+	loaded, err := store.LoadTokens(ctx)
+	if err != nil {
+		t.Fatalf("LoadTokens: %v", err)
+	}
+	if want := kv.created.Add(10 * time.Minute); !loaded.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %v, want %v", loaded.ExpiresAt, want)
+	}
+}
+
+func TestVaultTokenStore_SaveNil(t *testing.T) {
+	client, _ := newFakeVault(t)
+	store := NewVaultTokenStore(client, "secret/st")
+	if err := store.SaveTokens(context.Background(), nil); err == nil {
+		t.Error("expected error for nil tokens")
+	}
+}
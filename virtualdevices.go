@@ -3,6 +3,7 @@ package smartthings
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 )
@@ -176,3 +177,35 @@ func (c *Client) CreateVirtualDeviceEvents(ctx context.Context, deviceID string,
 
 	return &resp, nil
 }
+
+// CreateVirtualDeviceWithState creates a virtual device and seeds its initial
+// state with CreateVirtualDeviceEvents. The device is returned only after
+// seeding succeeds; if seeding fails, the device is deleted again and the
+// seeding error is returned (joined with the delete error, if that fails too).
+// With no initialStates it behaves like CreateVirtualDevice.
+//
+// Example:
+//
+//	device, err := client.CreateVirtualDeviceWithState(ctx, req, []smartthings.VirtualDeviceEvent{
+//	    {Component: "main", Capability: "switch", Attribute: "switch", Value: "off"},
+//	})
+func (c *Client) CreateVirtualDeviceWithState(ctx context.Context, req *VirtualDeviceCreateRequest, initialStates []VirtualDeviceEvent) (*Device, error) {
+	device, err := c.CreateVirtualDevice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(initialStates) == 0 {
+		return device, nil
+	}
+
+	if _, err := c.CreateVirtualDeviceEvents(ctx, device.DeviceID, initialStates); err != nil {
+		err = fmt.Errorf("CreateVirtualDeviceWithState: seed state: %w", err)
+		// Roll back even if ctx was canceled, so no half-initialized device is left behind.
+		if delErr := c.DeleteDevice(context.WithoutCancel(ctx), device.DeviceID); delErr != nil {
+			err = errors.Join(err, fmt.Errorf("CreateVirtualDeviceWithState: delete device %s: %w", device.DeviceID, delErr))
+		}
+		return nil, err
+	}
+
+	return device, nil
+}
@@ -2,6 +2,7 @@ package smartthings
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -260,3 +261,94 @@ func TestClient_CreateVirtualDeviceEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_CreateVirtualDeviceWithState(t *testing.T) {
+	req := &VirtualDeviceCreateRequest{Name: "Virtual Switch", DeviceProfileID: "profile1"}
+	states := []VirtualDeviceEvent{{Component: "main", Capability: "switch", Attribute: "switch", Value: "on"}}
+
+	newServer := func(eventsStatus, deleteStatus int, calls *[]string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/virtualdevices":
+				w.Write([]byte(`{"deviceId": "vdev1", "name": "Virtual Switch"}`))
+			case r.URL.Path == "/virtualdevices/vdev1/events":
+				w.WriteHeader(eventsStatus)
+				w.Write([]byte(`{"stateChanges": []}`))
+			case r.Method == http.MethodDelete && r.URL.Path == "/devices/vdev1":
+				w.WriteHeader(deleteStatus)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	t.Run("creates and seeds", func(t *testing.T) {
+		var calls []string
+		server := newServer(http.StatusOK, http.StatusOK, &calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-token", WithBaseURL(server.URL))
+		device, err := client.CreateVirtualDeviceWithState(context.Background(), req, states)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if device.DeviceID != "vdev1" {
+			t.Errorf("DeviceID = %q, want vdev1", device.DeviceID)
+		}
+		if len(calls) != 2 {
+			t.Errorf("expected create and events calls, got %v", calls)
+		}
+	})
+
+	t.Run("no initial states", func(t *testing.T) {
+		var calls []string
+		server := newServer(http.StatusOK, http.StatusOK, &calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-token", WithBaseURL(server.URL))
+		if _, err := client.CreateVirtualDeviceWithState(context.Background(), req, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(calls) != 1 {
+			t.Errorf("expected only the create call, got %v", calls)
+		}
+	})
+
+	t.Run("rolls back when seeding fails", func(t *testing.T) {
+		var calls []string
+		server := newServer(http.StatusBadRequest, http.StatusOK, &calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-token", WithBaseURL(server.URL))
+		device, err := client.CreateVirtualDeviceWithState(context.Background(), req, states)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if device != nil {
+			t.Errorf("expected nil device, got %+v", device)
+		}
+		if calls[len(calls)-1] != "DELETE /devices/vdev1" {
+			t.Errorf("expected device to be deleted, got calls %v", calls)
+		}
+	})
+
+	t.Run("reports failed rollback", func(t *testing.T) {
+		var calls []string
+		server := newServer(http.StatusBadRequest, http.StatusNotFound, &calls)
+		defer server.Close()
+
+		client, _ := NewClient("test-token", WithBaseURL(server.URL))
+		_, err := client.CreateVirtualDeviceWithState(context.Background(), req, states)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected joined delete error, got %v", err)
+		}
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		client, _ := NewClient("test-token")
+		if _, err := client.CreateVirtualDeviceWithState(context.Background(), nil, states); err != ErrEmptyVirtualDeviceName {
+			t.Errorf("expected ErrEmptyVirtualDeviceName, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package smartthings
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Default polling behavior for WaitForDeviceState.
+const (
+	DefaultWaitInterval = time.Second
+	maxWaitInterval     = 30 * time.Second
+)
+
+// WaitOptions configures WaitForDeviceState polling.
+type WaitOptions struct {
+	// Interval is the delay before the second poll. It doubles after each
+	// poll, up to 30 seconds. Defaults to DefaultWaitInterval if not positive.
+	Interval time.Duration
+
+	// MaxAttempts is the maximum number of status polls. Zero means poll
+	// until the context is done.
+	MaxAttempts int
+}
+
+// WaitForDeviceState polls GetDeviceStatus with exponential backoff until the
+// attribute of capability in the main component equals want, or until the
+// context is done or MaxAttempts polls have been made.
+//
+// Numeric values are compared by value, so want may be an int even though
+// JSON numbers decode as float64. If the wait ends before the state is
+// reached, the returned error wraps ErrWaitTimeout (and the context error, if
+// any). API errors are returned as-is and end the wait immediately.
+//
+// Example:
+//
+//	client.ExecuteCommand(ctx, deviceID, NewCommand("switch", "on"))
+//	err := client.WaitForDeviceState(ctx, deviceID, "switch", "switch", "on", nil)
+//	if errors.Is(err, smartthings.ErrWaitTimeout) {
+//	    log.Println("device did not turn on")
+//	}
+func (c *Client) WaitForDeviceState(ctx context.Context, deviceID, capability, attribute string, want any, opts *WaitOptions) error {
+	if deviceID == "" {
+		return ErrEmptyDeviceID
+	}
+
+	interval := DefaultWaitInterval
+	maxAttempts := 0
+	if opts != nil {
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		maxAttempts = opts.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		status, err := c.GetDeviceStatus(ctx, deviceID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("%w: %w", ErrWaitTimeout, ctx.Err())
+			}
+			return err
+		}
+
+		if got, ok := attributeValue(status, capability, attribute); ok && valuesEqual(got, want) {
+			return nil
+		}
+
+		if maxAttempts > 0 && attempt >= maxAttempts {
+			return fmt.Errorf("%w: %s.%s not %v after %d attempts", ErrWaitTimeout, capability, attribute, want, attempt)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrWaitTimeout, ctx.Err())
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, maxWaitInterval)
+	}
+}
+
+// attributeValue returns the value of a capability attribute from a component status.
+func attributeValue(status Status, capability, attribute string) (any, bool) {
+	cap, _ := findCapability(status, capability)
+	if cap == nil {
+		return nil, false
+	}
+	attr, ok := GetMap(cap, attribute)
+	if !ok {
+		return nil, false
+	}
+	value, ok := attr["value"]
+	return value, ok
+}
+
+// valuesEqual compares two attribute values, treating all numeric types as equal by value.
+func valuesEqual(a, b any) bool {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toFloat converts a numeric value to float64.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
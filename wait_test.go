@@ -0,0 +1,119 @@
+package smartthings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_WaitForDeviceState(t *testing.T) {
+	t.Run("returns once state matches", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/devices/device-1/components/main/status" {
+				t.Errorf("path = %q, want %q", r.URL.Path, "/devices/device-1/components/main/status")
+			}
+			level := 10
+			if calls.Add(1) >= 3 {
+				level = 50
+			}
+			fmt.Fprintf(w, `{"switchLevel":{"level":{"value":%d}}}`, level)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.WaitForDeviceState(context.Background(), "device-1", "switchLevel", "level", 50, &WaitOptions{Interval: time.Millisecond})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls.Load() != 3 {
+			t.Errorf("got %d polls, want 3", calls.Load())
+		}
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Write([]byte(`{"switch":{"switch":{"value":"off"}}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.WaitForDeviceState(context.Background(), "device-1", "switch", "switch", "on", &WaitOptions{Interval: time.Millisecond, MaxAttempts: 2})
+		if !errors.Is(err, ErrWaitTimeout) {
+			t.Errorf("expected ErrWaitTimeout, got %v", err)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("got %d polls, want 2", calls.Load())
+		}
+	})
+
+	t.Run("context deadline", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"switch":{"switch":{"value":"off"}}}`))
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := client.WaitForDeviceState(ctx, "device-1", "switch", "switch", "on", &WaitOptions{Interval: time.Millisecond})
+		if !errors.Is(err, ErrWaitTimeout) {
+			t.Errorf("expected ErrWaitTimeout, got %v", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("API error is not a timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, _ := NewClient("token", WithBaseURL(server.URL))
+		err := client.WaitForDeviceState(context.Background(), "device-1", "switch", "switch", "on", nil)
+		if errors.Is(err, ErrWaitTimeout) {
+			t.Errorf("expected API error, got %v", err)
+		}
+		if !IsNotFound(err) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("empty device ID", func(t *testing.T) {
+		client, _ := NewClient("token")
+		err := client.WaitForDeviceState(context.Background(), "", "switch", "switch", "on", nil)
+		if err != ErrEmptyDeviceID {
+			t.Errorf("expected ErrEmptyDeviceID, got %v", err)
+		}
+	})
+}
+
+func TestValuesEqual(t *testing.T) {
+	tests := []struct {
+		a, b any
+		want bool
+	}{
+		{float64(50), 50, true},
+		{float64(50.5), 50, false},
+		{"on", "on", true},
+		{"on", "off", false},
+		{true, true, true},
+		{nil, "on", false},
+		{[]any{"a"}, []any{"a"}, true},
+	}
+	for _, tt := range tests {
+		if got := valuesEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("valuesEqual(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -143,6 +143,7 @@ var (
 	ErrInvalidSignature = errors.New("smartthings: invalid webhook signature")
 	ErrMissingSignature = errors.New("smartthings: missing webhook signature header")
 	ErrEmptyBody        = errors.New("smartthings: empty webhook body")
+	ErrEmptySecret      = errors.New("smartthings: webhook secret cannot be empty")
 )
 
 // ValidateWebhookSignature verifies the HMAC-SHA256 signature of a webhook request.
@@ -153,11 +154,39 @@ func ValidateWebhookSignature(secret string, body []byte, signature string) bool
 		return false
 	}
 
+	expected := webhookSignature(secret, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// SignWebhookPayload returns the headers SmartThings would send with body,
+// signed with secret, so that ParseWebhookRequest and ValidateWebhookSignature
+// accept it. This is intended for testing webhook handlers.
+//
+// Example:
+//
+//	headers, _ := smartthings.SignWebhookPayload(secret, body)
+//	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+//	for k, v := range headers {
+//	    req.Header.Set(k, v)
+//	}
+func SignWebhookPayload(secret string, body []byte) (map[string]string, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+	if len(body) == 0 {
+		return nil, ErrEmptyBody
+	}
+
+	return map[string]string{
+		WebhookSignatureHeader: webhookSignature(secret, body),
+	}, nil
+}
+
+// webhookSignature computes the base64-encoded HMAC-SHA256 of body.
+func webhookSignature(secret string, body []byte) string {
 	mac := hmac.New(sha256.New, []byte(secret))
 	mac.Write(body)
-	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
-
-	return hmac.Equal([]byte(expected), []byte(signature))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
 }
 
 // ParseWebhookRequest parses and validates a webhook request from SmartThings.
@@ -200,3 +229,37 @@ func PingResponse(challenge string) map[string]any {
 		},
 	}
 }
+
+// WebhookEventToHubLocalEvents converts the device events of an EVENT
+// lifecycle webhook into HubLocalEvents, so cloud webhooks and local hub
+// events can share one handler. Timer and other non-device events are
+// skipped. Cloud events carry no unit or timestamp, so Unit and Timestamp
+// are left empty. Returns nil if event has no event data.
+//
+// Example:
+//
+//	for _, e := range smartthings.WebhookEventToHubLocalEvents(event) {
+//	    handle(e) // same handler as HubLocalClient events
+//	}
+func WebhookEventToHubLocalEvents(event *WebhookEvent) []HubLocalEvent {
+	if event == nil || event.EventData == nil {
+		return nil
+	}
+
+	var events []HubLocalEvent
+	for _, e := range event.EventData.Events {
+		if e.DeviceEvent == nil {
+			continue
+		}
+		d := e.DeviceEvent
+		events = append(events, HubLocalEvent{
+			DeviceID:    d.DeviceID,
+			Component:   d.ComponentID,
+			Capability:  d.Capability,
+			Attribute:   d.Attribute,
+			Value:       d.Value,
+			StateChange: d.StateChange,
+		})
+	}
+	return events
+}
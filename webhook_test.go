@@ -238,3 +238,84 @@ func TestWebhookLifecycleConstants(t *testing.T) {
 		}
 	}
 }
+
+func TestSignWebhookPayload(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"lifecycle":"PING","pingData":{"challenge":"abc"}}`)
+
+	t.Run("round trip through ParseWebhookRequest", func(t *testing.T) {
+		headers, err := SignWebhookPayload(secret, body)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if headers[WebhookSignatureHeader] == "" {
+			t.Fatalf("missing %s header in %v", WebhookSignatureHeader, headers)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		event, err := ParseWebhookRequest(req, secret)
+		if err != nil {
+			t.Fatalf("ParseWebhookRequest rejected signed request: %v", err)
+		}
+		if event.Lifecycle != LifecyclePing || event.PingData.Challenge != "abc" {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	})
+
+	t.Run("different secret fails validation", func(t *testing.T) {
+		headers, _ := SignWebhookPayload("other-secret", body)
+		if ValidateWebhookSignature(secret, body, headers[WebhookSignatureHeader]) {
+			t.Error("signature from a different secret should not validate")
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		if _, err := SignWebhookPayload("", body); err != ErrEmptySecret {
+			t.Errorf("expected ErrEmptySecret, got %v", err)
+		}
+		if _, err := SignWebhookPayload(secret, nil); err != ErrEmptyBody {
+			t.Errorf("expected ErrEmptyBody, got %v", err)
+		}
+	})
+}
+
+func TestWebhookEventToHubLocalEvents(t *testing.T) {
+	t.Run("converts device events", func(t *testing.T) {
+		event := &WebhookEvent{
+			Lifecycle: LifecycleEvent,
+			EventData: &EventData{Events: []DeviceEventData{
+				{EventType: "DEVICE_EVENT", DeviceEvent: &DeviceEventDetail{
+					DeviceID: "dev-1", ComponentID: "main", Capability: "switch", Attribute: "switch", Value: "on", StateChange: true,
+				}},
+				{EventType: "TIMER_EVENT", TimerEvent: &TimerEventDetail{Name: "nightly"}},
+				{EventType: "DEVICE_EVENT", DeviceEvent: &DeviceEventDetail{
+					DeviceID: "dev-2", ComponentID: "main", Capability: "temperatureMeasurement", Attribute: "temperature", Value: 21.5,
+				}},
+			}},
+		}
+
+		events := WebhookEventToHubLocalEvents(event)
+		if len(events) != 2 {
+			t.Fatalf("expected 2 events, got %d", len(events))
+		}
+		want := HubLocalEvent{DeviceID: "dev-1", Component: "main", Capability: "switch", Attribute: "switch", Value: "on", StateChange: true}
+		if events[0] != want {
+			t.Errorf("events[0] = %+v, want %+v", events[0], want)
+		}
+		if events[1].DeviceID != "dev-2" || events[1].Value != 21.5 || events[1].StateChange {
+			t.Errorf("events[1] = %+v", events[1])
+		}
+	})
+
+	t.Run("no event data", func(t *testing.T) {
+		if events := WebhookEventToHubLocalEvents(nil); events != nil {
+			t.Errorf("expected nil for nil event, got %v", events)
+		}
+		if events := WebhookEventToHubLocalEvents(&WebhookEvent{Lifecycle: LifecyclePing}); events != nil {
+			t.Errorf("expected nil for ping event, got %v", events)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package smartthings
+
+import "sync"
+
+// WebhookWildcard matches any capability or attribute in WebhookDispatcher.OnDeviceEvent.
+const WebhookWildcard = "*"
+
+// WebhookDispatcher routes device events from EVENT lifecycle webhooks to
+// handlers registered by capability and attribute. It is safe for concurrent
+// use; handlers may be registered while events are being dispatched.
+//
+// Example:
+//
+//	d := smartthings.NewWebhookDispatcher()
+//	d.OnDeviceEvent("motionSensor", "motion", func(e smartthings.DeviceEventDetail) {
+//	    if e.Value == "active" {
+//	        turnOnLights(e.DeviceID)
+//	    }
+//	})
+//	d.OnDeviceEvent("*", "*", func(e smartthings.DeviceEventDetail) {
+//	    log.Printf("%s.%s = %v", e.Capability, e.Attribute, e.Value)
+//	})
+//
+//	event, err := smartthings.ParseWebhookRequest(r, secret)
+//	if err == nil && event.Lifecycle == smartthings.LifecycleEvent {
+//	    d.Dispatch(event)
+//	}
+type WebhookDispatcher struct {
+	mu       sync.RWMutex
+	handlers []webhookHandler
+}
+
+// webhookHandler is a registered device event handler.
+type webhookHandler struct {
+	capability string
+	attribute  string
+	handle     func(DeviceEventDetail)
+}
+
+// NewWebhookDispatcher creates an empty WebhookDispatcher.
+func NewWebhookDispatcher() *WebhookDispatcher {
+	return &WebhookDispatcher{}
+}
+
+// OnDeviceEvent registers handler for device events with the given capability
+// and attribute. Either may be WebhookWildcard ("*") to match any value.
+func (d *WebhookDispatcher) OnDeviceEvent(capability, attribute string, handler func(DeviceEventDetail)) {
+	if handler == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers = append(d.handlers, webhookHandler{
+		capability: capability,
+		attribute:  attribute,
+		handle:     handler,
+	})
+}
+
+// Dispatch calls every matching handler for each device event in event, in
+// event order and then registration order. Events without device details
+// (e.g. timer events) and non-EVENT webhooks are ignored. Handlers run
+// synchronously on the calling goroutine.
+func (d *WebhookDispatcher) Dispatch(event *WebhookEvent) {
+	if event == nil || event.EventData == nil {
+		return
+	}
+
+	d.mu.RLock()
+	handlers := d.handlers
+	d.mu.RUnlock()
+
+	for _, e := range event.EventData.Events {
+		if e.DeviceEvent == nil {
+			continue
+		}
+		for _, h := range handlers {
+			if h.matches(e.DeviceEvent) {
+				h.handle(*e.DeviceEvent)
+			}
+		}
+	}
+}
+
+func (h webhookHandler) matches(e *DeviceEventDetail) bool {
+	return (h.capability == WebhookWildcard || h.capability == e.Capability) &&
+		(h.attribute == WebhookWildcard || h.attribute == e.Attribute)
+}
@@ -0,0 +1,60 @@
+package smartthings
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	event := &WebhookEvent{
+		Lifecycle: LifecycleEvent,
+		EventData: &EventData{
+			Events: []DeviceEventData{
+				{EventType: "DEVICE_EVENT", DeviceEvent: &DeviceEventDetail{DeviceID: "motion-1", Capability: "motionSensor", Attribute: "motion", Value: "active"}},
+				{EventType: "TIMER_EVENT", TimerEvent: &TimerEventDetail{Name: "nightly"}},
+				{EventType: "DEVICE_EVENT", DeviceEvent: &DeviceEventDetail{DeviceID: "door-1", Capability: "contactSensor", Attribute: "contact", Value: "open"}},
+				{EventType: "DEVICE_EVENT", DeviceEvent: &DeviceEventDetail{DeviceID: "temp-1", Capability: "temperatureMeasurement", Attribute: "temperature", Value: 21.5}},
+			},
+		},
+	}
+
+	var calls []string
+	record := func(name string) func(DeviceEventDetail) {
+		return func(e DeviceEventDetail) {
+			calls = append(calls, name+":"+e.DeviceID)
+		}
+	}
+
+	d := NewWebhookDispatcher()
+	d.OnDeviceEvent("motionSensor", "motion", record("motion"))
+	d.OnDeviceEvent("contactSensor", "*", record("contact"))
+	d.OnDeviceEvent("*", "temperature", record("temperature"))
+	d.OnDeviceEvent("*", "*", record("all"))
+	d.OnDeviceEvent("switch", "switch", record("switch"))
+	d.OnDeviceEvent("switch", "switch", nil) // ignored
+
+	d.Dispatch(event)
+
+	want := []string{
+		"motion:motion-1", "all:motion-1",
+		"contact:door-1", "all:door-1",
+		"temperature:temp-1", "all:temp-1",
+	}
+	if !slices.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestWebhookDispatcher_IgnoresNonEvents(t *testing.T) {
+	called := false
+	d := NewWebhookDispatcher()
+	d.OnDeviceEvent("*", "*", func(DeviceEventDetail) { called = true })
+
+	d.Dispatch(nil)
+	d.Dispatch(&WebhookEvent{Lifecycle: LifecyclePing, PingData: &PingData{Challenge: "abc"}})
+	d.Dispatch(&WebhookEvent{Lifecycle: LifecycleEvent, EventData: &EventData{}})
+
+	if called {
+		t.Error("handler should not be called")
+	}
+}